@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrAttendeeNameRequired      = errors.New("attendee name is required")
+	ErrAttendeeDocumentRequired  = errors.New("attendee document is required")
+	ErrAttendeeBirthDateInvalid  = errors.New("attendee birth date must be a past date in the YYYY-MM-DD format")
+	ErrAttendeeBirthDateRequired = errors.New("attendee birth date is required for events with an age rating")
+	ErrAttendeesMismatch         = errors.New("purchase must have one attendee for each spot")
+)
+
+// Attendee is the person who uses a ticket. BirthDate is the zero time when
+// it was not informed, which is only accepted for events rated L.
+type Attendee struct {
+	Name      string    `json:"name"`
+	Document  string    `json:"document"`
+	BirthDate time.Time `json:"birth_date"`
+}
+
+func CreatedNewAttendee(name, document string, birthDate time.Time) (*Attendee, error) {
+	attendee := &Attendee{
+		Name:      strings.TrimSpace(name),
+		Document:  strings.TrimSpace(document),
+		BirthDate: birthDate,
+	}
+	if err := attendee.Validate(); err != nil {
+		return nil, err
+	}
+	return attendee, nil
+}
+
+func (a Attendee) Validate() error {
+	if a.Name == "" {
+		return ErrAttendeeNameRequired
+	}
+	if a.Document == "" {
+		return ErrAttendeeDocumentRequired
+	}
+	if a.BirthDate.After(time.Now()) {
+		return ErrAttendeeBirthDateInvalid
+	}
+	return nil
+}
+
+// ParseBirthDate reads a birth date in the YYYY-MM-DD format. An empty value
+// is the zero time.
+func ParseBirthDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	birthDate, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, ErrAttendeeBirthDateInvalid
+	}
+	return birthDate, nil
+}
+
+// AgeAt returns the age in whole years on the calendar day of at. People
+// born on February 29 turn a year older on March 1 in common years.
+func AgeAt(birthDate, at time.Time) int {
+	age := at.Year() - birthDate.Year()
+	if at.Month() < birthDate.Month() || at.Month() == birthDate.Month() && at.Day() < birthDate.Day() {
+		age--
+	}
+	return age
+}
+
+// CheckAttendeeAge verifies the attendee is old enough for the event rating
+// on the event date, in the event time zone. Underage attendees are refused
+// with a PurchaseRejection.
+func (e Event) CheckAttendeeAge(attendee Attendee) error {
+	minimumAge := e.Rating.MinimumAge()
+	if minimumAge == 0 {
+		return nil
+	}
+	if attendee.BirthDate.IsZero() {
+		return ErrAttendeeBirthDateRequired
+	}
+	if AgeAt(attendee.BirthDate, e.LocalDate()) < minimumAge {
+		return &PurchaseRejection{
+			Code:   RejectionAttendeeUnderage,
+			Reason: fmt.Sprintf("the event is rated %s and %s will not be %d years old on the event date", e.Rating, attendee.Name, minimumAge),
+		}
+	}
+	return nil
+}
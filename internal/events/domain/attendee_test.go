@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatedNewAttendee(t *testing.T) {
+	attendee, err := CreatedNewAttendee(" Maria Silva ", " 123.456.789-09 ", time.Date(2000, 5, 10, 0, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.Equal(t, "Maria Silva", attendee.Name)
+	assert.Equal(t, "123.456.789-09", attendee.Document)
+
+	_, err = CreatedNewAttendee(" ", "123", time.Time{})
+	assert.Equal(t, ErrAttendeeNameRequired, err)
+	_, err = CreatedNewAttendee("Maria", "", time.Time{})
+	assert.Equal(t, ErrAttendeeDocumentRequired, err)
+	_, err = CreatedNewAttendee("Maria", "123", time.Now().Add(48*time.Hour))
+	assert.Equal(t, ErrAttendeeBirthDateInvalid, err)
+}
+
+func TestParseBirthDate(t *testing.T) {
+	birthDate, err := ParseBirthDate("2008-02-29")
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2008, 2, 29, 0, 0, 0, 0, time.UTC), birthDate)
+
+	birthDate, err = ParseBirthDate("")
+	assert.Nil(t, err)
+	assert.True(t, birthDate.IsZero())
+
+	_, err = ParseBirthDate("29/02/2008")
+	assert.Equal(t, ErrAttendeeBirthDateInvalid, err)
+}
+
+func TestAgeAt(t *testing.T) {
+	birthDate := time.Date(2008, 6, 15, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, 17, AgeAt(birthDate, time.Date(2026, 6, 14, 23, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 18, AgeAt(birthDate, time.Date(2026, 6, 15, 0, 0, 0, 0, time.UTC)))
+
+	leap := time.Date(2008, 2, 29, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, 17, AgeAt(leap, time.Date(2026, 2, 28, 12, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 18, AgeAt(leap, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)))
+}
+
+func TestEventCheckAttendeeAge(t *testing.T) {
+	//o evento começa às 22h de 14/06 em São Paulo, já 15/06 em UTC
+	event := Event{
+		Rating:   Rating18,
+		Date:     time.Date(2026, 6, 15, 1, 0, 0, 0, time.UTC),
+		Timezone: "America/Sao_Paulo",
+	}
+
+	adult := Attendee{Name: "Ana", Document: "1", BirthDate: time.Date(2008, 6, 14, 0, 0, 0, 0, time.UTC)}
+	assert.Nil(t, event.CheckAttendeeAge(adult))
+
+	minor := Attendee{Name: "Bia", Document: "2", BirthDate: time.Date(2008, 6, 15, 0, 0, 0, 0, time.UTC)}
+	err := event.CheckAttendeeAge(minor)
+	assert.ErrorIs(t, err, ErrPurchaseRejected)
+	var rejection *PurchaseRejection
+	assert.True(t, errors.As(err, &rejection))
+	assert.Equal(t, RejectionAttendeeUnderage, rejection.Code)
+
+	assert.Equal(t, ErrAttendeeBirthDateRequired, event.CheckAttendeeAge(Attendee{Name: "Caio", Document: "3"}))
+
+	event.Rating = RatingFree
+	assert.Nil(t, event.CheckAttendeeAge(Attendee{Name: "Caio", Document: "3"}))
+}
+
+func TestTicketAssignAttendee(t *testing.T) {
+	var ticket Ticket
+	ticket.AssignAttendee(Attendee{Name: "Ana", Document: "123"})
+	assert.Equal(t, "Ana", ticket.AttendeeName)
+	assert.Equal(t, "123", ticket.AttendeeDocument)
+}
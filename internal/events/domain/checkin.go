@@ -0,0 +1,222 @@
+package domain
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTicketCheckedIn          = errors.New("ticket was already checked in")
+	ErrCheckInGateRequired      = errors.New("check-in gate is required")
+	ErrCheckInScanTimeInvalid   = errors.New("check-in scan time must not be in the future")
+	ErrCheckInEventMismatch     = errors.New("ticket is for another event")
+	ErrCheckInClosed            = errors.New("event is not open for check-in")
+	ErrCheckInManifestInvalid   = errors.New("check-in manifest signature is invalid")
+	ErrCheckInSyncBatchTooLarge = errors.New("check-in sync batch is too large")
+)
+
+// MaxCheckInSyncBatch is how many offline scans a scanner sends per sync
+const MaxCheckInSyncBatch = 500
+
+// checkInClockSkew is how far ahead of the server clock a scanner clock may
+// run before its scans are rejected
+const checkInClockSkew = 5 * time.Minute
+
+// checkInManifestPrefix separates the manifest signatures from the
+// credential signatures made with the same keys
+const checkInManifestPrefix = "MF1."
+
+type ScanSource string
+
+const (
+	ScanSourceOnline  ScanSource = "online"
+	ScanSourceOffline ScanSource = "offline"
+)
+
+type ScanResult string
+
+const (
+	ScanResultAccepted  ScanResult = "accepted"
+	ScanResultDuplicate ScanResult = "duplicate"
+	ScanResultRejected  ScanResult = "rejected"
+)
+
+// DuplicateCheckInError rejects a scan of a ticket that was already checked
+// in, with the details of the first scan for the door staff
+type DuplicateCheckInError struct {
+	TicketID    string
+	CheckedInAt time.Time
+	Gate        string
+}
+
+func (e *DuplicateCheckInError) Error() string {
+	return fmt.Sprintf("ticket %s was already checked in at gate %s at %s", e.TicketID, e.Gate, e.CheckedInAt.Format(time.RFC3339))
+}
+
+func (e *DuplicateCheckInError) Unwrap() error {
+	return ErrTicketCheckedIn
+}
+
+// TicketScan records every scan made at the door, accepted or not, so the
+// scans of the offline scanners can be audited after the sync
+type TicketScan struct {
+	ID        string     `json:"id"`
+	TicketID  string     `json:"ticket_id"`
+	EventID   string     `json:"event_id"`
+	Gate      string     `json:"gate"`
+	DeviceID  string     `json:"device_id"`
+	Source    ScanSource `json:"source"`
+	Result    ScanResult `json:"result"`
+	Reason    string     `json:"reason"`
+	ScannedAt time.Time  `json:"scanned_at"`
+	SyncedAt  time.Time  `json:"synced_at"`
+}
+
+func CreatedNewTicketScan(gate, deviceID string, source ScanSource, scannedAt, now time.Time) (*TicketScan, error) {
+	gate = strings.TrimSpace(gate)
+	if gate == "" {
+		return nil, ErrCheckInGateRequired
+	}
+	if scannedAt.After(now.Add(checkInClockSkew)) {
+		return nil, ErrCheckInScanTimeInvalid
+	}
+	return &TicketScan{
+		ID:        uuid.New().String(),
+		Gate:      gate,
+		DeviceID:  strings.TrimSpace(deviceID),
+		Source:    source,
+		ScannedAt: scannedAt.UTC().Truncate(time.Microsecond),
+		SyncedAt:  now.UTC(),
+	}, nil
+}
+
+// Resolve records the outcome of the scan
+func (s *TicketScan) Resolve(err error) {
+	var duplicate *DuplicateCheckInError
+	switch {
+	case err == nil:
+		s.Result = ScanResultAccepted
+	case errors.As(err, &duplicate):
+		s.Result = ScanResultDuplicate
+		s.Reason = err.Error()
+	default:
+		s.Result = ScanResultRejected
+		s.Reason = err.Error()
+	}
+}
+
+// CheckCheckInOpen verifies the tickets of the event can be used at the door
+func (e Event) CheckCheckInOpen() error {
+	if e.Status == EventStatusDraft || e.Status == EventStatusCancelled {
+		return fmt.Errorf("%w: event is %s", ErrCheckInClosed, e.Status)
+	}
+	return nil
+}
+
+// IsCheckedIn reports whether the ticket was used at the door
+func (t Ticket) IsCheckedIn() bool {
+	return t.CheckedInAt != nil
+}
+
+// CheckIn marks the ticket as used at gate. Offline scans arrive late, so
+// the earliest scan wins: a scan made before the recorded check-in replaces
+// it, and any later scan is a duplicate.
+func (t *Ticket) CheckIn(gate string, scannedAt time.Time) error {
+	if t.CheckedInAt != nil && !scannedAt.Before(*t.CheckedInAt) {
+		return &DuplicateCheckInError{TicketID: t.ID, CheckedInAt: *t.CheckedInAt, Gate: t.CheckedInGate}
+	}
+	scannedAt = scannedAt.UTC()
+	t.CheckedInAt = &scannedAt
+	t.CheckedInGate = gate
+	return nil
+}
+
+// CheckInManifest lists the tickets of an event for scanners that check
+// credentials without a connection. Credentials are verified with the public
+// keys; the manifest tells which version of each credential is current and
+// which tickets were already used.
+type CheckInManifest struct {
+	EventID     string                 `json:"event_id"`
+	GeneratedAt time.Time              `json:"generated_at"`
+	Tickets     []CheckInManifestEntry `json:"tickets"`
+}
+
+type CheckInManifestEntry struct {
+	TicketID          string     `json:"ticket_id"`
+	CredentialVersion int        `json:"credential_version"`
+	SpotName          string     `json:"spot_name"`
+	AttendeeName      string     `json:"attendee_name"`
+	CheckedInAt       *time.Time `json:"checked_in_at,omitempty"`
+	CheckedInGate     string     `json:"checked_in_gate,omitempty"`
+}
+
+func CreatedNewCheckInManifest(eventID string, tickets []Ticket, now time.Time) *CheckInManifest {
+	manifest := &CheckInManifest{
+		EventID:     eventID,
+		GeneratedAt: now.UTC(),
+		Tickets:     make([]CheckInManifestEntry, len(tickets)),
+	}
+	for i, ticket := range tickets {
+		manifest.Tickets[i] = CheckInManifestEntry{
+			TicketID:          ticket.ID,
+			CredentialVersion: ticket.CredentialVersion,
+			AttendeeName:      ticket.AttendeeName,
+			CheckedInAt:       ticket.CheckedInAt,
+			CheckedInGate:     ticket.CheckedInGate,
+		}
+		if ticket.Spot != nil {
+			manifest.Tickets[i].SpotName = ticket.Spot.Name
+		}
+	}
+	return manifest
+}
+
+// SignedCheckInManifest carries the manifest exactly as it was signed, so
+// scanners verify the bytes they received
+type SignedCheckInManifest struct {
+	Manifest  json.RawMessage `json:"manifest"`
+	KeyID     string          `json:"key_id"`
+	Signature string          `json:"signature"`
+}
+
+// SignCheckInManifest signs the manifest with the active credential key
+func (r *CredentialKeyRing) SignCheckInManifest(manifest *CheckInManifest) (*SignedCheckInManifest, error) {
+	payload, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+	signature := ed25519.Sign(r.keys[r.activeKeyID], append([]byte(checkInManifestPrefix), payload...))
+	return &SignedCheckInManifest{
+		Manifest:  payload,
+		KeyID:     r.activeKeyID,
+		Signature: base64.StdEncoding.EncodeToString(signature),
+	}, nil
+}
+
+// VerifyCheckInManifest checks the signature of a manifest with the given
+// public keys and returns its content
+func VerifyCheckInManifest(signed SignedCheckInManifest, publicKeys map[string]ed25519.PublicKey) (*CheckInManifest, error) {
+	publicKey, ok := publicKeys[signed.KeyID]
+	if !ok {
+		return nil, ErrCredentialKeyUnknown
+	}
+	signature, err := base64.StdEncoding.DecodeString(signed.Signature)
+	if err != nil {
+		return nil, ErrCheckInManifestInvalid
+	}
+	if !ed25519.Verify(publicKey, append([]byte(checkInManifestPrefix), signed.Manifest...), signature) {
+		return nil, ErrCheckInManifestInvalid
+	}
+	var manifest CheckInManifest
+	if err := json.Unmarshal(signed.Manifest, &manifest); err != nil {
+		return nil, ErrCheckInManifestInvalid
+	}
+	return &manifest, nil
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatedNewTicketScan(t *testing.T) {
+	now := time.Now()
+	scan, err := CreatedNewTicketScan(" A ", "scanner-1", ScanSourceOffline, now.Add(-time.Hour), now)
+	assert.Nil(t, err)
+	assert.Equal(t, "A", scan.Gate)
+	assert.Equal(t, ScanSourceOffline, scan.Source)
+
+	_, err = CreatedNewTicketScan(" ", "scanner-1", ScanSourceOnline, now, now)
+	assert.Equal(t, ErrCheckInGateRequired, err)
+	_, err = CreatedNewTicketScan("A", "scanner-1", ScanSourceOffline, now.Add(time.Hour), now)
+	assert.Equal(t, ErrCheckInScanTimeInvalid, err)
+}
+
+func TestTicket_CheckIn(t *testing.T) {
+	ticket := Ticket{ID: "ticket-1"}
+	first := time.Date(2026, 5, 1, 20, 0, 0, 0, time.UTC)
+
+	assert.Nil(t, ticket.CheckIn("A", first))
+	assert.True(t, ticket.IsCheckedIn())
+
+	err := ticket.CheckIn("B", first.Add(time.Minute))
+	var duplicate *DuplicateCheckInError
+	assert.True(t, errors.As(err, &duplicate))
+	assert.ErrorIs(t, err, ErrTicketCheckedIn)
+	assert.Equal(t, "A", duplicate.Gate)
+	assert.Equal(t, first, duplicate.CheckedInAt)
+
+	//uma leitura offline anterior vence a registrada
+	assert.Nil(t, ticket.CheckIn("C", first.Add(-time.Minute)))
+	assert.Equal(t, "C", ticket.CheckedInGate)
+	assert.Equal(t, first.Add(-time.Minute), *ticket.CheckedInAt)
+}
+
+func TestTicketScan_Resolve(t *testing.T) {
+	scan := TicketScan{}
+	scan.Resolve(nil)
+	assert.Equal(t, ScanResultAccepted, scan.Result)
+
+	scan.Resolve(&DuplicateCheckInError{TicketID: "ticket-1", Gate: "A"})
+	assert.Equal(t, ScanResultDuplicate, scan.Result)
+
+	scan.Resolve(ErrCredentialRevoked)
+	assert.Equal(t, ScanResultRejected, scan.Result)
+	assert.Equal(t, ErrCredentialRevoked.Error(), scan.Reason)
+}
+
+func TestEvent_CheckCheckInOpen(t *testing.T) {
+	assert.Nil(t, Event{Status: EventStatusSoldOut}.CheckCheckInOpen())
+	assert.Nil(t, Event{Status: EventStatusFinished}.CheckCheckInOpen())
+	assert.ErrorIs(t, Event{Status: EventStatusCancelled}.CheckCheckInOpen(), ErrCheckInClosed)
+	assert.ErrorIs(t, Event{Status: EventStatusDraft}.CheckCheckInOpen(), ErrCheckInClosed)
+}
+
+func TestCheckInManifest_SignVerify(t *testing.T) {
+	ring, _ := GenerateCredentialKeyRing("k1")
+	checkedInAt := time.Date(2026, 5, 1, 20, 0, 0, 0, time.UTC)
+	tickets := []Ticket{
+		{ID: "ticket-1", Spot: &Spot{Name: "A1"}, CredentialVersion: 2},
+		{ID: "ticket-2", Spot: &Spot{Name: "A2"}, CredentialVersion: 1, CheckedInAt: &checkedInAt, CheckedInGate: "A"},
+	}
+
+	signed, err := ring.SignCheckInManifest(CreatedNewCheckInManifest("event-1", tickets, time.Now()))
+	assert.Nil(t, err)
+
+	manifest, err := VerifyCheckInManifest(*signed, ring.PublicKeys())
+	assert.Nil(t, err)
+	assert.Equal(t, "event-1", manifest.EventID)
+	assert.Len(t, manifest.Tickets, 2)
+	assert.Equal(t, 2, manifest.Tickets[0].CredentialVersion)
+	assert.Equal(t, "A1", manifest.Tickets[0].SpotName)
+	assert.Equal(t, "A", manifest.Tickets[1].CheckedInGate)
+
+	tampered := *signed
+	tampered.Manifest = []byte(`{"event_id":"event-1","tickets":[]}`)
+	_, err = VerifyCheckInManifest(tampered, ring.PublicKeys())
+	assert.Equal(t, ErrCheckInManifestInvalid, err)
+
+	//a assinatura do manifesto não vale como credencial
+	_, err = ring.Verify("TK1." + string(signed.Manifest) + "." + signed.Signature)
+	assert.Error(t, err)
+}
@@ -0,0 +1,167 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrCouponCodeRequired      = errors.New("coupon code is required")
+	ErrCouponTypeInvalid       = errors.New("coupon discount type must be percentage or fixed")
+	ErrCouponValueInvalid      = errors.New("coupon value must be greater than zero")
+	ErrCouponPercentageInvalid = errors.New("coupon percentage must not exceed 100")
+	ErrCouponValidityInvalid   = errors.New("coupon valid until must be after valid from")
+	ErrCouponUsageInvalid      = errors.New("coupon usage limits must not be negative")
+	ErrCouponNotFound          = errors.New("coupon not found")
+	ErrCouponAlreadyExists     = errors.New("coupon code already exists")
+	ErrCouponNotActive         = errors.New("coupon is not valid at this time")
+	ErrCouponUsageLimitReached = errors.New("coupon usage limit reached")
+	ErrCouponEmailLimitReached = errors.New("coupon usage limit reached for this email")
+	ErrCouponNotApplicable     = errors.New("coupon does not apply to this event or ticket kind")
+)
+
+type DiscountType string
+
+const (
+	DiscountTypePercentage DiscountType = "percentage"
+	DiscountTypeFixed      DiscountType = "fixed"
+)
+
+// Coupon is a promotional code that discounts the price of tickets.
+// MaxUses and MaxUsesPerEmail count redeemed tickets; zero means unlimited.
+// Empty EventIDs or TicketKinds means the coupon is not restricted.
+type Coupon struct {
+	ID              string       `json:"id"`
+	Code            string       `json:"code"`
+	DiscountType    DiscountType `json:"discount_type"`
+	Value           float64      `json:"value"`
+	ValidFrom       time.Time    `json:"valid_from"`
+	ValidUntil      time.Time    `json:"valid_until"`
+	MaxUses         int          `json:"max_uses"`
+	MaxUsesPerEmail int          `json:"max_uses_per_email"`
+	UsedCount       int          `json:"used_count"`
+	EventIDs        []string     `json:"event_ids"`
+	TicketKinds     []TicketKind `json:"ticket_kinds"`
+}
+
+// CouponRedemption records the use of a coupon by a buyer in a purchase.
+type CouponRedemption struct {
+	ID        string    `json:"id"`
+	CouponID  string    `json:"coupon_id"`
+	Email     string    `json:"email"`
+	Quantity  int       `json:"quantity"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NormalizeCouponCode returns the canonical form used to store and look up codes
+func NormalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func CreatedNewCoupon(code string, discountType DiscountType, value float64, validFrom, validUntil time.Time, maxUses, maxUsesPerEmail int, eventIDs []string, ticketKinds []TicketKind) (*Coupon, error) {
+	coupon := &Coupon{
+		ID:              uuid.New().String(),
+		Code:            NormalizeCouponCode(code),
+		DiscountType:    discountType,
+		Value:           value,
+		ValidFrom:       validFrom,
+		ValidUntil:      validUntil,
+		MaxUses:         maxUses,
+		MaxUsesPerEmail: maxUsesPerEmail,
+		EventIDs:        eventIDs,
+		TicketKinds:     ticketKinds,
+	}
+	if err := coupon.Validate(); err != nil {
+		return nil, err
+	}
+	return coupon, nil
+}
+
+func (c Coupon) Validate() error {
+	if c.Code == "" {
+		return ErrCouponCodeRequired
+	}
+	if c.DiscountType != DiscountTypePercentage && c.DiscountType != DiscountTypeFixed {
+		return ErrCouponTypeInvalid
+	}
+	if c.Value <= 0 {
+		return ErrCouponValueInvalid
+	}
+	if c.DiscountType == DiscountTypePercentage && c.Value > 100 {
+		return ErrCouponPercentageInvalid
+	}
+	if !c.ValidUntil.IsZero() && !c.ValidUntil.After(c.ValidFrom) {
+		return ErrCouponValidityInvalid
+	}
+	if c.MaxUses < 0 || c.MaxUsesPerEmail < 0 {
+		return ErrCouponUsageInvalid
+	}
+	for _, kind := range c.TicketKinds {
+		if !IsValidTicketKind(kind) {
+			return ErrTicketStatusInvalid
+		}
+	}
+	return nil
+}
+
+// IsActive reports whether the coupon validity window contains the given time
+func (c Coupon) IsActive(at time.Time) bool {
+	if at.Before(c.ValidFrom) {
+		return false
+	}
+	if !c.ValidUntil.IsZero() && at.After(c.ValidUntil) {
+		return false
+	}
+	return true
+}
+
+// CheckApplicable verifies the coupon can be used for a purchase of the given
+// quantity. It does not check the per email limit, which needs the redemption
+// history and is enforced by the repository when the coupon is redeemed.
+func (c Coupon) CheckApplicable(eventID string, kind TicketKind, quantity int, at time.Time) error {
+	if !c.IsActive(at) {
+		return ErrCouponNotActive
+	}
+	if len(c.EventIDs) > 0 && !slices.Contains(c.EventIDs, eventID) {
+		return ErrCouponNotApplicable
+	}
+	if len(c.TicketKinds) > 0 && !slices.Contains(c.TicketKinds, kind) {
+		return ErrCouponNotApplicable
+	}
+	if c.MaxUses > 0 && c.UsedCount+quantity > c.MaxUses {
+		return ErrCouponUsageLimitReached
+	}
+	if c.MaxUsesPerEmail > 0 && quantity > c.MaxUsesPerEmail {
+		return ErrCouponEmailLimitReached
+	}
+	return nil
+}
+
+// DiscountFor returns the discount the coupon grants on a single ticket price.
+// The discount never exceeds the price itself.
+func (c Coupon) DiscountFor(price float64) float64 {
+	var discount float64
+	switch c.DiscountType {
+	case DiscountTypePercentage:
+		discount = price * c.Value / 100
+	case DiscountTypeFixed:
+		discount = c.Value
+	}
+	discount = math.Round(discount*100) / 100
+	return math.Min(discount, price)
+}
+
+func CreatedNewCouponRedemption(c *Coupon, email string, quantity int) *CouponRedemption {
+	return &CouponRedemption{
+		ID:        uuid.New().String(),
+		CouponID:  c.ID,
+		Email:     email,
+		Quantity:  quantity,
+		CreatedAt: time.Now().UTC(),
+	}
+}
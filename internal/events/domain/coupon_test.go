@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatedNewCoupon(t *testing.T) {
+	validFrom := time.Now().Add(-time.Hour)
+	validUntil := time.Now().Add(24 * time.Hour)
+	coupon, err := CreatedNewCoupon(" promo10 ", DiscountTypePercentage, 10, validFrom, validUntil, 100, 2, []string{"event-1"}, []TicketKind{TicketKindFull})
+	assert.Nil(t, err)
+	assert.NotNil(t, coupon)
+	assert.NotEmpty(t, coupon.ID)
+	assert.Equal(t, "PROMO10", coupon.Code)
+	assert.Equal(t, DiscountTypePercentage, coupon.DiscountType)
+	assert.Equal(t, 10.0, coupon.Value)
+	assert.Equal(t, 100, coupon.MaxUses)
+	assert.Equal(t, 2, coupon.MaxUsesPerEmail)
+	assert.Equal(t, 0, coupon.UsedCount)
+}
+
+func TestCoupon_Validate(t *testing.T) {
+	coupon := Coupon{
+		Code:         "",
+		DiscountType: DiscountTypeFixed,
+		Value:        10,
+		ValidFrom:    time.Now(),
+	}
+	err := coupon.Validate()
+	assert.Equal(t, ErrCouponCodeRequired, err)
+
+	coupon.Code = "PROMO"
+	coupon.DiscountType = "bogus"
+	err = coupon.Validate()
+	assert.Equal(t, ErrCouponTypeInvalid, err)
+
+	coupon.DiscountType = DiscountTypeFixed
+	coupon.Value = 0
+	err = coupon.Validate()
+	assert.Equal(t, ErrCouponValueInvalid, err)
+
+	coupon.DiscountType = DiscountTypePercentage
+	coupon.Value = 120
+	err = coupon.Validate()
+	assert.Equal(t, ErrCouponPercentageInvalid, err)
+
+	coupon.Value = 20
+	coupon.ValidUntil = coupon.ValidFrom.Add(-time.Hour)
+	err = coupon.Validate()
+	assert.Equal(t, ErrCouponValidityInvalid, err)
+
+	coupon.ValidUntil = time.Time{}
+	coupon.MaxUses = -1
+	err = coupon.Validate()
+	assert.Equal(t, ErrCouponUsageInvalid, err)
+
+	coupon.MaxUses = 0
+	coupon.TicketKinds = []TicketKind{"VIP!"}
+	err = coupon.Validate()
+	assert.Equal(t, ErrTicketStatusInvalid, err)
+
+	coupon.TicketKinds = nil
+	assert.Nil(t, coupon.Validate())
+}
+
+func TestCoupon_CheckApplicable(t *testing.T) {
+	now := time.Now()
+	coupon := Coupon{
+		Code:            "PROMO",
+		DiscountType:    DiscountTypeFixed,
+		Value:           10,
+		ValidFrom:       now.Add(-time.Hour),
+		ValidUntil:      now.Add(time.Hour),
+		MaxUses:         5,
+		MaxUsesPerEmail: 2,
+		UsedCount:       3,
+		EventIDs:        []string{"event-1"},
+		TicketKinds:     []TicketKind{TicketKindFull},
+	}
+
+	assert.Nil(t, coupon.CheckApplicable("event-1", TicketKindFull, 2, now))
+	assert.Equal(t, ErrCouponNotActive, coupon.CheckApplicable("event-1", TicketKindFull, 1, now.Add(2*time.Hour)))
+	assert.Equal(t, ErrCouponNotActive, coupon.CheckApplicable("event-1", TicketKindFull, 1, now.Add(-2*time.Hour)))
+	assert.Equal(t, ErrCouponNotApplicable, coupon.CheckApplicable("event-2", TicketKindFull, 1, now))
+	assert.Equal(t, ErrCouponNotApplicable, coupon.CheckApplicable("event-1", TicketKindHalf, 1, now))
+	assert.Equal(t, ErrCouponUsageLimitReached, coupon.CheckApplicable("event-1", TicketKindFull, 3, now))
+
+	coupon.UsedCount = 0
+	assert.Equal(t, ErrCouponEmailLimitReached, coupon.CheckApplicable("event-1", TicketKindFull, 3, now))
+}
+
+func TestCoupon_DiscountFor(t *testing.T) {
+	percentage := Coupon{DiscountType: DiscountTypePercentage, Value: 15}
+	assert.Equal(t, 15.0, percentage.DiscountFor(100))
+	assert.Equal(t, 3.75, percentage.DiscountFor(25))
+
+	fixed := Coupon{DiscountType: DiscountTypeFixed, Value: 30}
+	assert.Equal(t, 30.0, fixed.DiscountFor(100))
+	assert.Equal(t, 25.0, fixed.DiscountFor(25))
+}
+
+func TestTicket_ApplyCoupon(t *testing.T) {
+	event, _ := CreatedNewEvent("Event Test", "Location Test", "Organization Test", RatingFree, time.Now().Add(24*time.Hour), "image_url", 100, 50.00, 1)
+	spot, _ := CreatedNewSpot(*event, "A1")
+	ticket, err := CreatedNewTicket(event, spot, TicketKindFull)
+	assert.Nil(t, err)
+
+	coupon := &Coupon{ID: "coupon-1", DiscountType: DiscountTypePercentage, Value: 10}
+	ticket.ApplyCoupon(coupon)
+	assert.Equal(t, "coupon-1", ticket.CouponID)
+	assert.Equal(t, 50.00, ticket.Price)
+	assert.Equal(t, 5.00, ticket.Discount)
+	assert.Equal(t, 45.00, ticket.Total())
+}
@@ -13,8 +13,14 @@ var (
 	ErrEventCapacityInvalid = errors.New("event capacity must be greater than zero")
 	ErrEventPriceInvalid = errors.New("event price must be greater than zero")
 	ErrEventNotFound   = errors.New("event not found")
+	ErrEventCapacityExceeded = errors.New("event capacity exceeded")
+	ErrEventTimezoneInvalid = errors.New("event timezone must be a valid IANA time zone")
+	ErrEventRatingInvalid = errors.New("event rating must be L, L10, L12, L14, L16 or L18")
 )
 
+// DefaultEventTimezone is the timezone of events created without one
+const DefaultEventTimezone = "UTC"
+
 
 type Rating string
 
@@ -27,6 +33,32 @@ const (
 	Rating18 	Rating = "L18"
 )
 
+// ratingMinimumAges has the age an attendee must have on the event date for
+// each rating of the Brazilian classification
+var ratingMinimumAges = map[Rating]int{
+	RatingFree: 0,
+	Rating10:   10,
+	Rating12:   12,
+	Rating14:   14,
+	Rating16:   16,
+	Rating18:   18,
+}
+
+func (r Rating) IsValid() bool {
+	_, ok := ratingMinimumAges[r]
+	return ok
+}
+
+// MinimumAge returns the age required to attend an event with the rating
+func (r Rating) MinimumAge() int {
+	return ratingMinimumAges[r]
+}
+
+// Event is a show or match that sells tickets. Date is the instant the event
+// starts, kept in UTC; Timezone is the IANA time zone of the place where it
+// happens, used to show the date in local time. Status follows the event
+// lifecycle; PublishAt and OnSaleAt, when set, schedule its first steps.
+// ArchivedAt is set once a past event leaves the event listing.
 type Event struct {
 	ID           string `json:"id"`
 	Name         string `json:"name"`
@@ -40,6 +72,18 @@ type Event struct {
 	PartnerID    int `json:"partner_id"`
 	Spots        []Spot `json:"spots"`
 	Tickets			 []Ticket `json:"tickets"`
+	TicketCategories []TicketCategory `json:"ticket_categories"`
+	MaxTicketsPerBuyer int `json:"max_tickets_per_buyer"`
+	VenueID      string `json:"venue_id"`
+	Timezone     string `json:"timezone"`
+	SeriesID     string `json:"series_id"`
+	SeriesOverrides []string `json:"series_overrides"`
+	Coordinates  *GeoPoint `json:"coordinates"`
+	Status       EventStatus `json:"status"`
+	PublishAt    *time.Time `json:"publish_at"`
+	OnSaleAt     *time.Time `json:"on_sale_at"`
+	TransferDeadlineHours int `json:"transfer_deadline_hours"`
+	ArchivedAt   *time.Time `json:"archived_at"`
 }
 
 func CreatedNewEvent(name, location, organization string, rating Rating, date time.Time, imageURL string, capacity int, price float64, partnerID int) (*Event, error) {
@@ -49,11 +93,13 @@ func CreatedNewEvent(name, location, organization string, rating Rating, date ti
 		Location:     location,
 		Organization: organization,
 		Rating:       rating,
-		Date:         date,
+		Date:         date.UTC(),
 		ImageURL:     imageURL,
 		Capacity:     capacity,
 		Price:        price,
 		PartnerID:    partnerID,
+		Timezone:     DefaultEventTimezone,
+		Status:       EventStatusDraft,
 		Spots: 			make([]Spot, 0),
 	}
 	if err := event.Validade(); err != nil {
@@ -69,16 +115,68 @@ func (e Event) Validade() error {
 	if e.Date.Before(time.Now()) {
 		return ErrEventDateInFuture
 	}
+	return e.validateSettings()
+} 
+
+// validateSettings checks the event configuration other than its name and
+// date, shared with the event series
+func (e Event) validateSettings() error {
 	if e.Capacity <= 0 {
 		return ErrEventCapacityInvalid
 	}
 	if e.Price <=0 {
 		return ErrEventPriceInvalid
 	}
+	if e.MaxTicketsPerBuyer < 0 {
+		return ErrEventBuyerLimitInvalid
+	}
+	if _, err := e.TimeLocation(); err != nil {
+		return ErrEventTimezoneInvalid
+	}
+	if e.Coordinates != nil {
+		if err := e.Coordinates.Validate(); err != nil {
+			return err
+		}
+	}
+	if e.TransferDeadlineHours < 0 {
+		return ErrEventTransferDeadlineInvalid
+	}
+	if !e.Rating.IsValid() {
+		return ErrEventRatingInvalid
+	}
 	return nil
 } 
 
+// TimeLocation returns the time zone of the event. An empty Timezone is UTC;
+// "Local" is rejected because it depends on the server running the API.
+func (e Event) TimeLocation() (*time.Location, error) {
+	if e.Timezone == "Local" {
+		return nil, ErrEventTimezoneInvalid
+	}
+	return time.LoadLocation(e.Timezone)
+}
+
+// LocalDate returns the event date in the event time zone
+func (e Event) LocalDate() time.Time {
+	location, err := e.TimeLocation()
+	if err != nil {
+		return e.Date.UTC()
+	}
+	return e.Date.In(location)
+}
+
+// CheckSpotCapacity verifies the event can hold the given number of new spots
+func (e Event) CheckSpotCapacity(newSpots int) error {
+	if len(e.Spots)+newSpots > e.Capacity {
+		return ErrEventCapacityExceeded
+	}
+	return nil
+}
+
 func (e *Event) AddSpot(name string) (*Spot, error) {
+		if err := e.CheckSpotCapacity(1); err != nil {
+			return nil, err
+		}
 		spot, err := CreatedNewSpot(*e, name)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,218 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+var (
+	ErrEventStatusInvalid     = errors.New("event status is invalid")
+	ErrEventTransitionInvalid = errors.New("event status transition is not allowed")
+	ErrEventSoldOutAutomatic  = errors.New("sold out is set from the ticket sales and cannot be changed by hand")
+	ErrEventStillSoldOut      = errors.New("event has no tickets left to sell")
+	ErrEventAlreadyStarted    = errors.New("event has already started")
+	ErrEventNotStarted        = errors.New("event has not started yet")
+	ErrEventScheduleInvalid   = errors.New("publish time must not be after the on sale time and both must be before the event date")
+	ErrEventNotOnSale         = errors.New("event tickets are not on sale")
+	ErrEventStatusConflict    = errors.New("event status was changed by another request")
+)
+
+// EventStatus is the stage of the event lifecycle. Drafts are still being
+// prepared, published events are announced without selling tickets, and
+// finished and cancelled are final.
+type EventStatus string
+
+const (
+	EventStatusDraft     EventStatus = "draft"
+	EventStatusPublished EventStatus = "published"
+	EventStatusOnSale    EventStatus = "on_sale"
+	EventStatusSoldOut   EventStatus = "sold_out"
+	EventStatusFinished  EventStatus = "finished"
+	EventStatusCancelled EventStatus = "cancelled"
+)
+
+// EventArchiveAfter is how long after its date a finished or cancelled
+// event stays in the event listing before it is archived
+const EventArchiveAfter = 30 * 24 * time.Hour
+
+// EventReminderLead is how long before the event its ticket holders are
+// reminded of it
+const EventReminderLead = 24 * time.Hour
+
+// eventTransitions lists the statuses each status can move to. The move to
+// sold out is made by UpdateSoldOut only.
+var eventTransitions = map[EventStatus][]EventStatus{
+	EventStatusDraft:     {EventStatusPublished, EventStatusOnSale, EventStatusFinished, EventStatusCancelled},
+	EventStatusPublished: {EventStatusDraft, EventStatusOnSale, EventStatusFinished, EventStatusCancelled},
+	EventStatusOnSale:    {EventStatusPublished, EventStatusSoldOut, EventStatusFinished, EventStatusCancelled},
+	EventStatusSoldOut:   {EventStatusOnSale, EventStatusFinished, EventStatusCancelled},
+	EventStatusFinished:  {},
+	EventStatusCancelled: {},
+}
+
+func (s EventStatus) IsValid() bool {
+	_, ok := eventTransitions[s]
+	return ok
+}
+
+// IsFinal reports whether the event can no longer change status
+func (s EventStatus) IsFinal() bool {
+	return s == EventStatusFinished || s == EventStatusCancelled
+}
+
+// CanTransitionTo reports whether the lifecycle allows moving to status
+func (s EventStatus) CanTransitionTo(status EventStatus) bool {
+	return slices.Contains(eventTransitions[s], status)
+}
+
+// Schedule sets when a draft is published and when its tickets go on sale.
+// Either time may be nil to keep that step manual.
+func (e *Event) Schedule(publishAt, onSaleAt *time.Time) error {
+	if publishAt != nil && onSaleAt != nil && publishAt.After(*onSaleAt) {
+		return ErrEventScheduleInvalid
+	}
+	for _, at := range []*time.Time{publishAt, onSaleAt} {
+		if at != nil && !at.Before(e.Date) {
+			return ErrEventScheduleInvalid
+		}
+	}
+	e.PublishAt = utcTime(publishAt)
+	e.OnSaleAt = utcTime(onSaleAt)
+	return nil
+}
+
+// TransitionTo moves the event to status by hand. Publishing and opening the
+// sales require the event not to have started and finishing requires it to
+// have started. A sold out event may be put back on sale, as long as the
+// caller checked with IsSoldOut that tickets are left. Moving back to draft
+// or published drops the scheduled steps that are already due, so the
+// scheduler does not undo the change.
+func (e *Event) TransitionTo(status EventStatus, now time.Time) error {
+	if !status.IsValid() {
+		return ErrEventStatusInvalid
+	}
+	if status == EventStatusSoldOut {
+		return ErrEventSoldOutAutomatic
+	}
+	if err := e.transition(status, now); err != nil {
+		return err
+	}
+	if e.PublishAt != nil && !now.Before(*e.PublishAt) && status == EventStatusDraft {
+		e.PublishAt = nil
+	}
+	if e.OnSaleAt != nil && !now.Before(*e.OnSaleAt) && (status == EventStatusDraft || status == EventStatusPublished) {
+		e.OnSaleAt = nil
+	}
+	return nil
+}
+
+func (e *Event) transition(status EventStatus, now time.Time) error {
+	if !e.Status.CanTransitionTo(status) {
+		return fmt.Errorf("%w: %s to %s", ErrEventTransitionInvalid, e.Status, status)
+	}
+	switch status {
+	case EventStatusPublished, EventStatusOnSale:
+		if !now.Before(e.Date) {
+			return ErrEventAlreadyStarted
+		}
+	case EventStatusFinished:
+		if now.Before(e.Date) {
+			return ErrEventNotStarted
+		}
+	}
+	e.Status = status
+	return nil
+}
+
+// AdvanceLifecycle applies the scheduled steps that are due at now and
+// finishes the event once it has started. It reports whether the status
+// changed.
+func (e *Event) AdvanceLifecycle(now time.Time) bool {
+	previous := e.Status
+	if !e.Status.IsFinal() && !now.Before(e.Date) {
+		e.Status = EventStatusFinished
+		return true
+	}
+	isDue := func(at *time.Time) bool { return at != nil && !now.Before(*at) }
+
+	if e.Status == EventStatusDraft && (isDue(e.PublishAt) || isDue(e.OnSaleAt)) {
+		e.Status = EventStatusPublished
+	}
+	if e.Status == EventStatusPublished && isDue(e.OnSaleAt) {
+		e.Status = EventStatusOnSale
+	}
+	return e.Status != previous
+}
+
+// IsSoldOut reports whether no more tickets can be sold: the sales reached
+// the capacity or every spot of the event is sold. Held spots may be
+// released, so they do not make the event sold out.
+func (e Event) IsSoldOut(sales TicketSales) bool {
+	if sales.Total >= e.Capacity {
+		return true
+	}
+	if len(e.Spots) == 0 {
+		return false
+	}
+	for _, spot := range e.Spots {
+		if spot.SpotStatus == SpotStatusAvailable || spot.SpotStatus == SpotStatusHeld {
+			return false
+		}
+	}
+	return true
+}
+
+// UpdateSoldOut moves an event on sale to sold out when nothing is left to
+// sell, and back on sale when tickets become available again. It reports
+// whether the status changed.
+func (e *Event) UpdateSoldOut(sales TicketSales) bool {
+	soldOut := e.IsSoldOut(sales)
+	switch {
+	case e.Status == EventStatusOnSale && soldOut:
+		e.Status = EventStatusSoldOut
+	case e.Status == EventStatusSoldOut && !soldOut:
+		e.Status = EventStatusOnSale
+	default:
+		return false
+	}
+	return true
+}
+
+// Archive takes a finished or cancelled event out of the event listing once
+// EventArchiveAfter has passed since its date. It reports whether the event
+// was archived.
+func (e *Event) Archive(now time.Time) bool {
+	if e.ArchivedAt != nil || !e.Status.IsFinal() || now.Before(e.Date.Add(EventArchiveAfter)) {
+		return false
+	}
+	archivedAt := now.UTC()
+	e.ArchivedAt = &archivedAt
+	return true
+}
+
+// CheckOnSale verifies that tickets of the event can be sold at now
+func (e Event) CheckOnSale(now time.Time) error {
+	if e.Status != EventStatusOnSale {
+		return fmt.Errorf("%w: event is %s", ErrEventNotOnSale, e.Status)
+	}
+	if !now.Before(e.Date) {
+		return fmt.Errorf("%w: %w", ErrEventNotOnSale, ErrEventAlreadyStarted)
+	}
+	return nil
+}
+
+// IsSelling reports whether the event is on sale or sold out, the statuses
+// in which buyers may wait for released spots
+func (e Event) IsSelling() bool {
+	return e.Status == EventStatusOnSale || e.Status == EventStatusSoldOut
+}
+
+func utcTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
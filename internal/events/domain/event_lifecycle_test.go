@@ -0,0 +1,180 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newLifecycleEvent(t *testing.T, date time.Time) *Event {
+	event, err := CreatedNewEvent("Event Test", "Location Test", "Organization Test", RatingFree, date, "image_url", 2, 50.00, 1)
+	assert.Nil(t, err)
+	return event
+}
+
+func TestCreatedNewEventIsDraft(t *testing.T) {
+	event := newLifecycleEvent(t, time.Now().Add(24*time.Hour))
+	assert.Equal(t, EventStatusDraft, event.Status)
+	assert.ErrorIs(t, event.CheckOnSale(time.Now()), ErrEventNotOnSale)
+}
+
+func TestEventTransitionTo(t *testing.T) {
+	now := time.Now()
+	event := newLifecycleEvent(t, now.Add(24*time.Hour))
+
+	assert.Nil(t, event.TransitionTo(EventStatusPublished, now))
+	assert.Nil(t, event.TransitionTo(EventStatusOnSale, now))
+	assert.Nil(t, event.CheckOnSale(now))
+	assert.Equal(t, ErrEventSoldOutAutomatic, event.TransitionTo(EventStatusSoldOut, now))
+	assert.Equal(t, ErrEventNotStarted, event.TransitionTo(EventStatusFinished, now))
+	assert.Equal(t, ErrEventStatusInvalid, event.TransitionTo("closed", now))
+
+	assert.Nil(t, event.TransitionTo(EventStatusCancelled, now))
+	assert.ErrorIs(t, event.TransitionTo(EventStatusOnSale, now), ErrEventTransitionInvalid)
+	assert.ErrorIs(t, event.TransitionTo(EventStatusDraft, now), ErrEventTransitionInvalid)
+}
+
+func TestEventTransitionToStartedEvent(t *testing.T) {
+	now := time.Now()
+	event := newLifecycleEvent(t, now.Add(time.Hour))
+	later := now.Add(2 * time.Hour)
+
+	assert.Equal(t, ErrEventAlreadyStarted, event.TransitionTo(EventStatusPublished, later))
+	assert.Nil(t, event.TransitionTo(EventStatusFinished, later))
+	assert.True(t, event.Status.IsFinal())
+}
+
+func TestEventTransitionToDropsDueSchedule(t *testing.T) {
+	now := time.Now()
+	event := newLifecycleEvent(t, now.Add(48*time.Hour))
+	onSaleAt := now.Add(-time.Minute)
+	assert.Nil(t, event.Schedule(nil, &onSaleAt))
+	assert.True(t, event.AdvanceLifecycle(now))
+	assert.Equal(t, EventStatusOnSale, event.Status)
+
+	//suspender as vendas não pode ser desfeito pelo agendamento vencido
+	assert.Nil(t, event.TransitionTo(EventStatusPublished, now))
+	assert.Nil(t, event.OnSaleAt)
+	assert.False(t, event.AdvanceLifecycle(now))
+	assert.Equal(t, EventStatusPublished, event.Status)
+}
+
+func TestEventSchedule(t *testing.T) {
+	now := time.Now()
+	event := newLifecycleEvent(t, now.Add(48*time.Hour))
+	publishAt := now.Add(time.Hour)
+	onSaleAt := now.Add(2 * time.Hour)
+	afterEvent := now.Add(72 * time.Hour)
+
+	assert.Equal(t, ErrEventScheduleInvalid, event.Schedule(&onSaleAt, &publishAt))
+	assert.Equal(t, ErrEventScheduleInvalid, event.Schedule(nil, &afterEvent))
+	assert.Nil(t, event.Schedule(&publishAt, &onSaleAt))
+
+	assert.False(t, event.AdvanceLifecycle(now))
+	assert.Equal(t, EventStatusDraft, event.Status)
+	assert.True(t, event.AdvanceLifecycle(publishAt))
+	assert.Equal(t, EventStatusPublished, event.Status)
+	assert.True(t, event.AdvanceLifecycle(onSaleAt))
+	assert.Equal(t, EventStatusOnSale, event.Status)
+}
+
+func TestEventAdvanceLifecycleFinishes(t *testing.T) {
+	now := time.Now()
+	event := newLifecycleEvent(t, now.Add(time.Hour))
+	assert.Nil(t, event.TransitionTo(EventStatusOnSale, now))
+
+	assert.True(t, event.AdvanceLifecycle(now.Add(time.Hour)))
+	assert.Equal(t, EventStatusFinished, event.Status)
+	assert.ErrorIs(t, event.CheckOnSale(now), ErrEventNotOnSale)
+	assert.False(t, event.AdvanceLifecycle(now.Add(2*time.Hour)))
+
+	cancelled := newLifecycleEvent(t, now.Add(time.Hour))
+	assert.Nil(t, cancelled.TransitionTo(EventStatusCancelled, now))
+	assert.False(t, cancelled.AdvanceLifecycle(now.Add(2*time.Hour)))
+	assert.Equal(t, EventStatusCancelled, cancelled.Status)
+}
+
+func TestEventUpdateSoldOut(t *testing.T) {
+	now := time.Now()
+	event := newLifecycleEvent(t, now.Add(24*time.Hour))
+	assert.Nil(t, event.TransitionTo(EventStatusOnSale, now))
+
+	assert.False(t, event.UpdateSoldOut(TicketSales{Total: 1}))
+	assert.True(t, event.UpdateSoldOut(TicketSales{Total: 2}))
+	assert.Equal(t, EventStatusSoldOut, event.Status)
+	assert.ErrorIs(t, event.CheckOnSale(now), ErrEventNotOnSale)
+	assert.True(t, event.IsSelling())
+
+	assert.True(t, event.UpdateSoldOut(TicketSales{Total: 1}))
+	assert.Equal(t, EventStatusOnSale, event.Status)
+
+	//o organizador também pode reabrir as vendas
+	assert.True(t, event.UpdateSoldOut(TicketSales{Total: 2}))
+	assert.Nil(t, event.TransitionTo(EventStatusOnSale, now))
+	assert.Equal(t, EventStatusOnSale, event.Status)
+}
+
+func TestEventSoldOutReopensWhenSpotsAreReleased(t *testing.T) {
+	now := time.Now()
+	event := newLifecycleEvent(t, now.Add(24*time.Hour))
+	for _, name := range []string{"A1", "A2"} {
+		_, err := event.AddSpot(name)
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, event.TransitionTo(EventStatusOnSale, now))
+	sold, released := &event.Spots[0], &event.Spots[1]
+
+	assert.Nil(t, sold.ReserveSpot("ticket-1"))
+	assert.Nil(t, released.Block("Imprensa"))
+	assert.True(t, event.UpdateSoldOut(TicketSales{Total: 1}))
+	assert.Equal(t, EventStatusSoldOut, event.Status)
+
+	//o lugar desbloqueado volta à venda junto com o evento
+	assert.Nil(t, released.Unblock())
+	assert.True(t, event.UpdateSoldOut(TicketSales{Total: 1}))
+	assert.Equal(t, EventStatusOnSale, event.Status)
+
+	//a reserva que expira também não esgota o evento
+	assert.Nil(t, released.Hold("ana@example.com", now))
+	assert.False(t, event.UpdateSoldOut(TicketSales{Total: 1}))
+	released.ReleaseHold()
+
+	assert.Nil(t, event.CheckOnSale(now))
+	assert.Nil(t, released.CheckPurchasableBy("bia@example.com", now))
+	assert.Nil(t, released.ReserveSpot("ticket-2"))
+	assert.True(t, event.UpdateSoldOut(TicketSales{Total: 2}))
+	assert.Equal(t, EventStatusSoldOut, event.Status)
+}
+
+func TestEventIsSoldOutBySpots(t *testing.T) {
+	event := newLifecycleEvent(t, time.Now().Add(24*time.Hour))
+	_, err := event.AddSpot("A1")
+	assert.Nil(t, err)
+	assert.False(t, event.IsSoldOut(TicketSales{}))
+
+	event.Spots[0].SpotStatus = SpotStatusHeld
+	assert.False(t, event.IsSoldOut(TicketSales{}))
+
+	event.Spots[0].SpotStatus = SpotStatusReserved
+	assert.True(t, event.IsSoldOut(TicketSales{Total: 1}))
+}
+
+func TestEventArchive(t *testing.T) {
+	now := time.Now()
+	event := newLifecycleEvent(t, now.Add(24*time.Hour))
+
+	//eventos ainda não encerrados nunca são arquivados
+	assert.False(t, event.Archive(event.Date.Add(EventArchiveAfter)))
+
+	event.AdvanceLifecycle(event.Date)
+	assert.Equal(t, EventStatusFinished, event.Status)
+	assert.False(t, event.Archive(event.Date.Add(EventArchiveAfter-time.Minute)))
+	assert.Nil(t, event.ArchivedAt)
+
+	archivedAt := event.Date.Add(EventArchiveAfter)
+	assert.True(t, event.Archive(archivedAt))
+	assert.Equal(t, archivedAt.UTC(), *event.ArchivedAt)
+	assert.False(t, event.Archive(archivedAt.Add(time.Hour)))
+	assert.Equal(t, archivedAt.UTC(), *event.ArchivedAt)
+}
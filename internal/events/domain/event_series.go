@@ -0,0 +1,271 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrEventSeriesNotFound         = errors.New("event series not found")
+	ErrEventNotInSeries            = errors.New("event is not an occurrence of this series")
+	ErrSeriesFieldInvalid          = errors.New("series field must be name, location, organization, rating, image_url, capacity, price or max_tickets_per_buyer")
+	ErrSeriesCapacityBelowSpots    = errors.New("series capacity is lower than the spots of an occurrence")
+	ErrSeriesSpotsExceedCapacity   = errors.New("series spots exceed the series capacity")
+	ErrSeriesOccurrenceAlreadyPast = errors.New("past occurrences of a series cannot be changed")
+)
+
+// Fields of an occurrence that follow its series unless overridden
+const (
+	SeriesFieldName               = "name"
+	SeriesFieldLocation           = "location"
+	SeriesFieldOrganization       = "organization"
+	SeriesFieldRating             = "rating"
+	SeriesFieldImageURL           = "image_url"
+	SeriesFieldCapacity           = "capacity"
+	SeriesFieldPrice              = "price"
+	SeriesFieldMaxTicketsPerBuyer = "max_tickets_per_buyer"
+)
+
+var seriesFields = []string{
+	SeriesFieldName, SeriesFieldLocation, SeriesFieldOrganization, SeriesFieldRating,
+	SeriesFieldImageURL, SeriesFieldCapacity, SeriesFieldPrice, SeriesFieldMaxTicketsPerBuyer,
+}
+
+// EventSeries is a show repeated on the dates of a recurrence rule. Each
+// occurrence is an Event created with the series configuration, spots and
+// ticket categories. Later changes to the series apply to the future
+// occurrences, except for the fields an occurrence overrides.
+type EventSeries struct {
+	ID                 string           `json:"id"`
+	Name               string           `json:"name"`
+	Location           string           `json:"location"`
+	Organization       string           `json:"organization"`
+	Rating             Rating           `json:"rating"`
+	ImageURL           string           `json:"image_url"`
+	Capacity           int              `json:"capacity"`
+	Price              float64          `json:"price"`
+	PartnerID          int              `json:"partner_id"`
+	MaxTicketsPerBuyer int              `json:"max_tickets_per_buyer"`
+	VenueID            string           `json:"venue_id"`
+	Timezone           string           `json:"timezone"`
+	Start              time.Time        `json:"start"`
+	Recurrence         string           `json:"recurrence"`
+	NumberOfSpots      int              `json:"number_of_spots"`
+	Layout             SeatLayout       `json:"layout"`
+	TicketCategories   []TicketCategory `json:"ticket_categories"`
+	Coordinates        *GeoPoint        `json:"coordinates"`
+}
+
+func CreatedNewEventSeries(name, location, organization string, rating Rating, start time.Time, timezone, recurrence, imageURL string, capacity int, price float64, partnerID int) (*EventSeries, error) {
+	series := &EventSeries{
+		ID:               uuid.New().String(),
+		Name:             name,
+		Location:         location,
+		Organization:     organization,
+		Rating:           rating,
+		ImageURL:         imageURL,
+		Capacity:         capacity,
+		Price:            price,
+		PartnerID:        partnerID,
+		Timezone:         timezone,
+		Start:            start.UTC(),
+		Recurrence:       recurrence,
+		TicketCategories: []TicketCategory{},
+	}
+	if series.Timezone == "" {
+		series.Timezone = DefaultEventTimezone
+	}
+	if series.Start.Before(time.Now()) {
+		return nil, ErrEventDateInFuture
+	}
+	if err := series.Validate(); err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+// Validate checks the configuration shared by the occurrences and normalizes
+// the recurrence rule. The start is only checked when the series is created,
+// so series already running can still be changed.
+func (s *EventSeries) Validate() error {
+	if s.Name == "" {
+		return ErrEventNameRequired
+	}
+	template := s.template(s.Start)
+	if err := template.validateSettings(); err != nil {
+		return err
+	}
+	for _, category := range s.TicketCategories {
+		_, err := template.AddTicketCategory(category.Kind, category.Name, category.PriceMultiplier,
+			category.Requirements, category.Quota, category.QuotaPercent, category.PartnerTicketKind)
+		if err != nil {
+			return err
+		}
+	}
+	rule, err := ParseRecurrenceRule(s.Recurrence)
+	if err != nil {
+		return err
+	}
+	s.Recurrence = rule.String()
+
+	if s.NumberOfSpots < 0 || s.NumberOfSpots > s.Capacity {
+		return ErrSeriesSpotsExceedCapacity
+	}
+	if s.HasLayout() {
+		if err := s.Layout.Validate(); err != nil {
+			return err
+		}
+		if s.Layout.SeatCount() > s.Capacity {
+			return ErrSeriesSpotsExceedCapacity
+		}
+	}
+	return nil
+}
+
+// HasLayout reports whether the occurrences get their spots from a seat layout
+func (s EventSeries) HasLayout() bool {
+	return len(s.Layout.Sections) > 0
+}
+
+// Occurrences returns the dates of the series, in UTC
+func (s EventSeries) Occurrences() ([]time.Time, error) {
+	rule, err := ParseRecurrenceRule(s.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+	location, err := s.template(s.Start).TimeLocation()
+	if err != nil {
+		return nil, ErrEventTimezoneInvalid
+	}
+	return rule.Occurrences(s.Start.In(location))
+}
+
+// NewOccurrence creates the event of the series at date, with the series
+// ticket categories. Spots are generated separately by the spot service.
+func (s EventSeries) NewOccurrence(date time.Time) (*Event, error) {
+	event := s.template(date)
+	event.ID = uuid.New().String()
+	if err := event.Validade(); err != nil {
+		return nil, err
+	}
+	for _, category := range s.TicketCategories {
+		_, err := event.AddTicketCategory(category.Kind, category.Name, category.PriceMultiplier,
+			category.Requirements, category.Quota, category.QuotaPercent, category.PartnerTicketKind)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return event, nil
+}
+
+// ApplyTo copies the series configuration to an occurrence, keeping the
+// fields the occurrence overrides.
+func (s EventSeries) ApplyTo(e *Event) error {
+	if e.SeriesID != s.ID {
+		return ErrEventNotInSeries
+	}
+	template := s.template(e.Date)
+	for _, field := range seriesFields {
+		if !e.IsSeriesFieldOverridden(field) {
+			e.setSeriesField(field, template)
+		}
+	}
+	if len(e.Spots) > e.Capacity {
+		return ErrSeriesCapacityBelowSpots
+	}
+	return e.Validade()
+}
+
+// template is the event the series configuration describes at date
+func (s EventSeries) template(date time.Time) *Event {
+	return &Event{
+		Name:               s.Name,
+		Location:           s.Location,
+		Organization:       s.Organization,
+		Rating:             s.Rating,
+		Date:               date.UTC(),
+		ImageURL:           s.ImageURL,
+		Capacity:           s.Capacity,
+		Price:              s.Price,
+		PartnerID:          s.PartnerID,
+		MaxTicketsPerBuyer: s.MaxTicketsPerBuyer,
+		VenueID:            s.VenueID,
+		Timezone:           s.Timezone,
+		Coordinates:        s.coordinates(),
+		SeriesID:           s.ID,
+		SeriesOverrides:    []string{},
+		Status:             EventStatusDraft,
+		Spots:              make([]Spot, 0),
+	}
+}
+
+// coordinates returns a copy of the series coordinates for an occurrence
+func (s EventSeries) coordinates() *GeoPoint {
+	if s.Coordinates == nil {
+		return nil
+	}
+	coordinates := *s.Coordinates
+	return &coordinates
+}
+
+// IsValidSeriesField reports whether an occurrence can override the field
+func IsValidSeriesField(field string) bool {
+	return slices.Contains(seriesFields, field)
+}
+
+// IsSeriesFieldOverridden reports whether the occurrence keeps its own value
+// for the field when the series changes
+func (e Event) IsSeriesFieldOverridden(field string) bool {
+	return slices.Contains(e.SeriesOverrides, field)
+}
+
+// OverrideSeriesField marks the field as set on the occurrence itself
+func (e *Event) OverrideSeriesField(field string) error {
+	if e.SeriesID == "" {
+		return ErrEventNotInSeries
+	}
+	if !IsValidSeriesField(field) {
+		return ErrSeriesFieldInvalid
+	}
+	if !e.IsSeriesFieldOverridden(field) {
+		e.SeriesOverrides = append(e.SeriesOverrides, field)
+	}
+	return nil
+}
+
+// ResetSeriesField makes the field follow the series again
+func (e *Event) ResetSeriesField(series EventSeries, field string) error {
+	if e.SeriesID != series.ID {
+		return ErrEventNotInSeries
+	}
+	if !IsValidSeriesField(field) {
+		return ErrSeriesFieldInvalid
+	}
+	e.SeriesOverrides = slices.DeleteFunc(e.SeriesOverrides, func(f string) bool { return f == field })
+	e.setSeriesField(field, series.template(e.Date))
+	return nil
+}
+
+func (e *Event) setSeriesField(field string, from *Event) {
+	switch field {
+	case SeriesFieldName:
+		e.Name = from.Name
+	case SeriesFieldLocation:
+		e.Location = from.Location
+	case SeriesFieldOrganization:
+		e.Organization = from.Organization
+	case SeriesFieldRating:
+		e.Rating = from.Rating
+	case SeriesFieldImageURL:
+		e.ImageURL = from.ImageURL
+	case SeriesFieldCapacity:
+		e.Capacity = from.Capacity
+	case SeriesFieldPrice:
+		e.Price = from.Price
+	case SeriesFieldMaxTicketsPerBuyer:
+		e.MaxTicketsPerBuyer = from.MaxTicketsPerBuyer
+	}
+}
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestEventSeries(t *testing.T, recurrence string) *EventSeries {
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	series, err := CreatedNewEventSeries("Show", "Theater", "Organization", RatingFree, start, "America/Sao_Paulo", recurrence, "image_url", 100, 50.00, 1)
+	assert.Nil(t, err)
+	return series
+}
+
+func TestCreatedNewEventSeries(t *testing.T) {
+	series := newTestEventSeries(t, "RRULE:FREQ=DAILY;COUNT=3")
+	assert.NotEmpty(t, series.ID)
+	assert.Equal(t, "FREQ=DAILY;COUNT=3", series.Recurrence)
+	assert.Equal(t, time.UTC, series.Start.Location())
+	assert.Empty(t, series.TicketCategories)
+
+	start := time.Now().Add(24 * time.Hour)
+	_, err := CreatedNewEventSeries("Show", "Theater", "Organization", RatingFree, start, "", "FREQ=MONTHLY;COUNT=3", "image_url", 100, 50.00, 1)
+	assert.Equal(t, ErrRecurrenceFrequencyInvalid, err)
+
+	_, err = CreatedNewEventSeries("", "Theater", "Organization", RatingFree, start, "", "FREQ=DAILY;COUNT=3", "image_url", 100, 50.00, 1)
+	assert.Equal(t, ErrEventNameRequired, err)
+
+	_, err = CreatedNewEventSeries("Show", "Theater", "Organization", RatingFree, start, "", "FREQ=DAILY;COUNT=3", "image_url", 0, 50.00, 1)
+	assert.Equal(t, ErrEventCapacityInvalid, err)
+
+	_, err = CreatedNewEventSeries("Show", "Theater", "Organization", RatingFree, time.Now().Add(-time.Hour), "", "FREQ=DAILY;COUNT=3", "image_url", 100, 50.00, 1)
+	assert.Equal(t, ErrEventDateInFuture, err)
+}
+
+func TestEventSeries_Validate(t *testing.T) {
+	series := newTestEventSeries(t, "FREQ=DAILY;COUNT=3")
+
+	series.NumberOfSpots = 101
+	assert.Equal(t, ErrSeriesSpotsExceedCapacity, series.Validate())
+	series.NumberOfSpots = 10
+	assert.Nil(t, series.Validate())
+
+	series.TicketCategories = []TicketCategory{{Kind: "vip", Name: "VIP", PriceMultiplier: 2}, {Kind: "vip", Name: "VIP 2", PriceMultiplier: 3}}
+	assert.Equal(t, ErrTicketCategoryDuplicated, series.Validate())
+	series.TicketCategories = series.TicketCategories[:1]
+	assert.Nil(t, series.Validate())
+
+	//series already running can still be changed
+	series.Start = time.Now().Add(-24 * time.Hour)
+	assert.Nil(t, series.Validate())
+}
+
+func TestEventSeries_NewOccurrence(t *testing.T) {
+	series := newTestEventSeries(t, "FREQ=WEEKLY;COUNT=3")
+	series.MaxTicketsPerBuyer = 4
+	series.TicketCategories = []TicketCategory{{Kind: "vip", Name: "VIP", PriceMultiplier: 2}}
+
+	dates, err := series.Occurrences()
+	assert.Nil(t, err)
+	assert.Len(t, dates, 3)
+	assert.Equal(t, series.Start, dates[0])
+	assert.Equal(t, dates[0].AddDate(0, 0, 14), dates[2])
+
+	first, err := series.NewOccurrence(dates[0])
+	assert.Nil(t, err)
+	second, err := series.NewOccurrence(dates[1])
+	assert.Nil(t, err)
+	assert.NotEqual(t, first.ID, second.ID)
+	assert.Equal(t, series.ID, first.SeriesID)
+	assert.Equal(t, "America/Sao_Paulo", first.Timezone)
+	assert.Equal(t, 4, first.MaxTicketsPerBuyer)
+	assert.Len(t, first.TicketCategories, 1)
+	assert.Equal(t, first.ID, first.TicketCategories[0].EventID)
+	assert.Empty(t, first.SeriesOverrides)
+}
+
+func TestEventSeries_ApplyToKeepsOverrides(t *testing.T) {
+	series := newTestEventSeries(t, "FREQ=DAILY;COUNT=2")
+	event, err := series.NewOccurrence(series.Start)
+	assert.Nil(t, err)
+
+	event.Price = 80
+	assert.Nil(t, event.OverrideSeriesField(SeriesFieldPrice))
+	assert.Nil(t, event.OverrideSeriesField(SeriesFieldPrice))
+	assert.Equal(t, []string{SeriesFieldPrice}, event.SeriesOverrides)
+	assert.Equal(t, ErrSeriesFieldInvalid, event.OverrideSeriesField("date"))
+
+	series.Name = "Show Extra"
+	series.Price = 60
+	assert.Nil(t, series.ApplyTo(event))
+	assert.Equal(t, "Show Extra", event.Name)
+	assert.Equal(t, 80.00, event.Price)
+
+	assert.Nil(t, event.ResetSeriesField(*series, SeriesFieldPrice))
+	assert.Equal(t, 60.00, event.Price)
+	assert.Empty(t, event.SeriesOverrides)
+}
+
+func TestEventSeries_ApplyToChecksSpots(t *testing.T) {
+	series := newTestEventSeries(t, "FREQ=DAILY;COUNT=2")
+	event, err := series.NewOccurrence(series.Start)
+	assert.Nil(t, err)
+	assert.Nil(t, NewSpotService().GenerateSpots(event, 20))
+
+	series.Capacity = 10
+	assert.Equal(t, ErrSeriesCapacityBelowSpots, series.ApplyTo(event))
+
+	other := &Event{SeriesID: "another-series"}
+	assert.Equal(t, ErrEventNotInSeries, series.ApplyTo(other))
+	assert.Equal(t, ErrEventNotInSeries, (&Event{}).OverrideSeriesField(SeriesFieldName))
+}
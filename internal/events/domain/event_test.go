@@ -67,4 +67,95 @@ func TestEvent_AddSpot(t *testing.T) {
 	assert.Equal(t, event.ID, spot.EventID)
 	assert.Equal(t, SpotStatusAvailable, spot.SpotStatus)
 	assert.Equal(t, 1, len(event.Spots))
-}
\ No newline at end of file
+}
+
+func TestEvent_CheckSpotCapacity(t *testing.T) {
+	event, err := CreatedNewEvent("Event Test", "Location Test", "Organization Test", RatingFree, time.Now().Add(24*time.Hour), "image_url", 2, 50.00, 1)
+	assert.Nil(t, err)
+
+	assert.Nil(t, event.CheckSpotCapacity(2))
+	assert.Equal(t, ErrEventCapacityExceeded, event.CheckSpotCapacity(3))
+
+	_, err = event.AddSpot("A1")
+	assert.Nil(t, err)
+	_, err = event.AddSpot("A2")
+	assert.Nil(t, err)
+	_, err = event.AddSpot("A3")
+	assert.Equal(t, ErrEventCapacityExceeded, err)
+	assert.Equal(t, 2, len(event.Spots))
+
+	err = NewSpotService().GenerateSpots(event, 1)
+	assert.Equal(t, ErrEventCapacityExceeded, err)
+}
+
+func TestCreatedNewEvent_StoresDateInUTC(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	assert.Nil(t, err)
+	date := time.Now().Add(24 * time.Hour).In(saoPaulo)
+
+	event, err := CreatedNewEvent("Event Test", "Location Test", "Organization Test", RatingFree, date, "image_url", 100, 50.00, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, time.UTC, event.Date.Location())
+	assert.True(t, date.Equal(event.Date))
+	assert.Equal(t, DefaultEventTimezone, event.Timezone)
+}
+
+func TestEvent_TimeLocation(t *testing.T) {
+	event := Event{Timezone: "America/Sao_Paulo"}
+	location, err := event.TimeLocation()
+	assert.Nil(t, err)
+	assert.Equal(t, "America/Sao_Paulo", location.String())
+
+	event.Timezone = ""
+	location, err = event.TimeLocation()
+	assert.Nil(t, err)
+	assert.Equal(t, time.UTC, location)
+
+	event.Timezone = "Local"
+	_, err = event.TimeLocation()
+	assert.Equal(t, ErrEventTimezoneInvalid, err)
+
+	event.Timezone = "Mars/Olympus_Mons"
+	_, err = event.TimeLocation()
+	assert.NotNil(t, err)
+}
+
+func TestEvent_ValidateTimezone(t *testing.T) {
+	event := Event{
+		Name:     "Event Test",
+		Rating:   RatingFree,
+		Date:     time.Now().Add(24 * time.Hour),
+		Capacity: 100,
+		Price:    50.00,
+		Timezone: "Mars/Olympus_Mons",
+	}
+	assert.Equal(t, ErrEventTimezoneInvalid, event.Validade())
+
+	event.Timezone = "Europe/Lisbon"
+	assert.Nil(t, event.Validade())
+}
+
+func TestEvent_LocalDate(t *testing.T) {
+	event := Event{
+		Date:     time.Date(2030, 1, 15, 23, 30, 0, 0, time.UTC),
+		Timezone: "America/Sao_Paulo",
+	}
+	local := event.LocalDate()
+	assert.True(t, event.Date.Equal(local))
+	assert.Equal(t, "2030-01-15T20:30:00-03:00", local.Format(time.RFC3339))
+
+	event.Timezone = "Asia/Tokyo"
+	assert.Equal(t, "2030-01-16T08:30:00+09:00", event.LocalDate().Format(time.RFC3339))
+
+	event.Timezone = ""
+	assert.Equal(t, "2030-01-15T23:30:00Z", event.LocalDate().Format(time.RFC3339))
+}
+
+func TestEvent_ValidateRating(t *testing.T) {
+	_, err := CreatedNewEvent("Event Test", "Location Test", "Organization Test", Rating("PG-13"), time.Now().Add(24*time.Hour), "image_url", 100, 50.00, 1)
+	assert.Equal(t, ErrEventRatingInvalid, err)
+
+	assert.True(t, Rating16.IsValid())
+	assert.Equal(t, 16, Rating16.MinimumAge())
+	assert.Equal(t, 0, RatingFree.MinimumAge())
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	ErrGeoPointInvalid     = errors.New("latitude must be between -90 and 90 and longitude between -180 and 180")
+	ErrNearbyRadiusInvalid = errors.New("radius must be greater than zero and at most 500 km")
+)
+
+const (
+	EarthRadiusKm         = 6371.0
+	DefaultNearbyRadiusKm = 50.0
+	MaxNearbyRadiusKm     = 500.0
+)
+
+// GeoPoint is a position in decimal degrees
+type GeoPoint struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+func (p GeoPoint) Validate() error {
+	if p.Latitude < -90 || p.Latitude > 90 || p.Longitude < -180 || p.Longitude > 180 {
+		return ErrGeoPointInvalid
+	}
+	return nil
+}
+
+// DistanceKm returns the great-circle distance to other with the haversine formula
+func (p GeoPoint) DistanceKm(other GeoPoint) float64 {
+	lat1, lat2 := degreesToRadians(p.Latitude), degreesToRadians(other.Latitude)
+	dLat := lat2 - lat1
+	dLng := degreesToRadians(other.Longitude - p.Longitude)
+
+	a := math.Pow(math.Sin(dLat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dLng/2), 2)
+	return 2 * EarthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// BoundingBox returns the south-west and north-east corners of a box that
+// contains every point within radiusKm, used to narrow the search before
+// computing distances. Longitudes are not wrapped at the antimeridian.
+func (p GeoPoint) BoundingBox(radiusKm float64) (GeoPoint, GeoPoint) {
+	dLat := radiusKm / EarthRadiusKm * 180 / math.Pi
+	dLng := 180.0
+	if cos := math.Cos(degreesToRadians(p.Latitude)); cos > 1e-9 {
+		dLng = math.Min(180, dLat/cos)
+	}
+	southWest := GeoPoint{Latitude: math.Max(-90, p.Latitude-dLat), Longitude: math.Max(-180, p.Longitude-dLng)}
+	northEast := GeoPoint{Latitude: math.Min(90, p.Latitude+dLat), Longitude: math.Min(180, p.Longitude+dLng)}
+	return southWest, northEast
+}
+
+// CheckNearbyRadius returns the radius to search, DefaultNearbyRadiusKm when radiusKm is zero
+func CheckNearbyRadius(radiusKm float64) (float64, error) {
+	if radiusKm == 0 {
+		return DefaultNearbyRadiusKm, nil
+	}
+	if radiusKm < 0 || radiusKm > MaxNearbyRadiusKm || math.IsNaN(radiusKm) {
+		return 0, ErrNearbyRadiusInvalid
+	}
+	return radiusKm, nil
+}
+
+// NearbyEvent is an event found by a location search with its distance
+type NearbyEvent struct {
+	Event      Event
+	DistanceKm float64
+}
+
+func degreesToRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
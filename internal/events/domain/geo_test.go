@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeoPointValidate(t *testing.T) {
+	assert.Nil(t, GeoPoint{Latitude: -23.5505, Longitude: -46.6333}.Validate())
+	assert.Nil(t, GeoPoint{Latitude: 90, Longitude: -180}.Validate())
+	assert.Equal(t, ErrGeoPointInvalid, GeoPoint{Latitude: 91, Longitude: 0}.Validate())
+	assert.Equal(t, ErrGeoPointInvalid, GeoPoint{Latitude: 0, Longitude: 180.5}.Validate())
+}
+
+func TestGeoPointDistanceKm(t *testing.T) {
+	saoPaulo := GeoPoint{Latitude: -23.5505, Longitude: -46.6333}
+	rio := GeoPoint{Latitude: -22.9068, Longitude: -43.1729}
+
+	assert.InDelta(t, 361, saoPaulo.DistanceKm(rio), 1)
+	assert.InDelta(t, saoPaulo.DistanceKm(rio), rio.DistanceKm(saoPaulo), 1e-9)
+	assert.Equal(t, 0.0, saoPaulo.DistanceKm(saoPaulo))
+}
+
+func TestGeoPointBoundingBox(t *testing.T) {
+	center := GeoPoint{Latitude: -23.5505, Longitude: -46.6333}
+	southWest, northEast := center.BoundingBox(50)
+
+	for _, bearing := range []float64{0, 45, 90, 135, 180, 225, 270, 315} {
+		//ponto a 49.9 km do centro na direção bearing
+		angular := 49.9 / EarthRadiusKm
+		lat1, lng1 := degreesToRadians(center.Latitude), degreesToRadians(center.Longitude)
+		theta := degreesToRadians(bearing)
+		lat2 := math.Asin(math.Sin(lat1)*math.Cos(angular) + math.Cos(lat1)*math.Sin(angular)*math.Cos(theta))
+		lng2 := lng1 + math.Atan2(math.Sin(theta)*math.Sin(angular)*math.Cos(lat1), math.Cos(angular)-math.Sin(lat1)*math.Sin(lat2))
+		point := GeoPoint{Latitude: lat2 * 180 / math.Pi, Longitude: lng2 * 180 / math.Pi}
+
+		assert.True(t, point.Latitude >= southWest.Latitude && point.Latitude <= northEast.Latitude)
+		assert.True(t, point.Longitude >= southWest.Longitude && point.Longitude <= northEast.Longitude)
+	}
+
+	southWest, northEast = GeoPoint{Latitude: 90, Longitude: 0}.BoundingBox(10)
+	assert.Equal(t, -180.0, southWest.Longitude)
+	assert.Equal(t, 180.0, northEast.Longitude)
+}
+
+func TestCheckNearbyRadius(t *testing.T) {
+	radius, err := CheckNearbyRadius(0)
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultNearbyRadiusKm, radius)
+
+	radius, err = CheckNearbyRadius(12.5)
+	assert.Nil(t, err)
+	assert.Equal(t, 12.5, radius)
+
+	_, err = CheckNearbyRadius(-1)
+	assert.Equal(t, ErrNearbyRadiusInvalid, err)
+	_, err = CheckNearbyRadius(MaxNearbyRadiusKm + 1)
+	assert.Equal(t, ErrNearbyRadiusInvalid, err)
+}
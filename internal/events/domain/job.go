@@ -0,0 +1,335 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrJobNameInvalid     = errors.New("job name must have 1 to 60 letters, digits, '-', '_' or ':'")
+	ErrJobScheduleInvalid = errors.New("job schedule must be a cron expression with 5 fields, a descriptor such as @daily or @every <duration>")
+	ErrJobNotFound        = errors.New("job not found")
+	ErrJobHandlerNotFound = errors.New("job handler is not registered")
+	ErrJobRunAtInvalid    = errors.New("job run time is invalid")
+)
+
+type JobStatus string
+
+const (
+	// JobStatusActive jobs run whenever NextRunAt is due
+	JobStatusActive JobStatus = "active"
+	// JobStatusDone is the status of a one-off job after its run
+	JobStatusDone JobStatus = "done"
+)
+
+// Job is a task the scheduler runs in the background. Recurring jobs follow
+// Schedule, a cron expression evaluated in UTC; one-off jobs have no
+// schedule, run once at NextRunAt and are then done. Handler names the
+// function that does the work, so one handler may back several jobs.
+type Job struct {
+	Name      string     `json:"name"`
+	Handler   string     `json:"handler"`
+	Schedule  string     `json:"schedule"`
+	Status    JobStatus  `json:"status"`
+	NextRunAt time.Time  `json:"next_run_at"`
+	LastRunAt *time.Time `json:"last_run_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// CreatedNewJob creates a recurring job named after its handler, first due
+// at the next time of schedule after now
+func CreatedNewJob(name, schedule string, now time.Time) (*Job, error) {
+	if !isValidJobName(name) {
+		return nil, ErrJobNameInvalid
+	}
+	parsed, err := ParseJobSchedule(schedule)
+	if err != nil {
+		return nil, err
+	}
+	return &Job{
+		Name:      name,
+		Handler:   name,
+		Schedule:  schedule,
+		Status:    JobStatusActive,
+		NextRunAt: parsed.Next(now),
+		CreatedAt: now.UTC(),
+	}, nil
+}
+
+// CreatedNewOneOffJob creates a job that runs handler once at runAt. Its
+// name is unique, so the same handler may be scheduled many times.
+func CreatedNewOneOffJob(handler string, runAt, now time.Time) (*Job, error) {
+	if !isValidJobName(handler) || len(handler) > 51 {
+		return nil, ErrJobNameInvalid
+	}
+	if runAt.IsZero() {
+		return nil, ErrJobRunAtInvalid
+	}
+	return &Job{
+		Name:      handler + ":" + uuid.New().String()[:8],
+		Handler:   handler,
+		Status:    JobStatusActive,
+		NextRunAt: runAt.UTC(),
+		CreatedAt: now.UTC(),
+	}, nil
+}
+
+func isValidJobName(name string) bool {
+	if name == "" || len(name) > 60 {
+		return false
+	}
+	for _, r := range name {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' || r == ':') {
+			return false
+		}
+	}
+	return true
+}
+
+// IsOneOff reports whether the job runs only once
+func (j Job) IsOneOff() bool {
+	return j.Schedule == ""
+}
+
+// IsDue reports whether the job should run at now
+func (j Job) IsDue(now time.Time) bool {
+	return j.Status == JobStatusActive && !now.Before(j.NextRunAt)
+}
+
+// Reschedule changes the schedule of a recurring job. The next run moves
+// only when the schedule changed, so restarting the server with the same
+// jobs keeps their pending runs.
+func (j *Job) Reschedule(schedule string, now time.Time) error {
+	parsed, err := ParseJobSchedule(schedule)
+	if err != nil {
+		return err
+	}
+	if schedule == j.Schedule && j.Status == JobStatusActive {
+		return nil
+	}
+	j.Schedule = schedule
+	j.Status = JobStatusActive
+	j.NextRunAt = parsed.Next(now)
+	return nil
+}
+
+// Finish records a run that started at startedAt and schedules the next
+// one. Runs missed while the job was late are skipped, so a job runs at most
+// once per due time however long the scheduler was stopped.
+func (j *Job) Finish(startedAt, now time.Time) error {
+	lastRunAt := startedAt.UTC()
+	j.LastRunAt = &lastRunAt
+	if j.IsOneOff() {
+		j.Status = JobStatusDone
+		return nil
+	}
+	parsed, err := ParseJobSchedule(j.Schedule)
+	if err != nil {
+		return err
+	}
+	j.NextRunAt = parsed.Next(now)
+	return nil
+}
+
+type JobRunStatus string
+
+const (
+	JobRunStatusRunning   JobRunStatus = "running"
+	JobRunStatusSucceeded JobRunStatus = "succeeded"
+	JobRunStatusFailed    JobRunStatus = "failed"
+)
+
+// JobRun is an execution of a job, kept as its run history. Runner is the
+// instance that held the job lock during the run.
+type JobRun struct {
+	ID         string       `json:"id"`
+	JobName    string       `json:"job_name"`
+	Runner     string       `json:"runner"`
+	Status     JobRunStatus `json:"status"`
+	Output     string       `json:"output"`
+	Error      string       `json:"error"`
+	StartedAt  time.Time    `json:"started_at"`
+	FinishedAt *time.Time   `json:"finished_at"`
+}
+
+func CreatedNewJobRun(job Job, runner string, now time.Time) *JobRun {
+	return &JobRun{
+		ID:        uuid.New().String(),
+		JobName:   job.Name,
+		Runner:    runner,
+		Status:    JobRunStatusRunning,
+		StartedAt: now.UTC(),
+	}
+}
+
+// Finish records the outcome of the run
+func (r *JobRun) Finish(output string, err error, now time.Time) {
+	finishedAt := now.UTC()
+	r.FinishedAt = &finishedAt
+	r.Output = output
+	if err != nil {
+		r.Status = JobRunStatusFailed
+		r.Error = err.Error()
+		return
+	}
+	r.Status = JobRunStatusSucceeded
+}
+
+// JobSchedule computes the runs of a recurring job
+type JobSchedule interface {
+	// Next returns the first run strictly after after
+	Next(after time.Time) time.Time
+}
+
+// jobScheduleDescriptors are the cron shorthands accepted by ParseJobSchedule
+var jobScheduleDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// ParseJobSchedule reads a cron expression with the minute, hour, day of
+// month, month and day of week fields, each a "*", a value, a range "a-b"
+// or a list of those separated by commas, optionally with a "/step". The
+// descriptors @yearly, @monthly, @weekly, @daily and @hourly and the
+// interval "@every 30s" are also accepted.
+func ParseJobSchedule(spec string) (JobSchedule, error) {
+	spec = strings.TrimSpace(spec)
+	if every, ok := strings.CutPrefix(spec, "@every "); ok {
+		interval, err := time.ParseDuration(strings.TrimSpace(every))
+		if err != nil || interval < time.Second {
+			return nil, ErrJobScheduleInvalid
+		}
+		return everySchedule{interval: interval}, nil
+	}
+	if expression, ok := jobScheduleDescriptors[spec]; ok {
+		spec = expression
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, ErrJobScheduleInvalid
+	}
+	schedule := cronSchedule{}
+	bounds := [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+	sets := [5]*uint64{&schedule.minutes, &schedule.hours, &schedule.days, &schedule.months, &schedule.weekdays}
+	for i, field := range fields {
+		set, err := parseCronField(field, bounds[i][0], bounds[i][1])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrJobScheduleInvalid, field)
+		}
+		*sets[i] = set
+	}
+	//domingo pode ser escrito como 0 ou 7
+	if schedule.weekdays&(1<<7) != 0 {
+		schedule.weekdays |= 1
+	}
+	schedule.anyDay = fields[2] == "*"
+	schedule.anyWeekday = fields[4] == "*"
+	//datas que não existem, como 30 de fevereiro, nunca rodariam
+	if schedule.Next(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)).IsZero() {
+		return nil, ErrJobScheduleInvalid
+	}
+	return schedule, nil
+}
+
+// parseCronField returns the values the field matches as a bit set
+func parseCronField(field string, min, max int) (uint64, error) {
+	var set uint64
+	for _, part := range strings.Split(field, ",") {
+		values, stepText, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepText)
+			if err != nil || step < 1 {
+				return 0, ErrJobScheduleInvalid
+			}
+		}
+
+		low, high := min, max
+		if values != "*" {
+			lowText, highText, isRange := strings.Cut(values, "-")
+			var err error
+			if low, err = strconv.Atoi(lowText); err != nil {
+				return 0, ErrJobScheduleInvalid
+			}
+			high = low
+			if isRange {
+				if high, err = strconv.Atoi(highText); err != nil {
+					return 0, ErrJobScheduleInvalid
+				}
+			} else if hasStep {
+				high = max
+			}
+		}
+		if low < min || high > max || low > high {
+			return 0, ErrJobScheduleInvalid
+		}
+		for value := low; value <= high; value += step {
+			set |= 1 << value
+		}
+	}
+	return set, nil
+}
+
+// cronSchedule holds the values each field matches as bit sets. As in cron,
+// when both the day of month and the day of week are restricted a day
+// matching either of them runs the job.
+type cronSchedule struct {
+	minutes, hours, days, months, weekdays uint64
+	anyDay, anyWeekday                     bool
+}
+
+func (s cronSchedule) Next(after time.Time) time.Time {
+	t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	//um horário válido aparece em no máximo alguns anos (29 de fevereiro numa segunda, por exemplo)
+	limit := t.AddDate(8, 0, 0)
+	for t.Before(limit) {
+		if s.months&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if s.hours&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if s.minutes&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (s cronSchedule) matchesDay(t time.Time) bool {
+	day := s.days&(1<<uint(t.Day())) != 0
+	weekday := s.weekdays&(1<<uint(t.Weekday())) != 0
+	if s.anyDay || s.anyWeekday {
+		return day && weekday
+	}
+	return day || weekday
+}
+
+// everySchedule runs at a fixed interval after each run
+type everySchedule struct {
+	interval time.Duration
+}
+
+func (s everySchedule) Next(after time.Time) time.Time {
+	return after.UTC().Truncate(time.Second).Add(s.interval)
+}
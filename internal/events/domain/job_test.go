@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseJobSchedule_Next(t *testing.T) {
+	after := time.Date(2030, 1, 15, 10, 7, 30, 0, time.UTC) // terça-feira
+
+	cases := []struct {
+		spec string
+		next time.Time
+	}{
+		{"* * * * *", time.Date(2030, 1, 15, 10, 8, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2030, 1, 15, 10, 15, 0, 0, time.UTC)},
+		{"0 3 * * *", time.Date(2030, 1, 16, 3, 0, 0, 0, time.UTC)},
+		{"30 9-17/4 * * *", time.Date(2030, 1, 15, 13, 30, 0, 0, time.UTC)},
+		{"0 0 1,15 * *", time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 12 * * 0", time.Date(2030, 1, 20, 12, 0, 0, 0, time.UTC)},
+		{"0 12 * * 7", time.Date(2030, 1, 20, 12, 0, 0, 0, time.UTC)},
+		{"0 12 1 * 5", time.Date(2030, 1, 18, 12, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2032, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2030, 1, 15, 11, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2030, 1, 16, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"@every 90s", time.Date(2030, 1, 15, 10, 9, 0, 0, time.UTC)},
+	}
+	for _, c := range cases {
+		schedule, err := ParseJobSchedule(c.spec)
+		if assert.Nil(t, err, c.spec) {
+			assert.Equal(t, c.next, schedule.Next(after), c.spec)
+		}
+	}
+}
+
+func TestParseJobSchedule_UsesUTC(t *testing.T) {
+	saoPaulo, _ := time.LoadLocation("America/Sao_Paulo")
+	schedule, _ := ParseJobSchedule("0 3 * * *")
+
+	next := schedule.Next(time.Date(2030, 1, 15, 23, 0, 0, 0, saoPaulo))
+	assert.Equal(t, time.Date(2030, 1, 16, 3, 0, 0, 0, time.UTC), next)
+}
+
+func TestParseJobSchedule_Invalid(t *testing.T) {
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8",
+		"5-1 * * * *", "*/0 * * * *", "a * * * *", "0 0 30 2 *", "@every 0s", "@every soon", "@often"} {
+		_, err := ParseJobSchedule(spec)
+		assert.ErrorIs(t, err, ErrJobScheduleInvalid, spec)
+	}
+}
+
+func TestCreatedNewJob(t *testing.T) {
+	now := time.Date(2030, 1, 15, 10, 7, 0, 0, time.UTC)
+
+	job, err := CreatedNewJob("expire-holds", "* * * * *", now)
+	assert.Nil(t, err)
+	assert.Equal(t, "expire-holds", job.Handler)
+	assert.Equal(t, JobStatusActive, job.Status)
+	assert.Equal(t, now.Add(time.Minute), job.NextRunAt)
+	assert.False(t, job.IsOneOff())
+	assert.False(t, job.IsDue(now))
+	assert.True(t, job.IsDue(now.Add(time.Minute)))
+
+	_, err = CreatedNewJob("expire holds", "* * * * *", now)
+	assert.Equal(t, ErrJobNameInvalid, err)
+
+	_, err = CreatedNewJob("expire-holds", "every minute", now)
+	assert.ErrorIs(t, err, ErrJobScheduleInvalid)
+}
+
+func TestJob_FinishSkipsMissedRuns(t *testing.T) {
+	now := time.Date(2030, 1, 15, 10, 7, 0, 0, time.UTC)
+	job, _ := CreatedNewJob("send-event-reminders", "*/15 * * * *", now)
+
+	startedAt := now.Add(2 * time.Hour)
+	assert.Nil(t, job.Finish(startedAt, startedAt.Add(time.Second)))
+	assert.Equal(t, startedAt, *job.LastRunAt)
+	assert.Equal(t, time.Date(2030, 1, 15, 12, 15, 0, 0, time.UTC), job.NextRunAt)
+	assert.Equal(t, JobStatusActive, job.Status)
+}
+
+func TestJob_Reschedule(t *testing.T) {
+	now := time.Date(2030, 1, 15, 10, 7, 0, 0, time.UTC)
+	job, _ := CreatedNewJob("archive-past-events", "0 3 * * *", now)
+	pending := job.NextRunAt
+
+	assert.Nil(t, job.Reschedule("0 3 * * *", now.Add(time.Hour)))
+	assert.Equal(t, pending, job.NextRunAt)
+
+	assert.Nil(t, job.Reschedule("0 4 * * *", now))
+	assert.Equal(t, "0 4 * * *", job.Schedule)
+	assert.Equal(t, time.Date(2030, 1, 16, 4, 0, 0, 0, time.UTC), job.NextRunAt)
+
+	assert.ErrorIs(t, job.Reschedule("0 25 * * *", now), ErrJobScheduleInvalid)
+}
+
+func TestCreatedNewOneOffJob(t *testing.T) {
+	now := time.Date(2030, 1, 15, 10, 7, 0, 0, time.UTC)
+	runAt := now.Add(30 * time.Minute)
+
+	job, err := CreatedNewOneOffJob("send-event-reminders", runAt, now)
+	assert.Nil(t, err)
+	assert.Regexp(t, `^send-event-reminders:[0-9a-f]{8}$`, job.Name)
+	assert.Equal(t, "send-event-reminders", job.Handler)
+	assert.True(t, job.IsOneOff())
+	assert.False(t, job.IsDue(now))
+	assert.True(t, job.IsDue(runAt))
+
+	assert.Nil(t, job.Finish(runAt, runAt.Add(time.Second)))
+	assert.Equal(t, JobStatusDone, job.Status)
+	assert.False(t, job.IsDue(runAt.Add(time.Hour)))
+
+	_, err = CreatedNewOneOffJob("send-event-reminders", time.Time{}, now)
+	assert.Equal(t, ErrJobRunAtInvalid, err)
+}
+
+func TestJobRun_Finish(t *testing.T) {
+	now := time.Date(2030, 1, 15, 10, 7, 0, 0, time.UTC)
+	job, _ := CreatedNewJob("expire-holds", "* * * * *", now)
+
+	run := CreatedNewJobRun(*job, "api-1:42", now)
+	assert.Equal(t, JobRunStatusRunning, run.Status)
+	assert.Equal(t, "expire-holds", run.JobName)
+	assert.Nil(t, run.FinishedAt)
+
+	run.Finish(`{"released_spots":2}`, nil, now.Add(time.Second))
+	assert.Equal(t, JobRunStatusSucceeded, run.Status)
+	assert.Equal(t, `{"released_spots":2}`, run.Output)
+	assert.Equal(t, now.Add(time.Second), *run.FinishedAt)
+
+	failed := CreatedNewJobRun(*job, "api-1:42", now)
+	failed.Finish("", errors.New("database is down"), now.Add(time.Second))
+	assert.Equal(t, JobRunStatusFailed, failed.Status)
+	assert.Equal(t, "database is down", failed.Error)
+}
@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrNotificationKindInvalid      = errors.New("notification kind is invalid")
+	ErrNotificationRecipientInvalid = errors.New("notification recipient email is invalid")
+	ErrNotificationConflict         = errors.New("notification was claimed by another dispatcher")
+)
+
+// NotificationKind is the email a notification sends, each one with its own
+// template
+type NotificationKind string
+
+const (
+	NotificationOrderConfirmation NotificationKind = "order_confirmation"
+	NotificationRefund            NotificationKind = "refund"
+	NotificationReminder          NotificationKind = "reminder"
+	NotificationCancellation      NotificationKind = "cancellation"
+)
+
+func (k NotificationKind) IsValid() bool {
+	switch k {
+	case NotificationOrderConfirmation, NotificationRefund, NotificationReminder, NotificationCancellation:
+		return true
+	}
+	return false
+}
+
+type NotificationStatus string
+
+const (
+	NotificationStatusPending NotificationStatus = "pending"
+	NotificationStatusSent    NotificationStatus = "sent"
+	NotificationStatusFailed  NotificationStatus = "failed"
+)
+
+// DefaultNotificationLocale is used for recipients whose language is unknown
+const DefaultNotificationLocale = "pt-BR"
+
+// NotificationLocales are the languages the emails are written in
+var NotificationLocales = []string{"pt-BR", "en"}
+
+const (
+	// MaxNotificationAttempts is how many times an email is tried before the
+	// notification is given up as failed
+	MaxNotificationAttempts = 8
+	// NotificationLease is how long a dispatcher holds a notification it is
+	// sending, after which another dispatcher may retry it
+	NotificationLease = 5 * time.Minute
+
+	notificationBaseBackoff = time.Minute
+	notificationMaxBackoff  = time.Hour
+)
+
+// Notification is an email waiting in the outbox. It is queued right after
+// the change that caused it and delivered later, retrying with backoff until it
+// is sent or runs out of attempts. DedupKey identifies the cause, so the
+// same email is never queued twice.
+type Notification struct {
+	ID            string             `json:"id"`
+	Kind          NotificationKind   `json:"kind"`
+	Locale        string             `json:"locale"`
+	Recipient     string             `json:"recipient"`
+	DedupKey      string             `json:"dedup_key"`
+	Data          json.RawMessage    `json:"data"`
+	Status        NotificationStatus `json:"status"`
+	Attempts      int                `json:"attempts"`
+	NextAttemptAt time.Time          `json:"next_attempt_at"`
+	LastError     string             `json:"last_error"`
+	CreatedAt     time.Time          `json:"created_at"`
+	SentAt        time.Time          `json:"sent_at"`
+}
+
+// CreatedNewNotification queues an email of kind to recipient with data for
+// its template
+func CreatedNewNotification(kind NotificationKind, locale, recipient, dedupKey string, data any, now time.Time) (*Notification, error) {
+	if !kind.IsValid() {
+		return nil, ErrNotificationKindInvalid
+	}
+	recipient = NormalizeEmail(recipient)
+	if !IsValidEmail(recipient) {
+		return nil, ErrNotificationRecipientInvalid
+	}
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	return &Notification{
+		ID:            uuid.New().String(),
+		Kind:          kind,
+		Locale:        NotificationLocale(locale),
+		Recipient:     recipient,
+		DedupKey:      string(kind) + ":" + dedupKey,
+		Data:          payload,
+		Status:        NotificationStatusPending,
+		NextAttemptAt: now.UTC(),
+		CreatedAt:     now.UTC(),
+	}, nil
+}
+
+// NotificationLocale returns the supported locale closest to locale, such
+// as "en" for "en-US", or the default one
+func NotificationLocale(locale string) string {
+	locale = strings.TrimSpace(locale)
+	for _, supported := range NotificationLocales {
+		if strings.EqualFold(locale, supported) {
+			return supported
+		}
+	}
+	language, _, _ := strings.Cut(locale, "-")
+	for _, supported := range NotificationLocales {
+		supportedLanguage, _, _ := strings.Cut(supported, "-")
+		if strings.EqualFold(language, supportedLanguage) {
+			return supported
+		}
+	}
+	return DefaultNotificationLocale
+}
+
+// MarkSent records the delivery of the email
+func (n *Notification) MarkSent(now time.Time) {
+	n.Attempts++
+	n.Status = NotificationStatusSent
+	n.SentAt = now.UTC()
+	n.LastError = ""
+}
+
+// MarkFailed records a failed delivery and schedules the next attempt,
+// doubling the wait each time. It reports whether the email will be retried.
+func (n *Notification) MarkFailed(err error, now time.Time) bool {
+	n.Attempts++
+	n.LastError = err.Error()
+	if n.Attempts >= MaxNotificationAttempts {
+		n.Status = NotificationStatusFailed
+		return false
+	}
+	backoff := notificationBaseBackoff << (n.Attempts - 1)
+	if backoff > notificationMaxBackoff {
+		backoff = notificationMaxBackoff
+	}
+	n.NextAttemptAt = now.Add(backoff).UTC()
+	return true
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatedNewNotification(t *testing.T) {
+	now := time.Date(2030, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	notification, err := CreatedNewNotification(NotificationOrderConfirmation, "en-US", " Buyer@Example.com ", "order-1", map[string]any{"order_id": "order-1"}, now)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, notification.ID)
+	assert.Equal(t, "en", notification.Locale)
+	assert.Equal(t, "buyer@example.com", notification.Recipient)
+	assert.Equal(t, "order_confirmation:order-1", notification.DedupKey)
+	assert.JSONEq(t, `{"order_id":"order-1"}`, string(notification.Data))
+	assert.Equal(t, NotificationStatusPending, notification.Status)
+	assert.Equal(t, now, notification.NextAttemptAt)
+
+	_, err = CreatedNewNotification("newsletter", "en", "buyer@example.com", "order-1", nil, now)
+	assert.Equal(t, ErrNotificationKindInvalid, err)
+
+	_, err = CreatedNewNotification(NotificationRefund, "en", "not an email", "order-1", nil, now)
+	assert.Equal(t, ErrNotificationRecipientInvalid, err)
+}
+
+func TestNotificationLocale(t *testing.T) {
+	assert.Equal(t, "pt-BR", NotificationLocale("pt-br"))
+	assert.Equal(t, "pt-BR", NotificationLocale("pt-PT"))
+	assert.Equal(t, "en", NotificationLocale("en-GB"))
+	assert.Equal(t, DefaultNotificationLocale, NotificationLocale("fr"))
+	assert.Equal(t, DefaultNotificationLocale, NotificationLocale(""))
+}
+
+func TestNotification_MarkFailedBacksOff(t *testing.T) {
+	now := time.Date(2030, 1, 15, 12, 0, 0, 0, time.UTC)
+	notification := Notification{Status: NotificationStatusPending}
+
+	assert.True(t, notification.MarkFailed(errors.New("connection refused"), now))
+	assert.Equal(t, 1, notification.Attempts)
+	assert.Equal(t, "connection refused", notification.LastError)
+	assert.Equal(t, now.Add(time.Minute), notification.NextAttemptAt)
+
+	assert.True(t, notification.MarkFailed(errors.New("connection refused"), now))
+	assert.Equal(t, now.Add(2*time.Minute), notification.NextAttemptAt)
+
+	for notification.Attempts < MaxNotificationAttempts-1 {
+		assert.True(t, notification.MarkFailed(errors.New("connection refused"), now))
+	}
+	assert.Equal(t, now.Add(time.Hour), notification.NextAttemptAt)
+	assert.Equal(t, NotificationStatusPending, notification.Status)
+
+	assert.False(t, notification.MarkFailed(errors.New("connection refused"), now))
+	assert.Equal(t, NotificationStatusFailed, notification.Status)
+}
+
+func TestNotification_MarkSent(t *testing.T) {
+	now := time.Date(2030, 1, 15, 12, 0, 0, 0, time.UTC)
+	notification := Notification{Status: NotificationStatusPending, Attempts: 1, LastError: "timeout"}
+
+	notification.MarkSent(now)
+	assert.Equal(t, NotificationStatusSent, notification.Status)
+	assert.Equal(t, 2, notification.Attempts)
+	assert.Equal(t, now, notification.SentAt)
+	assert.Empty(t, notification.LastError)
+}
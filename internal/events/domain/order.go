@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrOrderEmailInvalid  = errors.New("order email is invalid")
+	ErrOrderEventRequired = errors.New("order event is required")
+	ErrOrderNotFound      = errors.New("order not found")
+)
+
+// Order groups the tickets bought together by a buyer. CardHash and IPAddress
+// are kept to detect one buyer purchasing under many emails.
+type Order struct {
+	ID        string    `json:"id"`
+	EventID   string    `json:"event_id"`
+	Email     string    `json:"email"`
+	CardHash  string    `json:"card_hash"`
+	IPAddress string    `json:"ip_address"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NormalizeEmail returns the canonical form of an email address used to
+// compare buyers: trimmed and lowercased.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func CreatedNewOrder(eventID, email, cardHash, ipAddress string) (*Order, error) {
+	order := &Order{
+		ID:        uuid.New().String(),
+		EventID:   eventID,
+		Email:     NormalizeEmail(email),
+		CardHash:  strings.TrimSpace(cardHash),
+		IPAddress: strings.TrimSpace(ipAddress),
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := order.Validate(); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+func (o Order) Validate() error {
+	if o.EventID == "" {
+		return ErrOrderEventRequired
+	}
+	if !IsValidEmail(o.Email) {
+		return ErrOrderEmailInvalid
+	}
+	return nil
+}
+
+// IsValidEmail reports whether email is a bare, normalized email address
+func IsValidEmail(email string) bool {
+	address, err := mail.ParseAddress(email)
+	return err == nil && address.Address == email && email == NormalizeEmail(email)
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatedNewOrder(t *testing.T) {
+	order, err := CreatedNewOrder("event-1", "  Buyer@Example.COM ", "card-hash", "10.0.0.1")
+	assert.Nil(t, err)
+	assert.NotNil(t, order)
+	assert.NotEmpty(t, order.ID)
+	assert.Equal(t, "event-1", order.EventID)
+	assert.Equal(t, "buyer@example.com", order.Email)
+	assert.Equal(t, "card-hash", order.CardHash)
+	assert.Equal(t, "10.0.0.1", order.IPAddress)
+	assert.False(t, order.CreatedAt.IsZero())
+}
+
+func TestOrder_Validate(t *testing.T) {
+	order := Order{EventID: "", Email: "buyer@example.com"}
+	assert.Equal(t, ErrOrderEventRequired, order.Validate())
+
+	order.EventID = "event-1"
+	order.Email = "not an email"
+	assert.Equal(t, ErrOrderEmailInvalid, order.Validate())
+
+	order.Email = "Buyer <buyer@example.com>"
+	assert.Equal(t, ErrOrderEmailInvalid, order.Validate())
+
+	order.Email = "buyer@example.com"
+	assert.Nil(t, order.Validate())
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrEventBuyerLimitInvalid = errors.New("event max tickets per buyer must not be negative")
+	ErrPurchaseRejected       = errors.New("purchase rejected")
+)
+
+// Rejection codes returned to clients when a purchase breaks an anti scalping
+// rule or the age rating of the event
+const (
+	RejectionBuyerLimitExceeded   = "BUYER_LIMIT_EXCEEDED"
+	RejectionCardVelocityExceeded = "CARD_VELOCITY_EXCEEDED"
+	RejectionIPVelocityExceeded   = "IP_VELOCITY_EXCEEDED"
+	RejectionAttendeeUnderage     = "ATTENDEE_UNDERAGE"
+)
+
+// PurchaseRejection is returned when a purchase is refused by a buyer limit,
+// velocity rule or age rating. It matches ErrPurchaseRejected with errors.Is.
+type PurchaseRejection struct {
+	Code   string
+	Reason string
+}
+
+func (r *PurchaseRejection) Error() string {
+	return fmt.Sprintf("purchase rejected (%s): %s", r.Code, r.Reason)
+}
+
+func (r *PurchaseRejection) Is(target error) bool {
+	return target == ErrPurchaseRejected
+}
+
+// CheckBuyerLimit verifies the buyer stays within the event limit of tickets
+// per buyer, given the tickets the buyer already holds for the event.
+func (e Event) CheckBuyerLimit(alreadyBought, quantity int) error {
+	if e.MaxTicketsPerBuyer > 0 && alreadyBought+quantity > e.MaxTicketsPerBuyer {
+		return &PurchaseRejection{
+			Code:   RejectionBuyerLimitExceeded,
+			Reason: fmt.Sprintf("the limit is %d tickets per buyer and %d were already bought", e.MaxTicketsPerBuyer, alreadyBought),
+		}
+	}
+	return nil
+}
+
+// VelocityPolicy flags a card hash or an IP address used by too many distinct
+// emails within Window. A zero maximum disables the respective rule.
+type VelocityPolicy struct {
+	Window           time.Duration
+	MaxEmailsPerCard int
+	MaxEmailsPerIP   int
+}
+
+func DefaultVelocityPolicy() VelocityPolicy {
+	return VelocityPolicy{
+		Window:           24 * time.Hour,
+		MaxEmailsPerCard: 2,
+		MaxEmailsPerIP:   5,
+	}
+}
+
+// Check verifies the buyer email against the emails that recently purchased
+// with the same card hash and from the same IP address.
+func (p VelocityPolicy) Check(email string, emailsByCard, emailsByIP []string) error {
+	if p.MaxEmailsPerCard > 0 && countDistinctWith(emailsByCard, email) > p.MaxEmailsPerCard {
+		return &PurchaseRejection{
+			Code:   RejectionCardVelocityExceeded,
+			Reason: fmt.Sprintf("card used by more than %d emails", p.MaxEmailsPerCard),
+		}
+	}
+	if p.MaxEmailsPerIP > 0 && countDistinctWith(emailsByIP, email) > p.MaxEmailsPerIP {
+		return &PurchaseRejection{
+			Code:   RejectionIPVelocityExceeded,
+			Reason: fmt.Sprintf("ip address used by more than %d emails", p.MaxEmailsPerIP),
+		}
+	}
+	return nil
+}
+
+// countDistinctWith counts the distinct normalized emails including email
+func countDistinctWith(emails []string, email string) int {
+	distinct := map[string]struct{}{NormalizeEmail(email): {}}
+	for _, e := range emails {
+		distinct[NormalizeEmail(e)] = struct{}{}
+	}
+	return len(distinct)
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvent_CheckBuyerLimit(t *testing.T) {
+	event, _ := CreatedNewEvent("Event Test", "Location Test", "Organization Test", RatingFree, time.Now().Add(24*time.Hour), "image_url", 100, 50.00, 1)
+	assert.Nil(t, event.CheckBuyerLimit(50, 50))
+
+	event.MaxTicketsPerBuyer = 4
+	assert.Nil(t, event.CheckBuyerLimit(2, 2))
+
+	err := event.CheckBuyerLimit(3, 2)
+	assert.ErrorIs(t, err, ErrPurchaseRejected)
+	var rejection *PurchaseRejection
+	assert.ErrorAs(t, err, &rejection)
+	assert.Equal(t, RejectionBuyerLimitExceeded, rejection.Code)
+
+	sales := TicketSales{Total: 3, ByKind: map[TicketKind]int{TicketKindFull: 3}, ByBuyer: 3}
+	assert.ErrorIs(t, event.CheckTicketSale(TicketKindFull, 2, sales), ErrPurchaseRejected)
+}
+
+func TestEvent_ValidateBuyerLimit(t *testing.T) {
+	event, _ := CreatedNewEvent("Event Test", "Location Test", "Organization Test", RatingFree, time.Now().Add(24*time.Hour), "image_url", 100, 50.00, 1)
+	event.MaxTicketsPerBuyer = -1
+	assert.Equal(t, ErrEventBuyerLimitInvalid, event.Validade())
+}
+
+func TestVelocityPolicy_Check(t *testing.T) {
+	policy := VelocityPolicy{Window: time.Hour, MaxEmailsPerCard: 2, MaxEmailsPerIP: 3}
+
+	assert.Nil(t, policy.Check("a@example.com", nil, nil))
+	assert.Nil(t, policy.Check("a@example.com", []string{"a@example.com", "b@example.com"}, nil))
+	assert.Nil(t, policy.Check("A@Example.com", []string{"a@example.com", "b@example.com"}, nil))
+
+	var rejection *PurchaseRejection
+	err := policy.Check("c@example.com", []string{"a@example.com", "b@example.com"}, nil)
+	assert.ErrorAs(t, err, &rejection)
+	assert.Equal(t, RejectionCardVelocityExceeded, rejection.Code)
+
+	err = policy.Check("d@example.com", nil, []string{"a@example.com", "b@example.com", "c@example.com"})
+	assert.ErrorAs(t, err, &rejection)
+	assert.Equal(t, RejectionIPVelocityExceeded, rejection.Code)
+
+	disabled := VelocityPolicy{}
+	assert.Nil(t, disabled.Check("z@example.com", []string{"a@example.com", "b@example.com", "c@example.com"}, nil))
+}
@@ -0,0 +1,244 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrRecurrenceRuleInvalid      = errors.New("recurrence rule must be an RRULE with FREQ, INTERVAL, BYDAY, COUNT or UNTIL parts")
+	ErrRecurrenceFrequencyInvalid = errors.New("recurrence frequency must be DAILY or WEEKLY")
+	ErrRecurrenceIntervalInvalid  = errors.New("recurrence interval must be greater than zero")
+	ErrRecurrenceWeekdayInvalid   = errors.New("recurrence weekdays must be MO, TU, WE, TH, FR, SA or SU")
+	ErrRecurrenceEndRequired      = errors.New("recurrence rule must end with either COUNT or UNTIL")
+	ErrRecurrenceTooLong          = errors.New("recurrence rule generates too many occurrences")
+	ErrRecurrenceEmpty            = errors.New("recurrence rule generates no occurrences")
+)
+
+// MaxRecurrenceOccurrences bounds how many events a single rule generates
+const MaxRecurrenceOccurrences = 366
+
+type RecurrenceFrequency string
+
+const (
+	RecurrenceDaily  RecurrenceFrequency = "DAILY"
+	RecurrenceWeekly RecurrenceFrequency = "WEEKLY"
+)
+
+const (
+	recurrenceUntilLayout     = "20060102T150405Z"
+	recurrenceUntilDateLayout = "20060102"
+)
+
+var recurrenceWeekdays = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// RecurrenceRule is the subset of the iCalendar RRULE used by event series:
+// FREQ=DAILY or WEEKLY, INTERVAL, BYDAY and either COUNT or UNTIL. Weeks
+// start on Monday. An UNTIL date without time includes the whole day in the
+// series time zone.
+type RecurrenceRule struct {
+	Frequency RecurrenceFrequency
+	Interval  int
+	ByWeekday []time.Weekday
+	Count     int
+	Until     time.Time
+	UntilDate bool
+}
+
+// ParseRecurrenceRule reads a rule like "FREQ=WEEKLY;BYDAY=FR,SA;COUNT=8",
+// with or without the "RRULE:" prefix.
+func ParseRecurrenceRule(value string) (RecurrenceRule, error) {
+	rule := RecurrenceRule{Interval: 1}
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	if value == "" {
+		return rule, ErrRecurrenceRuleInvalid
+	}
+
+	seen := map[string]bool{}
+	for _, part := range strings.Split(value, ";") {
+		name, val, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(strings.TrimSpace(name))
+		val = strings.ToUpper(strings.TrimSpace(val))
+		if !ok || val == "" || seen[name] {
+			return rule, ErrRecurrenceRuleInvalid
+		}
+		seen[name] = true
+
+		switch name {
+		case "FREQ":
+			rule.Frequency = RecurrenceFrequency(val)
+		case "INTERVAL":
+			interval, err := strconv.Atoi(val)
+			if err != nil {
+				return rule, ErrRecurrenceIntervalInvalid
+			}
+			rule.Interval = interval
+		case "BYDAY":
+			for _, day := range strings.Split(val, ",") {
+				weekday := slices.Index(recurrenceWeekdays, strings.TrimSpace(day))
+				if weekday < 0 {
+					return rule, ErrRecurrenceWeekdayInvalid
+				}
+				if !slices.Contains(rule.ByWeekday, time.Weekday(weekday)) {
+					rule.ByWeekday = append(rule.ByWeekday, time.Weekday(weekday))
+				}
+			}
+		case "COUNT":
+			count, err := strconv.Atoi(val)
+			if err != nil || count <= 0 {
+				return rule, ErrRecurrenceRuleInvalid
+			}
+			rule.Count = count
+		case "UNTIL":
+			until, err := time.Parse(recurrenceUntilLayout, val)
+			if err != nil {
+				until, err = time.Parse(recurrenceUntilDateLayout, val)
+				rule.UntilDate = true
+			}
+			if err != nil {
+				return rule, ErrRecurrenceRuleInvalid
+			}
+			rule.Until = until
+		default:
+			return rule, ErrRecurrenceRuleInvalid
+		}
+	}
+
+	if err := rule.Validate(); err != nil {
+		return rule, err
+	}
+	return rule, nil
+}
+
+func (r RecurrenceRule) Validate() error {
+	if r.Frequency != RecurrenceDaily && r.Frequency != RecurrenceWeekly {
+		return ErrRecurrenceFrequencyInvalid
+	}
+	if r.Interval <= 0 {
+		return ErrRecurrenceIntervalInvalid
+	}
+	if (r.Count > 0) == !r.Until.IsZero() {
+		return ErrRecurrenceEndRequired
+	}
+	if r.Count > MaxRecurrenceOccurrences {
+		return ErrRecurrenceTooLong
+	}
+	return nil
+}
+
+// String returns the rule in RRULE form, with the weekdays in week order
+func (r RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + string(r.Frequency)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByWeekday) > 0 {
+		days := []string{}
+		for _, weekday := range r.sortedWeekdays() {
+			days = append(days, recurrenceWeekdays[weekday])
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		layout := recurrenceUntilLayout
+		if r.UntilDate {
+			layout = recurrenceUntilDateLayout
+		}
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(layout))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Occurrences returns the start of each occurrence, in UTC, for a series
+// starting at start. Occurrences keep the wall clock time of start in its
+// location, so they stay at the same local hour across daylight saving
+// changes. Without BYDAY, weekly series repeat on the weekday of start.
+func (r RecurrenceRule) Occurrences(start time.Time) ([]time.Time, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	weekdays := r.sortedWeekdays()
+	if len(weekdays) == 0 && r.Frequency == RecurrenceWeekly {
+		weekdays = []time.Weekday{start.Weekday()}
+	}
+
+	occurrences := []time.Time{}
+	add := func(days int) bool {
+		date := time.Date(start.Year(), start.Month(), start.Day()+days,
+			start.Hour(), start.Minute(), start.Second(), 0, start.Location())
+		if !r.includes(date) {
+			return false
+		}
+		occurrences = append(occurrences, date.UTC())
+		return true
+	}
+
+	//the first period is the day or the week of start; later ones step by INTERVAL
+	weekStart := -int((start.Weekday() + 6) % 7)
+	for period := 0; ; period++ {
+		var days []int
+		if r.Frequency == RecurrenceDaily {
+			day := period * r.Interval
+			if len(weekdays) == 0 || slices.Contains(weekdays, start.AddDate(0, 0, day).Weekday()) {
+				days = append(days, day)
+			}
+		} else {
+			for _, weekday := range weekdays {
+				day := weekStart + period*7*r.Interval + int((weekday+6)%7)
+				if day >= 0 {
+					days = append(days, day)
+				}
+			}
+		}
+
+		for _, day := range days {
+			if !add(day) {
+				return r.checkOccurrences(occurrences)
+			}
+			if r.Count > 0 && len(occurrences) == r.Count {
+				return occurrences, nil
+			}
+			if len(occurrences) > MaxRecurrenceOccurrences {
+				return nil, ErrRecurrenceTooLong
+			}
+		}
+		if period*r.Interval > 7*MaxRecurrenceOccurrences {
+			return r.checkOccurrences(occurrences)
+		}
+	}
+}
+
+// includes reports whether date is not past UNTIL
+func (r RecurrenceRule) includes(date time.Time) bool {
+	if r.Until.IsZero() {
+		return true
+	}
+	if r.UntilDate {
+		day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+		return !day.After(r.Until)
+	}
+	return !date.After(r.Until)
+}
+
+func (r RecurrenceRule) checkOccurrences(occurrences []time.Time) ([]time.Time, error) {
+	if len(occurrences) == 0 {
+		return nil, ErrRecurrenceEmpty
+	}
+	return occurrences, nil
+}
+
+// sortedWeekdays returns ByWeekday from Monday to Sunday
+func (r RecurrenceRule) sortedWeekdays() []time.Weekday {
+	weekdays := slices.Clone(r.ByWeekday)
+	slices.SortFunc(weekdays, func(a, b time.Weekday) int {
+		return int((a+6)%7) - int((b+6)%7)
+	})
+	return weekdays
+}
@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func formatOccurrences(dates []time.Time, location *time.Location) []string {
+	formatted := make([]string, len(dates))
+	for i, date := range dates {
+		formatted[i] = date.In(location).Format("Mon 2006-01-02 15:04")
+	}
+	return formatted
+}
+
+func TestParseRecurrenceRule(t *testing.T) {
+	rule, err := ParseRecurrenceRule("RRULE:FREQ=WEEKLY;BYDAY=SA,FR;INTERVAL=2;COUNT=6")
+	assert.Nil(t, err)
+	assert.Equal(t, RecurrenceWeekly, rule.Frequency)
+	assert.Equal(t, 2, rule.Interval)
+	assert.Equal(t, []time.Weekday{time.Saturday, time.Friday}, rule.ByWeekday)
+	assert.Equal(t, 6, rule.Count)
+	assert.Equal(t, "FREQ=WEEKLY;INTERVAL=2;BYDAY=FR,SA;COUNT=6", rule.String())
+
+	rule, err = ParseRecurrenceRule("freq=daily;until=20300110")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, rule.Interval)
+	assert.True(t, rule.UntilDate)
+	assert.Equal(t, "FREQ=DAILY;UNTIL=20300110", rule.String())
+
+	rule, err = ParseRecurrenceRule("FREQ=DAILY;UNTIL=20300110T230000Z")
+	assert.Nil(t, err)
+	assert.False(t, rule.UntilDate)
+	assert.Equal(t, time.Date(2030, 1, 10, 23, 0, 0, 0, time.UTC), rule.Until)
+}
+
+func TestParseRecurrenceRule_Invalid(t *testing.T) {
+	cases := map[string]error{
+		"":                                  ErrRecurrenceRuleInvalid,
+		"FREQ=DAILY;COUNT=3;COUNT=4":        ErrRecurrenceRuleInvalid,
+		"FREQ=DAILY;COUNT=0":                ErrRecurrenceRuleInvalid,
+		"FREQ=DAILY;BYMONTH=1;COUNT=3":      ErrRecurrenceRuleInvalid,
+		"FREQ=DAILY;UNTIL=tomorrow":         ErrRecurrenceRuleInvalid,
+		"FREQ=MONTHLY;COUNT=3":              ErrRecurrenceFrequencyInvalid,
+		"FREQ=DAILY;INTERVAL=0;COUNT=3":     ErrRecurrenceIntervalInvalid,
+		"FREQ=WEEKLY;BYDAY=XX;COUNT=3":      ErrRecurrenceWeekdayInvalid,
+		"FREQ=DAILY":                        ErrRecurrenceEndRequired,
+		"FREQ=DAILY;COUNT=3;UNTIL=20300101": ErrRecurrenceEndRequired,
+		"FREQ=DAILY;COUNT=1000":             ErrRecurrenceTooLong,
+	}
+	for value, expected := range cases {
+		_, err := ParseRecurrenceRule(value)
+		assert.Equal(t, expected, err, value)
+	}
+}
+
+func TestRecurrenceRule_OccurrencesDaily(t *testing.T) {
+	saoPaulo, _ := time.LoadLocation("America/Sao_Paulo")
+	start := time.Date(2030, 3, 1, 20, 0, 0, 0, saoPaulo)
+
+	rule, _ := ParseRecurrenceRule("FREQ=DAILY;INTERVAL=2;COUNT=3")
+	dates, err := rule.Occurrences(start)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Fri 2030-03-01 20:00", "Sun 2030-03-03 20:00", "Tue 2030-03-05 20:00"}, formatOccurrences(dates, saoPaulo))
+	assert.Equal(t, time.UTC, dates[0].Location())
+
+	rule, _ = ParseRecurrenceRule("FREQ=DAILY;BYDAY=SA,SU;UNTIL=20300310")
+	dates, err = rule.Occurrences(start)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Sat 2030-03-02 20:00", "Sun 2030-03-03 20:00", "Sat 2030-03-09 20:00", "Sun 2030-03-10 20:00"}, formatOccurrences(dates, saoPaulo))
+}
+
+func TestRecurrenceRule_OccurrencesWeekly(t *testing.T) {
+	start := time.Date(2030, 1, 2, 21, 30, 0, 0, time.UTC) // a Wednesday
+
+	rule, _ := ParseRecurrenceRule("FREQ=WEEKLY;BYDAY=MO,FR;COUNT=4")
+	dates, err := rule.Occurrences(start)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Fri 2030-01-04 21:30", "Mon 2030-01-07 21:30", "Fri 2030-01-11 21:30", "Mon 2030-01-14 21:30"}, formatOccurrences(dates, time.UTC))
+
+	rule, _ = ParseRecurrenceRule("FREQ=WEEKLY;INTERVAL=2;UNTIL=20300130T213000Z")
+	dates, err = rule.Occurrences(start)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Wed 2030-01-02 21:30", "Wed 2030-01-16 21:30", "Wed 2030-01-30 21:30"}, formatOccurrences(dates, time.UTC))
+}
+
+func TestRecurrenceRule_OccurrencesKeepLocalTimeAcrossDST(t *testing.T) {
+	newYork, _ := time.LoadLocation("America/New_York")
+	start := time.Date(2030, 3, 8, 19, 0, 0, 0, newYork) // DST starts on March 10
+
+	rule, _ := ParseRecurrenceRule("FREQ=DAILY;COUNT=4")
+	dates, err := rule.Occurrences(start)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Fri 2030-03-08 19:00", "Sat 2030-03-09 19:00", "Sun 2030-03-10 19:00", "Mon 2030-03-11 19:00"}, formatOccurrences(dates, newYork))
+	assert.Equal(t, 0, dates[0].Hour())
+	assert.Equal(t, 23, dates[3].Hour())
+}
+
+func TestRecurrenceRule_OccurrencesLimits(t *testing.T) {
+	start := time.Date(2030, 1, 2, 20, 0, 0, 0, time.UTC)
+
+	rule, _ := ParseRecurrenceRule("FREQ=DAILY;UNTIL=20320101")
+	_, err := rule.Occurrences(start)
+	assert.Equal(t, ErrRecurrenceTooLong, err)
+
+	rule, _ = ParseRecurrenceRule("FREQ=DAILY;UNTIL=20291231")
+	_, err = rule.Occurrences(start)
+	assert.Equal(t, ErrRecurrenceEmpty, err)
+}
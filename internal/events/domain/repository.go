@@ -1,12 +1,180 @@
 package domain
 
+import "time"
+
 type EventRepository interface {
 	ListEvents() ([]Event, error)
+	ListEventsByVenueID(venueID string) ([]Event, error)
+	// FindEventsNearby returns the events with coordinates within radiusKm of
+	// center, the closest first
+	FindEventsNearby(center GeoPoint, radiusKm float64, limit int) ([]NearbyEvent, error)
+	// ListEventsBySeriesID returns the occurrences of the series ordered by date
+	ListEventsBySeriesID(seriesID string) ([]Event, error)
 	GetEventByID(eventId string) (*Event, error)
 	FindSpotsEventID(eventId string) ([]Spot, error)
 	FindSpotByName(eventId, spotNames string) (*Spot, error)
 	CreateSpot(spot *Spot) error
+	// CreateSpots stores the new spots of the event atomically, failing with
+	// ErrSpotNameAlreadyExists when the event already has one of the names
+	// and with ErrEventCapacityExceeded when the spots do not fit the event
+	CreateSpots(eventID string, spots []Spot) error
+	// ChangeSpots locks the event and runs change on all its spots. It stores
+	// the spots changed in place and removes the deleted ones, as listed by
+	// the audit entries change returns, together with the entries atomically.
+	ChangeSpots(eventID string, change func(spots []Spot) ([]SpotChange, error)) error
+	// FindSpotChangesByEventID returns the audit trail of the spots of the
+	// event, the oldest change first
+	FindSpotChangesByEventID(eventID string) ([]SpotChange, error)
 	CreateTicket(ticket *Ticket) error
 	ReserveSpot(spotId, ticketId string) error
 	CreateEvent(event *Event) error
-}
\ No newline at end of file
+	// UpdateEventLifecycle stores the status and schedule of the event if its
+	// status is still previous, failing with ErrEventStatusConflict otherwise
+	UpdateEventLifecycle(event *Event, previous EventStatus) error
+	// FindEventsDueForLifecycle returns the events not finished or cancelled
+	// that have started or have a scheduled step due at now
+	FindEventsDueForLifecycle(now time.Time) ([]Event, error)
+	// FindEventsStartingBetween returns the published, on sale and sold out
+	// events whose date is after from and not after to
+	FindEventsStartingBetween(from, to time.Time) ([]Event, error)
+	// FindEventsToArchive returns the finished and cancelled events not yet
+	// archived whose date is before before
+	FindEventsToArchive(before time.Time) ([]Event, error)
+	ArchiveEvent(event *Event) error
+	CreateTicketCategory(category *TicketCategory) error
+	FindTicketCategoriesByEventID(eventID string) ([]TicketCategory, error)
+	CountTicketSales(eventID, email string) (TicketSales, error)
+	// SellTickets locks the event, runs check against the current sales and,
+	// when it passes, creates the order, its tickets and reserves their spots
+	// atomically. A non nil redemption is recorded in the same transaction,
+	// failing with ErrCouponUsageLimitReached or ErrCouponEmailLimitReached
+	// when the coupon has no uses left.
+	SellTickets(order *Order, tickets []Ticket, redemption *CouponRedemption, check func(sales TicketSales) error) error
+	FindBuyerEmailsByCardHash(cardHash string, since time.Time) ([]string, error)
+	FindBuyerEmailsByIP(ipAddress string, since time.Time) ([]string, error)
+	FindAvailableSpotsByEventID(eventID string) ([]Spot, error)
+	// HoldSpots holds all the spots for the holder or none of them, failing
+	// with ErrSpotNotAvailable if any spot is no longer available.
+	HoldSpots(spotIDs []string, holder string, until time.Time) error
+	// ReleaseExpiredHolds makes the spots whose hold expired available again
+	// and returns them.
+	ReleaseExpiredHolds(now time.Time) ([]Spot, error)
+}
+
+// EventSearchIndex finds the events whose name, location or organization
+// have words starting with every term, the most relevant first. Ties are
+// ordered by date and ID.
+type EventSearchIndex interface {
+	SearchEvents(terms []string, limit int) ([]Event, error)
+}
+
+type TicketRepository interface {
+	GetTicketByID(ticketID string) (*Ticket, error)
+	// CreateTicketTransfer fails with ErrTicketTransferPending while the
+	// ticket has another open transfer
+	CreateTicketTransfer(transfer *TicketTransfer) error
+	// FindTicketTransfersByTicketID returns the transfers of the ticket ordered by CreatedAt
+	FindTicketTransfersByTicketID(ticketID string) ([]TicketTransfer, error)
+	// UpdateTicketTransfer stores the resolution of a pending transfer,
+	// failing with ErrTicketTransferNotPending if it was already resolved
+	UpdateTicketTransfer(transfer *TicketTransfer) error
+	// AcceptTicketTransfer stores the accepted transfer and the new holder of
+	// its ticket atomically
+	AcceptTicketTransfer(transfer *TicketTransfer, ticket *Ticket) error
+	// FindTicketsByEventID returns the tickets of the event ordered by spot name
+	FindTicketsByEventID(eventID string) ([]Ticket, error)
+	// CheckInTicket stores the check-in of the ticket and its accepted scan
+	// atomically. It fails with a *DuplicateCheckInError when an earlier scan
+	// was already stored and with ErrCredentialRevoked when the credential
+	// version of the ticket changed.
+	CheckInTicket(ticket *Ticket, scan *TicketScan) error
+	// RecordTicketScan stores a scan that did not check the ticket in
+	RecordTicketScan(scan *TicketScan) error
+	// FindTicketsByOrderID returns the tickets sold in the order ordered by spot name
+	FindTicketsByOrderID(orderID string) ([]Ticket, error)
+}
+
+type TicketTemplateRepository interface {
+	GetTicketTemplate(organization string) (*TicketTemplate, error)
+	// SaveTicketTemplate creates or replaces the template of the organization
+	SaveTicketTemplate(template *TicketTemplate) error
+}
+
+// NotificationRepository is the outbox of the emails
+type NotificationRepository interface {
+	// EnqueueNotification stores the notification unless another one with the
+	// same DedupKey was already queued
+	EnqueueNotification(notification *Notification) error
+	// FindDueNotifications returns up to limit pending notifications whose next
+	// attempt is due at now, the longest waiting first
+	FindDueNotifications(now time.Time, limit int) ([]Notification, error)
+	// ClaimNotification moves the next attempt of a due notification to until,
+	// so other dispatchers skip it while it is sent. It fails with
+	// ErrNotificationConflict if another dispatcher claimed it first.
+	ClaimNotification(notification *Notification, until time.Time) error
+	// UpdateNotification stores the outcome of a delivery attempt
+	UpdateNotification(notification *Notification) error
+}
+
+// JobRepository stores the scheduled jobs and their run history
+type JobRepository interface {
+	ListJobs() ([]Job, error)
+	GetJobByName(name string) (*Job, error)
+	// SaveJob creates the job or replaces the one with the same name
+	SaveJob(job *Job) error
+	// FindDueJobs returns the active jobs whose next run is due at now, the
+	// longest waiting first
+	FindDueJobs(now time.Time) ([]Job, error)
+	CreateJobRun(run *JobRun) error
+	UpdateJobRun(run *JobRun) error
+	// FindJobRunsByJobName returns up to limit runs of the job, the latest first
+	FindJobRunsByJobName(name string, limit int) ([]JobRun, error)
+}
+
+// JobLocker elects the instance that runs a job when several replicas of
+// the server share the database
+type JobLocker interface {
+	// TryLockJob takes the lock of the job without waiting. It reports
+	// false when another instance holds it; otherwise unlock must be called
+	// once the run is over.
+	TryLockJob(name string) (unlock func(), acquired bool, err error)
+}
+
+type VenueRepository interface {
+	ListVenues() ([]Venue, error)
+	GetVenueByID(venueID string) (*Venue, error)
+	CreateVenue(venue *Venue) error
+	UpdateVenue(venue *Venue) error
+	// DeleteVenue fails with ErrVenueInUse while events reference the venue
+	DeleteVenue(venueID string) error
+}
+
+type EventSeriesRepository interface {
+	// CreateEventSeries stores the series and its occurrences, with their
+	// spots and ticket categories, atomically
+	CreateEventSeries(series *EventSeries, occurrences []Event) error
+	GetEventSeriesByID(seriesID string) (*EventSeries, error)
+	// UpdateEventSeries stores the series and the configuration of the given
+	// occurrences atomically
+	UpdateEventSeries(series *EventSeries, occurrences []Event) error
+	// UpdateSeriesOccurrence stores the configuration and overrides of one occurrence
+	UpdateSeriesOccurrence(event *Event) error
+}
+
+type WaitlistRepository interface {
+	CreateWaitlistEntry(entry *WaitlistEntry) error
+	GetWaitlistEntryByID(entryID string) (*WaitlistEntry, error)
+	// FindWaitlistEntriesByEventID returns the entries of the event ordered by CreatedAt
+	FindWaitlistEntriesByEventID(eventID string) ([]WaitlistEntry, error)
+	FindExpiredWaitlistOffers(now time.Time) ([]WaitlistEntry, error)
+	UpdateWaitlistEntry(entry *WaitlistEntry) error
+}
+
+type CouponRepository interface {
+	ListCoupons() ([]Coupon, error)
+	GetCouponByID(couponID string) (*Coupon, error)
+	GetCouponByCode(code string) (*Coupon, error)
+	CreateCoupon(coupon *Coupon) error
+	UpdateCoupon(coupon *Coupon) error
+	DeleteCoupon(couponID string) error
+}
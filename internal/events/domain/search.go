@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+var (
+	ErrSearchQueryEmpty   = errors.New("search query must have a word with at least 3 letters")
+	ErrSearchLimitInvalid = errors.New("search limit must be between 1 and 100")
+)
+
+const (
+	// MinSearchTermLength matches the MySQL innodb_ft_min_token_size default,
+	// so shorter words are ignored by every search index
+	MinSearchTermLength = 3
+	DefaultSearchLimit  = 20
+	MaxSearchLimit      = 100
+)
+
+// Weights of each event field in the search relevance
+const (
+	SearchWeightName         = 3
+	SearchWeightLocation     = 2
+	SearchWeightOrganization = 1
+)
+
+// searchStopwords are the words of the MySQL InnoDB default stopword list
+// long enough to be indexed. They are ignored by every search index.
+var searchStopwords = map[string]bool{
+	"about": true, "are": true, "com": true, "for": true, "from": true, "how": true,
+	"that": true, "the": true, "this": true, "was": true, "what": true, "when": true,
+	"where": true, "who": true, "will": true, "with": true, "und": true, "www": true,
+}
+
+var searchFoldedRunes = map[rune]rune{
+	'á': 'a', 'à': 'a', 'â': 'a', 'ã': 'a', 'ä': 'a', 'å': 'a',
+	'ç': 'c',
+	'é': 'e', 'è': 'e', 'ê': 'e', 'ë': 'e',
+	'í': 'i', 'ì': 'i', 'î': 'i', 'ï': 'i',
+	'ñ': 'n',
+	'ó': 'o', 'ò': 'o', 'ô': 'o', 'õ': 'o', 'ö': 'o',
+	'ú': 'u', 'ù': 'u', 'û': 'u', 'ü': 'u',
+	'ý': 'y', 'ÿ': 'y',
+}
+
+// NormalizeSearchText lowercases the text and removes the accents, so "São
+// Paulo" and "sao paulo" are indexed and searched the same way
+func NormalizeSearchText(text string) string {
+	return strings.Map(func(r rune) rune {
+		r = unicode.ToLower(r)
+		if folded, ok := searchFoldedRunes[r]; ok {
+			return folded
+		}
+		return r
+	}, text)
+}
+
+// SearchTokens returns the indexed words of the text: normalized, with at
+// least MinSearchTermLength letters and not stopwords
+func SearchTokens(text string) []string {
+	words := strings.FieldsFunc(NormalizeSearchText(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	tokens := []string{}
+	for _, word := range words {
+		if len([]rune(word)) >= MinSearchTermLength && !searchStopwords[word] {
+			tokens = append(tokens, word)
+		}
+	}
+	return tokens
+}
+
+// SearchTerms returns the distinct words of a search query. Every term must
+// match the start of a word of the event name, location or organization.
+func SearchTerms(query string) ([]string, error) {
+	terms := []string{}
+	for _, token := range SearchTokens(query) {
+		if !slices.Contains(terms, token) {
+			terms = append(terms, token)
+		}
+	}
+	if len(terms) == 0 {
+		return nil, ErrSearchQueryEmpty
+	}
+	return terms, nil
+}
+
+// ScoreEventSearch returns the relevance of the event for the terms, adding
+// the weight of each field a term matches, or zero when a term matches no
+// field
+func ScoreEventSearch(e Event, terms []string) int {
+	fields := []struct {
+		tokens []string
+		weight int
+	}{
+		{SearchTokens(e.Name), SearchWeightName},
+		{SearchTokens(e.Location), SearchWeightLocation},
+		{SearchTokens(e.Organization), SearchWeightOrganization},
+	}
+
+	score := 0
+	for _, term := range terms {
+		termScore := 0
+		for _, field := range fields {
+			if slices.ContainsFunc(field.tokens, func(token string) bool { return strings.HasPrefix(token, term) }) {
+				termScore += field.weight
+			}
+		}
+		if termScore == 0 {
+			return 0
+		}
+		score += termScore
+	}
+	return score
+}
+
+// CheckSearchLimit returns the number of results to return, DefaultSearchLimit when limit is zero
+func CheckSearchLimit(limit int) (int, error) {
+	if limit == 0 {
+		return DefaultSearchLimit, nil
+	}
+	if limit < 0 || limit > MaxSearchLimit {
+		return 0, ErrSearchLimitInvalid
+	}
+	return limit, nil
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeSearchText(t *testing.T) {
+	assert.Equal(t, "sao paulo", NormalizeSearchText("São Paulo"))
+	assert.Equal(t, "uberlandia", NormalizeSearchText("UBERLÂNDIA"))
+	assert.Equal(t, "acao, coracao e pao", NormalizeSearchText("Ação, Coração e Pão"))
+}
+
+func TestSearchTokens(t *testing.T) {
+	assert.Equal(t, []string{"show", "rappa", "sao", "paulo"}, SearchTokens("Show do Rappa - São Paulo, SP"))
+	assert.Equal(t, []string{"night", "2024"}, SearchTokens("The Night of 2024"))
+	assert.Empty(t, SearchTokens("a de SP"))
+}
+
+func TestSearchTerms(t *testing.T) {
+	terms, err := SearchTerms("Paulo são PAULO")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"paulo", "sao"}, terms)
+
+	_, err = SearchTerms("  ")
+	assert.Equal(t, ErrSearchQueryEmpty, err)
+	_, err = SearchTerms("SP de")
+	assert.Equal(t, ErrSearchQueryEmpty, err)
+}
+
+func TestScoreEventSearch(t *testing.T) {
+	event := Event{Name: "Paulo Gustavo", Location: "São Paulo", Organization: "Comédia Ltda"}
+
+	assert.Equal(t, SearchWeightName+SearchWeightLocation, ScoreEventSearch(event, []string{"paulo"}))
+	assert.Equal(t, SearchWeightName+SearchWeightLocation+SearchWeightLocation, ScoreEventSearch(event, []string{"paulo", "sao"}))
+	assert.Equal(t, SearchWeightOrganization, ScoreEventSearch(event, []string{"comed"}))
+	assert.Equal(t, 0, ScoreEventSearch(event, []string{"paulo", "rio"}))
+}
+
+func TestCheckSearchLimit(t *testing.T) {
+	limit, err := CheckSearchLimit(0)
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultSearchLimit, limit)
+
+	limit, err = CheckSearchLimit(5)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, limit)
+
+	_, err = CheckSearchLimit(MaxSearchLimit + 1)
+	assert.Equal(t, ErrSearchLimitInvalid, err)
+	_, err = CheckSearchLimit(-1)
+	assert.Equal(t, ErrSearchLimitInvalid, err)
+}
@@ -0,0 +1,154 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrSeatLayoutEmpty         = errors.New("seat layout must have at least one seat")
+	ErrSeatSectionNameRequired = errors.New("seat section name is required")
+	ErrSeatRowLabelInvalid     = errors.New("seat row label must have 1 to 3 capital letters")
+	ErrSeatNumberInvalid       = errors.New("seat number must be between 1 and 9999")
+	ErrSeatCoordinatesInvalid  = errors.New("seat coordinates must not be negative")
+	ErrSeatAttributeBlank      = errors.New("seat attribute must not be blank")
+	ErrSeatDuplicated          = errors.New("seat layout has the same row and seat number twice")
+	ErrSpotNameAlreadyExists   = errors.New("spot name already exists in event")
+)
+
+const (
+	maxSeatRowLabelLength = 3
+	maxSeatNumber         = 9999
+)
+
+// Seat is a numbered seat of a row. X and Y place the seat on the seat map,
+// in the unit chosen by whoever draws the layout.
+type Seat struct {
+	Number     int      `json:"number"`
+	X          float64  `json:"x"`
+	Y          float64  `json:"y"`
+	Accessible bool     `json:"accessible"`
+	Attributes []string `json:"attributes"`
+}
+
+type SeatRow struct {
+	Label string `json:"label"`
+	Seats []Seat `json:"seats"`
+}
+
+type SeatSection struct {
+	Name string    `json:"name"`
+	Rows []SeatRow `json:"rows"`
+}
+
+// SeatLayout describes the seats of a venue grouped by section and row. Each
+// seat becomes a spot named after its row and number, such as "A1" or
+// "AB12", so row labels are unique across the whole layout.
+type SeatLayout struct {
+	Sections []SeatSection `json:"sections"`
+}
+
+func (l SeatLayout) Validate() error {
+	names := map[string]bool{}
+	for _, section := range l.Sections {
+		if strings.TrimSpace(section.Name) == "" {
+			return ErrSeatSectionNameRequired
+		}
+		for _, row := range section.Rows {
+			if !isSeatRowLabel(row.Label) {
+				return ErrSeatRowLabelInvalid
+			}
+			for _, seat := range row.Seats {
+				if err := seat.Validate(); err != nil {
+					return err
+				}
+				name := SpotName(row.Label, seat.Number)
+				if names[name] {
+					return fmt.Errorf("%w: %s", ErrSeatDuplicated, name)
+				}
+				names[name] = true
+			}
+		}
+	}
+	if len(names) == 0 {
+		return ErrSeatLayoutEmpty
+	}
+	return nil
+}
+
+func (s Seat) Validate() error {
+	if s.Number < 1 || s.Number > maxSeatNumber {
+		return ErrSeatNumberInvalid
+	}
+	if s.X < 0 || s.Y < 0 {
+		return ErrSeatCoordinatesInvalid
+	}
+	for _, attribute := range s.Attributes {
+		if strings.TrimSpace(attribute) == "" {
+			return ErrSeatAttributeBlank
+		}
+	}
+	return nil
+}
+
+// SeatCount returns the number of seats in the layout
+func (l SeatLayout) SeatCount() int {
+	count := 0
+	for _, section := range l.Sections {
+		for _, row := range section.Rows {
+			count += len(row.Seats)
+		}
+	}
+	return count
+}
+
+// SpotName returns the name of the spot for a seat, such as "A1"
+func SpotName(rowLabel string, number int) string {
+	return fmt.Sprintf("%s%d", rowLabel, number)
+}
+
+// SeatRowLabel returns the label of the row at index, counting from zero:
+// "A" to "Z", then "AA", "AB" and so on.
+func SeatRowLabel(index int) string {
+	label := ""
+	for index >= 0 {
+		label = string(rune('A'+index%26)) + label
+		index = index/26 - 1
+	}
+	return label
+}
+
+// SpotNameAt returns the name of the spot at index when spots are generated
+// without a layout, in rows of ten seats: "A1" to "A10", then "B1".
+func SpotNameAt(index int) string {
+	return SpotName(SeatRowLabel(index/10), index%10+1)
+}
+
+func isSeatRowLabel(label string) bool {
+	if len(label) == 0 || len(label) > maxSeatRowLabelLength {
+		return false
+	}
+	for _, c := range label {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// CreatedNewSeatSpot creates the spot of a seat of a layout: Function
+func CreatedNewSeatSpot(e Event, section, rowLabel string, seat Seat) (*Spot, error) {
+	spot, err := CreatedNewSpot(e, SpotName(rowLabel, seat.Number))
+	if err != nil {
+		return nil, err
+	}
+	spot.Section = strings.TrimSpace(section)
+	spot.Row = rowLabel
+	spot.Number = seat.Number
+	spot.X = seat.X
+	spot.Y = seat.Y
+	spot.Accessible = seat.Accessible
+	spot.Attributes = seat.Attributes
+	return spot, nil
+}
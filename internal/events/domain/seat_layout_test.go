@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testSeatLayout() SeatLayout {
+	return SeatLayout{Sections: []SeatSection{
+		{Name: "Plateia", Rows: []SeatRow{
+			{Label: "A", Seats: []Seat{{Number: 1, X: 10, Y: 10}, {Number: 2, X: 20, Y: 10, Accessible: true}}},
+			{Label: "B", Seats: []Seat{{Number: 1, X: 10, Y: 20, Attributes: []string{"aisle"}}}},
+		}},
+		{Name: "Camarote", Rows: []SeatRow{
+			{Label: "AA", Seats: []Seat{{Number: 12, X: 50, Y: 80}}},
+		}},
+	}}
+}
+
+func TestSeatLayout_Validate(t *testing.T) {
+	layout := testSeatLayout()
+	assert.Nil(t, layout.Validate())
+	assert.Equal(t, 4, layout.SeatCount())
+
+	assert.Equal(t, ErrSeatLayoutEmpty, SeatLayout{}.Validate())
+
+	layout = testSeatLayout()
+	layout.Sections[0].Name = " "
+	assert.Equal(t, ErrSeatSectionNameRequired, layout.Validate())
+
+	for _, label := range []string{"", "a", "A1", "ABCD"} {
+		layout = testSeatLayout()
+		layout.Sections[0].Rows[0].Label = label
+		assert.Equal(t, ErrSeatRowLabelInvalid, layout.Validate(), label)
+	}
+
+	layout = testSeatLayout()
+	layout.Sections[0].Rows[0].Seats[0].Number = 0
+	assert.Equal(t, ErrSeatNumberInvalid, layout.Validate())
+
+	layout = testSeatLayout()
+	layout.Sections[0].Rows[0].Seats[0].X = -1
+	assert.Equal(t, ErrSeatCoordinatesInvalid, layout.Validate())
+
+	layout = testSeatLayout()
+	layout.Sections[0].Rows[0].Seats[0].Attributes = []string{""}
+	assert.Equal(t, ErrSeatAttributeBlank, layout.Validate())
+
+	//the same row in two sections would give two spots the same name
+	layout = testSeatLayout()
+	layout.Sections[1].Rows[0].Label = "A"
+	layout.Sections[1].Rows[0].Seats[0].Number = 2
+	assert.ErrorIs(t, layout.Validate(), ErrSeatDuplicated)
+}
+
+func TestSpotNameAt(t *testing.T) {
+	assert.Equal(t, "A1", SpotNameAt(0))
+	assert.Equal(t, "A10", SpotNameAt(9))
+	assert.Equal(t, "B1", SpotNameAt(10))
+	assert.Equal(t, "Z10", SpotNameAt(259))
+	assert.Equal(t, "AA1", SpotNameAt(260))
+
+	assert.Equal(t, "A", SeatRowLabel(0))
+	assert.Equal(t, "Z", SeatRowLabel(25))
+	assert.Equal(t, "AZ", SeatRowLabel(51))
+	assert.Equal(t, 51, seatRowIndex("AZ"))
+
+	for i := 0; i < 1000; i++ {
+		spot := Spot{Name: SpotNameAt(i)}
+		assert.Nil(t, spot.Validate(), spot.Name)
+	}
+}
+
+func TestSpotService_GenerateSpots(t *testing.T) {
+	event, _ := CreatedNewEvent("Event Test", "Location Test", "Organization Test", RatingFree, time.Now().Add(24*time.Hour), "image_url", 100, 50.00, 1)
+
+	assert.Nil(t, NewSpotService().GenerateSpots(event, 11))
+	assert.Equal(t, "A1", event.Spots[0].Name)
+	assert.Equal(t, "B1", event.Spots[10].Name)
+
+	//a second call continues after the spots already created
+	assert.Nil(t, NewSpotService().GenerateSpots(event, 1))
+	assert.Equal(t, "B2", event.Spots[11].Name)
+}
+
+func TestSpotService_GenerateSpotsFromLayout(t *testing.T) {
+	event, _ := CreatedNewEvent("Event Test", "Location Test", "Organization Test", RatingFree, time.Now().Add(24*time.Hour), "image_url", 4, 50.00, 1)
+
+	err := NewSpotService().GenerateSpotsFromLayout(event, testSeatLayout())
+	assert.Nil(t, err)
+	assert.Len(t, event.Spots, 4)
+
+	accessible := event.Spots[1]
+	assert.Equal(t, "A2", accessible.Name)
+	assert.Equal(t, "Plateia", accessible.Section)
+	assert.Equal(t, "A", accessible.Row)
+	assert.Equal(t, 2, accessible.Number)
+	assert.Equal(t, 20.0, accessible.X)
+	assert.True(t, accessible.Accessible)
+	assert.Equal(t, "AA12", event.Spots[3].Name)
+
+	event.Capacity = 10
+	layout := SeatLayout{Sections: []SeatSection{{Name: "Extra", Rows: []SeatRow{{Label: "A", Seats: []Seat{{Number: 1}}}}}}}
+	err = NewSpotService().GenerateSpotsFromLayout(event, layout)
+	assert.ErrorIs(t, err, ErrSpotNameAlreadyExists)
+
+	event.Capacity = 5
+	err = NewSpotService().GenerateSpotsFromLayout(event, testSeatLayout())
+	assert.Equal(t, ErrEventCapacityExceeded, err)
+	assert.Len(t, event.Spots, 4)
+}
@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+)
+
+// DefaultSeatSection is the section of the spots created without a layout
+const DefaultSeatSection = "General"
+
+// SeatMapSeat is a spot placed on the seat map with its current status
+type SeatMapSeat struct {
+	SpotID     string     `json:"spot_id"`
+	Name       string     `json:"name"`
+	Number     int        `json:"number"`
+	X          float64    `json:"x"`
+	Y          float64    `json:"y"`
+	Accessible bool       `json:"accessible"`
+	Attributes []string   `json:"attributes"`
+	Status     SpotStatus `json:"status"`
+}
+
+type SeatMapRow struct {
+	Label string        `json:"label"`
+	Seats []SeatMapSeat `json:"seats"`
+}
+
+type SeatMapSection struct {
+	Name string       `json:"name"`
+	Rows []SeatMapRow `json:"rows"`
+}
+
+// SeatMap is the renderable view of the spots of an event. Width and Height
+// bound the coordinates of every seat.
+type SeatMap struct {
+	Width     float64          `json:"width"`
+	Height    float64          `json:"height"`
+	Total     int              `json:"total"`
+	Available int              `json:"available"`
+	Sections  []SeatMapSection `json:"sections"`
+}
+
+// BuildSeatMap groups the spots by section and row. Spots created without a
+// layout are placed in DefaultSeatSection on a grid derived from their names.
+// Sections are ordered by name, rows by label and seats by number.
+func BuildSeatMap(spots []Spot) SeatMap {
+	seatMap := SeatMap{Sections: []SeatMapSection{}}
+	sections := map[string]map[string][]SeatMapSeat{}
+
+	for _, spot := range spots {
+		section, row, seat := spot.seatPlacement()
+		if sections[section] == nil {
+			sections[section] = map[string][]SeatMapSeat{}
+		}
+		sections[section][row] = append(sections[section][row], seat)
+
+		seatMap.Total++
+		if spot.SpotStatus == SpotStatusAvailable {
+			seatMap.Available++
+		}
+		seatMap.Width = max(seatMap.Width, seat.X)
+		seatMap.Height = max(seatMap.Height, seat.Y)
+	}
+
+	for name, rows := range sections {
+		section := SeatMapSection{Name: name, Rows: []SeatMapRow{}}
+		for label, seats := range rows {
+			slices.SortFunc(seats, func(a, b SeatMapSeat) int {
+				return cmp.Compare(a.Number, b.Number)
+			})
+			section.Rows = append(section.Rows, SeatMapRow{Label: label, Seats: seats})
+		}
+		slices.SortFunc(section.Rows, func(a, b SeatMapRow) int {
+			return compareSeatRowLabels(a.Label, b.Label)
+		})
+		seatMap.Sections = append(seatMap.Sections, section)
+	}
+	slices.SortFunc(seatMap.Sections, func(a, b SeatMapSection) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return seatMap
+}
+
+// seatPlacement returns the section, row and seat of the spot on the seat map
+func (s Spot) seatPlacement() (string, string, SeatMapSeat) {
+	seat := SeatMapSeat{
+		SpotID:     s.ID,
+		Name:       s.Name,
+		Number:     s.Number,
+		X:          s.X,
+		Y:          s.Y,
+		Accessible: s.Accessible,
+		Attributes: s.Attributes,
+		Status:     s.SpotStatus,
+	}
+	if seat.Attributes == nil {
+		seat.Attributes = []string{}
+	}
+	if s.Section != "" {
+		return s.Section, s.Row, seat
+	}
+
+	//spots sem layout: a linha vem das letras do nome e o número dos dígitos
+	row, number := splitSpotName(s.Name)
+	seat.Number = number
+	seat.X = float64(number)
+	seat.Y = float64(seatRowIndex(row) + 1)
+	return DefaultSeatSection, row, seat
+}
+
+// splitSpotName splits a name such as "AB12" into its row "AB" and number 12
+func splitSpotName(name string) (string, int) {
+	i := 0
+	for i < len(name) && name[i] >= 'A' && name[i] <= 'Z' {
+		i++
+	}
+	number, _ := strconv.Atoi(name[i:])
+	return name[:i], number
+}
+
+// seatRowIndex is the inverse of SeatRowLabel
+func seatRowIndex(label string) int {
+	index := 0
+	for _, c := range label {
+		index = index*26 + int(c-'A') + 1
+	}
+	return index - 1
+}
+
+func compareSeatRowLabels(a, b string) int {
+	if c := cmp.Compare(len(a), len(b)); c != 0 {
+		return c
+	}
+	return cmp.Compare(a, b)
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildSeatMap(t *testing.T) {
+	spots := []Spot{
+		{ID: "3", Name: "B1", SpotStatus: SpotStatusAvailable, Section: "Plateia", Row: "B", Number: 1, X: 10, Y: 20},
+		{ID: "2", Name: "A2", SpotStatus: SpotStatusReserved, Section: "Plateia", Row: "A", Number: 2, X: 20, Y: 10, Accessible: true},
+		{ID: "1", Name: "A1", SpotStatus: SpotStatusAvailable, Section: "Plateia", Row: "A", Number: 1, X: 10, Y: 10},
+		{ID: "4", Name: "AA12", SpotStatus: SpotStatusHeld, Section: "Camarote", Row: "AA", Number: 12, X: 50, Y: 80},
+	}
+
+	seatMap := BuildSeatMap(spots)
+	assert.Equal(t, 4, seatMap.Total)
+	assert.Equal(t, 2, seatMap.Available)
+	assert.Equal(t, 50.0, seatMap.Width)
+	assert.Equal(t, 80.0, seatMap.Height)
+
+	assert.Len(t, seatMap.Sections, 2)
+	assert.Equal(t, "Camarote", seatMap.Sections[0].Name)
+	plateia := seatMap.Sections[1]
+	assert.Equal(t, "A", plateia.Rows[0].Label)
+	assert.Equal(t, "B", plateia.Rows[1].Label)
+	assert.Equal(t, "A1", plateia.Rows[0].Seats[0].Name)
+	assert.Equal(t, "A2", plateia.Rows[0].Seats[1].Name)
+	assert.True(t, plateia.Rows[0].Seats[1].Accessible)
+	assert.Equal(t, SpotStatusReserved, plateia.Rows[0].Seats[1].Status)
+	assert.NotNil(t, plateia.Rows[0].Seats[0].Attributes)
+}
+
+func TestBuildSeatMap_SpotsWithoutLayout(t *testing.T) {
+	spots := []Spot{
+		{ID: "1", Name: "B3", SpotStatus: SpotStatusAvailable},
+		{ID: "2", Name: "AA10", SpotStatus: SpotStatusAvailable},
+		{ID: "3", Name: "B1", SpotStatus: SpotStatusAvailable},
+	}
+
+	seatMap := BuildSeatMap(spots)
+	assert.Len(t, seatMap.Sections, 1)
+	section := seatMap.Sections[0]
+	assert.Equal(t, DefaultSeatSection, section.Name)
+	assert.Equal(t, "B", section.Rows[0].Label)
+	assert.Equal(t, "AA", section.Rows[1].Label)
+
+	b1 := section.Rows[0].Seats[0]
+	assert.Equal(t, "B1", b1.Name)
+	assert.Equal(t, 1, b1.Number)
+	assert.Equal(t, 1.0, b1.X)
+	assert.Equal(t, 2.0, b1.Y)
+	assert.Equal(t, 27.0, seatMap.Height)
+	assert.Equal(t, 10.0, seatMap.Width)
+}
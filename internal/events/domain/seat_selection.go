@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"cmp"
+	"errors"
+	"math"
+	"slices"
+	"time"
+)
+
+var (
+	ErrSeatQuantityInvalid = errors.New("seat quantity must be greater than zero")
+	ErrNoContiguousSeats   = errors.New("no contiguous seats available for the requested quantity")
+)
+
+// DefaultSeatHoldTTL is how long seats picked by best available selection
+// stay held for the buyer to complete the purchase.
+const DefaultSeatHoldTTL = 10 * time.Minute
+
+type selectionRow struct {
+	section string
+	label   string
+	y       float64
+	minX    float64
+	maxX    float64
+	seats   []selectionSeat
+}
+
+type selectionSeat struct {
+	spot   Spot
+	number int
+	x      float64
+}
+
+// SelectBestAvailableSeats picks quantity available spots with consecutive
+// numbers in the same row, optionally restricted to a section. Rows closer to
+// the front, the smallest Y, are preferred and, within a row, the block
+// closest to the row center. Ties are broken by section, row label and seat
+// number, so the selection is deterministic. All spots given are used to
+// measure the rows; only available ones are selected.
+func SelectBestAvailableSeats(spots []Spot, quantity int, section string) ([]Spot, error) {
+	if quantity <= 0 {
+		return nil, ErrSeatQuantityInvalid
+	}
+
+	rowsByKey := map[[2]string]*selectionRow{}
+	rows := []*selectionRow{}
+	for _, spot := range spots {
+		spotSection, label, seat := spot.seatPlacement()
+		if section != "" && spotSection != section {
+			continue
+		}
+		key := [2]string{spotSection, label}
+		row, ok := rowsByKey[key]
+		if !ok {
+			row = &selectionRow{section: spotSection, label: label, y: seat.Y, minX: seat.X, maxX: seat.X}
+			rowsByKey[key] = row
+			rows = append(rows, row)
+		}
+		row.y = min(row.y, seat.Y)
+		row.minX = min(row.minX, seat.X)
+		row.maxX = max(row.maxX, seat.X)
+		if spot.SpotStatus == SpotStatusAvailable {
+			row.seats = append(row.seats, selectionSeat{spot: spot, number: seat.Number, x: seat.X})
+		}
+	}
+
+	slices.SortFunc(rows, func(a, b *selectionRow) int {
+		if c := cmp.Compare(a.y, b.y); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(a.section, b.section); c != 0 {
+			return c
+		}
+		return compareSeatRowLabels(a.label, b.label)
+	})
+
+	for _, row := range rows {
+		if seats := row.bestBlock(quantity); seats != nil {
+			return seats, nil
+		}
+	}
+	return nil, ErrNoContiguousSeats
+}
+
+// bestBlock returns the block of quantity consecutive seats closest to the
+// row center, or nil when the row has no such block.
+func (r *selectionRow) bestBlock(quantity int) []Spot {
+	slices.SortFunc(r.seats, func(a, b selectionSeat) int {
+		return cmp.Compare(a.number, b.number)
+	})
+
+	center := (r.minX + r.maxX) / 2
+	best, bestDistance := -1, math.Inf(1)
+	for start := 0; start+quantity <= len(r.seats); start++ {
+		block := r.seats[start : start+quantity]
+		if block[quantity-1].number-block[0].number != quantity-1 {
+			continue
+		}
+		distance := math.Abs((block[0].x+block[quantity-1].x)/2 - center)
+		if distance < bestDistance {
+			best, bestDistance = start, distance
+		}
+	}
+	if best < 0 {
+		return nil
+	}
+
+	spots := make([]Spot, quantity)
+	for i, seat := range r.seats[best : best+quantity] {
+		spots[i] = seat.spot
+	}
+	return spots
+}
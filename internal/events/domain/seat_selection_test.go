@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func selectionSpots() []Spot {
+	spots := []Spot{}
+	for _, row := range []struct {
+		section string
+		label   string
+		y       float64
+	}{{"Plateia", "A", 10}, {"Plateia", "B", 20}, {"Camarote", "C", 5}} {
+		for number := 1; number <= 6; number++ {
+			spots = append(spots, Spot{
+				ID:         SpotName(row.label, number),
+				Name:       SpotName(row.label, number),
+				SpotStatus: SpotStatusAvailable,
+				Section:    row.section,
+				Row:        row.label,
+				Number:     number,
+				X:          float64(number * 10),
+				Y:          row.y,
+			})
+		}
+	}
+	return spots
+}
+
+func spotNames(spots []Spot) []string {
+	names := make([]string, len(spots))
+	for i, spot := range spots {
+		names[i] = spot.Name
+	}
+	return names
+}
+
+func setStatus(spots []Spot, status SpotStatus, names ...string) {
+	for i := range spots {
+		for _, name := range names {
+			if spots[i].Name == name {
+				spots[i].SpotStatus = status
+			}
+		}
+	}
+}
+
+func TestSelectBestAvailableSeats(t *testing.T) {
+	spots := selectionSpots()
+
+	//the front row is in the Camarote section, centered seats are picked
+	selected, err := SelectBestAvailableSeats(spots, 2, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"C3", "C4"}, spotNames(selected))
+
+	selected, err = SelectBestAvailableSeats(spots, 3, "Plateia")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"A2", "A3", "A4"}, spotNames(selected))
+
+	//sold seats break the row into blocks that are too small
+	setStatus(spots, SpotStatusReserved, "A3", "C2", "C5")
+	selected, err = SelectBestAvailableSeats(spots, 3, "Plateia")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"A4", "A5", "A6"}, spotNames(selected))
+
+	selected, err = SelectBestAvailableSeats(spots, 2, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"C3", "C4"}, spotNames(selected))
+
+	setStatus(spots, SpotStatusHeld, "A5", "B3")
+	selected, err = SelectBestAvailableSeats(spots, 3, "Plateia")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"B4", "B5", "B6"}, spotNames(selected))
+
+	_, err = SelectBestAvailableSeats(spots, 4, "Plateia")
+	assert.Equal(t, ErrNoContiguousSeats, err)
+
+	_, err = SelectBestAvailableSeats(spots, 1, "Pista")
+	assert.Equal(t, ErrNoContiguousSeats, err)
+
+	_, err = SelectBestAvailableSeats(spots, 0, "")
+	assert.Equal(t, ErrSeatQuantityInvalid, err)
+}
+
+func TestSelectBestAvailableSeats_Deterministic(t *testing.T) {
+	spots := selectionSpots()
+	setStatus(spots, SpotStatusReserved, "C3", "C4")
+	expected, err := SelectBestAvailableSeats(spots, 2, "")
+	assert.Nil(t, err)
+	//C1-C2 and C5-C6 are as far from the center, the lowest numbers win
+	assert.Equal(t, []string{"C1", "C2"}, spotNames(expected))
+
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		random.Shuffle(len(spots), func(a, b int) { spots[a], spots[b] = spots[b], spots[a] })
+		selected, err := SelectBestAvailableSeats(spots, 2, "")
+		assert.Nil(t, err)
+		assert.Equal(t, spotNames(expected), spotNames(selected))
+	}
+}
+
+func TestSelectBestAvailableSeats_SpotsWithoutLayout(t *testing.T) {
+	spots := []Spot{}
+	for i := 0; i < 20; i++ {
+		spots = append(spots, Spot{ID: SpotNameAt(i), Name: SpotNameAt(i), SpotStatus: SpotStatusAvailable})
+	}
+	setStatus(spots, SpotStatusReserved, "A5", "A6")
+
+	//A1-A4 and A7-A10 are as far from the center, the lowest numbers win
+	selected, err := SelectBestAvailableSeats(spots, 4, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"A1", "A2", "A3", "A4"}, spotNames(selected))
+
+	selected, err = SelectBestAvailableSeats(spots, 5, DefaultSeatSection)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"B3", "B4", "B5", "B6", "B7"}, spotNames(selected))
+}
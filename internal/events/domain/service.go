@@ -16,14 +16,22 @@ func NewSpotService() *spotService {
 	return &spotService{}
 }
 
+// GenerateSpots adds numberSpot spots to the event, named in rows of ten
+// after the spots the event already has.
 func (s *spotService) GenerateSpots(event *Event, numberSpot int) error {
 	if numberSpot <= 0 {
 		return ErrInvalidNumberSpot
 	}
+	if numberSpot > MaxSpotsPerRequest {
+		return ErrSpotCountTooLarge
+	}
+	if err := event.CheckSpotCapacity(numberSpot); err != nil {
+		return err
+	}
 
-	for i := 0; i < numberSpot; i++ {
-		spotName := fmt.Sprintf("%c%d", 'A'+i, i%10+1)
-		spot, err := CreatedNewSpot(*event, spotName)
+	start := len(event.Spots)
+	for i := start; i < start+numberSpot; i++ {
+		spot, err := CreatedNewSpot(*event, SpotNameAt(i))
 		if err != nil {
 			return err
 		}
@@ -31,4 +39,71 @@ func (s *spotService) GenerateSpots(event *Event, numberSpot int) error {
 	}
 	return nil
 
-}
\ No newline at end of file
+}
+
+// GenerateNamedSpots adds a spot to the event for each name, failing with
+// ErrSpotNameAlreadyExists when a name is repeated or already in the event
+func (s *spotService) GenerateNamedSpots(event *Event, names []string) error {
+	if len(names) == 0 {
+		return ErrInvalidNumberSpot
+	}
+	if len(names) > MaxSpotsPerRequest {
+		return ErrSpotCountTooLarge
+	}
+	if err := event.CheckSpotCapacity(len(names)); err != nil {
+		return err
+	}
+
+	existing := map[string]bool{}
+	for _, spot := range event.Spots {
+		existing[spot.Name] = true
+	}
+
+	spots := make([]Spot, 0, len(names))
+	for _, name := range names {
+		spot, err := CreatedNewSpot(*event, name)
+		if err != nil {
+			return fmt.Errorf("%w: %s", err, name)
+		}
+		if existing[spot.Name] {
+			return fmt.Errorf("%w: %s", ErrSpotNameAlreadyExists, spot.Name)
+		}
+		existing[spot.Name] = true
+		spots = append(spots, *spot)
+	}
+	event.Spots = append(event.Spots, spots...)
+	return nil
+}
+
+// GenerateSpotsFromLayout adds a spot to the event for every seat of the layout
+func (s *spotService) GenerateSpotsFromLayout(event *Event, layout SeatLayout) error {
+	if err := layout.Validate(); err != nil {
+		return err
+	}
+	if err := event.CheckSpotCapacity(layout.SeatCount()); err != nil {
+		return err
+	}
+
+	existing := map[string]bool{}
+	for _, spot := range event.Spots {
+		existing[spot.Name] = true
+	}
+
+	spots := make([]Spot, 0, layout.SeatCount())
+	for _, section := range layout.Sections {
+		for _, row := range section.Rows {
+			for _, seat := range row.Seats {
+				spot, err := CreatedNewSeatSpot(*event, section.Name, row.Label, seat)
+				if err != nil {
+					return err
+				}
+				if existing[spot.Name] {
+					return fmt.Errorf("%w: %s", ErrSpotNameAlreadyExists, spot.Name)
+				}
+				spots = append(spots, *spot)
+			}
+		}
+	}
+	event.Spots = append(event.Spots, spots...)
+	return nil
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -10,27 +11,50 @@ var (
 	ErrSpotNameRequired = errors.New("spot name is required")
 	ErrSpotNumberInvalid = errors.New("spot number invalid")
 	ErrSpotNameCharMin = errors.New("spot name must have at least 2 characters")
+	ErrSpotNameTooLong = errors.New("spot name must have at most 10 characters")
 	ErrSportNameFormatInit = errors.New("spot name must start with a capital letter")
 	ErrSportNameFormatEnd = errors.New("spot name must end with a number")
 	ErrSpotEventIDNotFount = errors.New("spot not found in event")
 	ErrorSpotNotFound = errors.New("spot not found")
 	ErrorSpotAlreadyReserved = errors.New("spot is already reserved")
+	ErrSpotNotAvailable = errors.New("spot is not available")
+	ErrSpotHeld = errors.New("spot is held for another buyer")
 )
 
+// maxSpotNameLength is the size of the name column of the spots table
+const maxSpotNameLength = 10
+
 type SpotStatus string
 
 const (
 	SpotStatusAvailable SpotStatus = "available"
 	SpotStatusReserved  SpotStatus = "reserved"
 	SpotStatusSold      SpotStatus = "sold"
+	SpotStatusHeld      SpotStatus = "held"
+	// SpotStatusBlocked spots are kept out of sale by the organizer
+	SpotStatusBlocked   SpotStatus = "blocked"
 )
 
+// Spot is a seat of an event. A held spot is kept exclusively for HeldBy,
+// the email of a buyer, until HoldExpiresAt, and a blocked spot is kept out
+// of sale for BlockedReason. Spots generated from a seat layout carry the
+// section, row, number and position of their seat.
 type Spot struct {
 	ID         string     `json:"id"`
 	EventID    string     `json:"event_id"`
 	Name       string     `json:"name"`
 	SpotStatus SpotStatus `json:"spot_status"`
 	TicketID   string     `json:"ticket_id"`
+	HeldBy        string    `json:"held_by"`
+	HoldExpiresAt time.Time `json:"hold_expires_at"`
+	BlockedReason string    `json:"blocked_reason"`
+	Section    string   `json:"section"`
+	Row        string   `json:"row"`
+	Number     int      `json:"number"`
+	X          float64  `json:"x"`
+	Y          float64  `json:"y"`
+	Accessible bool     `json:"accessible"`
+	Attributes []string `json:"attributes"`
 }
 
 
@@ -42,13 +66,25 @@ func (s Spot) Validate() error {
 	if len(s.Name) < 2 {
 		return ErrSpotNameCharMin
 	}
-	//Validade format of name exemple: "A1"
+	if len(s.Name) > maxSpotNameLength {
+		return ErrSpotNameTooLong
+	}
+	//Validade format of name exemple: "A1" or "AB12", row letters then seat number
 	if s.Name[0] < 'A' || s.Name[0] > 'Z' {
 		return ErrSportNameFormatInit
 	}
-	if s.Name[1] < '0' || s.Name[1] > '9' {
+	i := 0
+	for i < len(s.Name) && s.Name[i] >= 'A' && s.Name[i] <= 'Z' {
+		i++
+	}
+	if i == len(s.Name) {
 		return ErrSportNameFormatEnd
 	}
+	for ; i < len(s.Name); i++ {
+		if s.Name[i] < '0' || s.Name[i] > '9' {
+			return ErrSportNameFormatEnd
+		}
+	}
 	return nil
 }
 
@@ -78,3 +114,53 @@ func (s *Spot) ReserveSpot(ticketID string) error {
 	s.TicketID = ticketID
 	return nil
 }
+
+//Hold keeps an available spot exclusively for a buyer until the given time: Method
+func (s *Spot) Hold(holder string, until time.Time) error {
+	if s.SpotStatus != SpotStatusAvailable {
+		return ErrSpotNotAvailable
+	}
+	s.SpotStatus = SpotStatusHeld
+	s.HeldBy = NormalizeEmail(holder)
+	s.HoldExpiresAt = until
+	return nil
+}
+
+//IsHoldExpired reports whether the spot is held and the hold is over: Method
+func (s Spot) IsHoldExpired(now time.Time) bool {
+	return s.SpotStatus == SpotStatusHeld && !now.Before(s.HoldExpiresAt)
+}
+
+//IsHeldFor reports whether the spot is held for the buyer and the hold is not over: Method
+func (s Spot) IsHeldFor(email string, now time.Time) bool {
+	return s.SpotStatus == SpotStatusHeld && !s.IsHoldExpired(now) && s.HeldBy == NormalizeEmail(email)
+}
+
+//ReleaseHold makes a held spot available again: Method
+func (s *Spot) ReleaseHold() {
+	if s.SpotStatus != SpotStatusHeld {
+		return
+	}
+	s.SpotStatus = SpotStatusAvailable
+	s.HeldBy = ""
+	s.HoldExpiresAt = time.Time{}
+}
+
+//CheckPurchasableBy verifies the buyer may purchase the spot: it must be
+//available, or held for this buyer with a hold that did not expire: Method
+func (s Spot) CheckPurchasableBy(email string, now time.Time) error {
+	switch s.SpotStatus {
+	case SpotStatusAvailable:
+		return nil
+	case SpotStatusHeld:
+		if s.IsHoldExpired(now) {
+			return nil
+		}
+		if s.HeldBy != NormalizeEmail(email) {
+			return ErrSpotHeld
+		}
+		return nil
+	default:
+		return ErrSpotNotAvailable
+	}
+}
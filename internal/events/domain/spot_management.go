@@ -0,0 +1,221 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSpotSold                = errors.New("spot is sold and cannot be changed")
+	ErrSpotNotBlocked          = errors.New("spot is not blocked")
+	ErrSpotBlockReasonRequired = errors.New("spot block reason is required")
+	ErrSpotBlockReasonTooLong  = errors.New("spot block reason must have at most 255 characters")
+	ErrSpotStatusChangeInvalid = errors.New(`spot status can only be changed to "blocked" or "available"`)
+	ErrSpotUpdateEmpty         = errors.New("spot update must change the status or the name")
+	ErrSpotChangeActorRequired = errors.New("spot change actor is required")
+)
+
+const maxSpotBlockReasonLength = 255
+
+// checkChangeable refuses changes to spots that were sold or are held for a
+// buyer in the middle of a purchase
+func (s Spot) checkChangeable() error {
+	if s.TicketID != "" || s.SpotStatus == SpotStatusReserved || s.SpotStatus == SpotStatusSold {
+		return ErrSpotSold
+	}
+	if s.SpotStatus == SpotStatusHeld {
+		return ErrSpotHeld
+	}
+	return nil
+}
+
+//Block takes the spot out of sale, for the press or sponsors for example: Method
+func (s *Spot) Block(reason string) error {
+	if err := s.checkChangeable(); err != nil {
+		return err
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return ErrSpotBlockReasonRequired
+	}
+	if len(reason) > maxSpotBlockReasonLength {
+		return ErrSpotBlockReasonTooLong
+	}
+	s.SpotStatus = SpotStatusBlocked
+	s.BlockedReason = reason
+	return nil
+}
+
+//Unblock puts a blocked spot back on sale: Method
+func (s *Spot) Unblock() error {
+	if s.SpotStatus != SpotStatusBlocked {
+		return ErrSpotNotBlocked
+	}
+	s.SpotStatus = SpotStatusAvailable
+	s.BlockedReason = ""
+	return nil
+}
+
+//Rename changes the name of a spot not sold: Method
+func (s *Spot) Rename(name string) error {
+	if err := s.checkChangeable(); err != nil {
+		return err
+	}
+	renamed := *s
+	renamed.Name = strings.ToUpper(strings.TrimSpace(name))
+	if err := renamed.Validate(); err != nil {
+		return err
+	}
+	s.Name = renamed.Name
+	return nil
+}
+
+type SpotChangeAction string
+
+const (
+	SpotChangeBlocked   SpotChangeAction = "blocked"
+	SpotChangeUnblocked SpotChangeAction = "unblocked"
+	SpotChangeRenamed   SpotChangeAction = "renamed"
+	SpotChangeDeleted   SpotChangeAction = "deleted"
+)
+
+// SpotChange is an entry of the audit trail of the spots of an event. It
+// keeps the name of the spot at the time of the change, so the entries of
+// deleted and renamed spots still say which seat was changed.
+type SpotChange struct {
+	ID           string           `json:"id"`
+	EventID      string           `json:"event_id"`
+	SpotID       string           `json:"spot_id"`
+	SpotName     string           `json:"spot_name"`
+	Action       SpotChangeAction `json:"action"`
+	PreviousName string           `json:"previous_name"`
+	Reason       string           `json:"reason"`
+	Actor        string           `json:"actor"`
+	CreatedAt    time.Time        `json:"created_at"`
+}
+
+func newSpotChange(spot Spot, action SpotChangeAction, actor string, now time.Time) SpotChange {
+	return SpotChange{
+		ID:        uuid.New().String(),
+		EventID:   spot.EventID,
+		SpotID:    spot.ID,
+		SpotName:  spot.Name,
+		Action:    action,
+		Actor:     actor,
+		CreatedAt: now.UTC(),
+	}
+}
+
+// SpotUpdate changes one spot: Status "blocked" blocks it with Reason,
+// "available" unblocks it and Name renames it
+type SpotUpdate struct {
+	SpotID string     `json:"spot_id"`
+	Status SpotStatus `json:"status"`
+	Reason string     `json:"reason"`
+	Name   string     `json:"name"`
+}
+
+// UpdateSpots applies the updates to spots, all the spots of an event, and
+// returns the audit entries of the changes. It fails without changing
+// anything if any update is refused or leaves two spots with the same name.
+func UpdateSpots(spots []Spot, updates []SpotUpdate, actor string, now time.Time) ([]SpotChange, error) {
+	actor = strings.TrimSpace(actor)
+	if actor == "" {
+		return nil, ErrSpotChangeActorRequired
+	}
+
+	updated := make([]Spot, len(spots))
+	copy(updated, spots)
+	changes := []SpotChange{}
+	for _, update := range updates {
+		i := indexOfSpot(updated, update.SpotID)
+		if i < 0 {
+			return nil, fmt.Errorf("%w: %s", ErrorSpotNotFound, update.SpotID)
+		}
+		spot := &updated[i]
+		if update.Status == "" && update.Name == "" {
+			return nil, ErrSpotUpdateEmpty
+		}
+
+		if name := strings.ToUpper(strings.TrimSpace(update.Name)); name != "" && name != spot.Name {
+			previous := spot.Name
+			if err := spot.Rename(name); err != nil {
+				return nil, fmt.Errorf("%w: %s", err, previous)
+			}
+			change := newSpotChange(*spot, SpotChangeRenamed, actor, now)
+			change.PreviousName = previous
+			changes = append(changes, change)
+		}
+
+		switch update.Status {
+		case "":
+		case SpotStatusBlocked:
+			if err := spot.Block(update.Reason); err != nil {
+				return nil, fmt.Errorf("%w: %s", err, spot.Name)
+			}
+			change := newSpotChange(*spot, SpotChangeBlocked, actor, now)
+			change.Reason = spot.BlockedReason
+			changes = append(changes, change)
+		case SpotStatusAvailable:
+			if err := spot.Unblock(); err != nil {
+				return nil, fmt.Errorf("%w: %s", err, spot.Name)
+			}
+			changes = append(changes, newSpotChange(*spot, SpotChangeUnblocked, actor, now))
+		default:
+			return nil, ErrSpotStatusChangeInvalid
+		}
+	}
+
+	names := map[string]bool{}
+	for _, spot := range updated {
+		if names[spot.Name] {
+			return nil, fmt.Errorf("%w: %s", ErrSpotNameAlreadyExists, spot.Name)
+		}
+		names[spot.Name] = true
+	}
+	copy(spots, updated)
+	return changes, nil
+}
+
+// DeleteSpots checks the spots with the given IDs, out of spots, can be
+// removed and returns the audit entries of their removal
+func DeleteSpots(spots []Spot, spotIDs []string, actor string, now time.Time) ([]SpotChange, error) {
+	actor = strings.TrimSpace(actor)
+	if actor == "" {
+		return nil, ErrSpotChangeActorRequired
+	}
+	if len(spotIDs) == 0 {
+		return nil, ErrInvalidNumberSpot
+	}
+
+	changes := []SpotChange{}
+	deleted := map[string]bool{}
+	for _, spotID := range spotIDs {
+		i := indexOfSpot(spots, spotID)
+		if i < 0 {
+			return nil, fmt.Errorf("%w: %s", ErrorSpotNotFound, spotID)
+		}
+		if deleted[spotID] {
+			continue
+		}
+		if err := spots[i].checkChangeable(); err != nil {
+			return nil, fmt.Errorf("%w: %s", err, spots[i].Name)
+		}
+		deleted[spotID] = true
+		changes = append(changes, newSpotChange(spots[i], SpotChangeDeleted, actor, now))
+	}
+	return changes, nil
+}
+
+func indexOfSpot(spots []Spot, spotID string) int {
+	for i, spot := range spots {
+		if spot.ID == spotID {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testManagedSpots() []Spot {
+	return []Spot{
+		{ID: "s1", EventID: "e1", Name: "A1", SpotStatus: SpotStatusAvailable},
+		{ID: "s2", EventID: "e1", Name: "A2", SpotStatus: SpotStatusAvailable},
+		{ID: "s3", EventID: "e1", Name: "A3", SpotStatus: SpotStatusReserved, TicketID: "t1"},
+		{ID: "s4", EventID: "e1", Name: "A4", SpotStatus: SpotStatusHeld, HeldBy: "buyer@example.com"},
+	}
+}
+
+func TestSpot_BlockAndUnblock(t *testing.T) {
+	spot := testManagedSpots()[0]
+	assert.Equal(t, ErrSpotBlockReasonRequired, spot.Block(" "))
+	assert.Equal(t, ErrSpotNotBlocked, spot.Unblock())
+
+	assert.Nil(t, spot.Block(" Imprensa "))
+	assert.Equal(t, SpotStatusBlocked, spot.SpotStatus)
+	assert.Equal(t, "Imprensa", spot.BlockedReason)
+	assert.Equal(t, ErrSpotNotAvailable, spot.CheckPurchasableBy("buyer@example.com", time.Now()))
+	assert.Equal(t, ErrSpotNotAvailable, spot.Hold("buyer@example.com", time.Now().Add(time.Minute)))
+
+	assert.Nil(t, spot.Unblock())
+	assert.Equal(t, SpotStatusAvailable, spot.SpotStatus)
+	assert.Empty(t, spot.BlockedReason)
+
+	sold := testManagedSpots()[2]
+	assert.Equal(t, ErrSpotSold, sold.Block("Patrocinador"))
+	held := testManagedSpots()[3]
+	assert.Equal(t, ErrSpotHeld, held.Block("Patrocinador"))
+}
+
+func TestSpot_Rename(t *testing.T) {
+	spot := testManagedSpots()[0]
+	assert.Nil(t, spot.Rename(" b7 "))
+	assert.Equal(t, "B7", spot.Name)
+	assert.Equal(t, ErrSportNameFormatEnd, spot.Rename("BX"))
+	assert.Equal(t, "B7", spot.Name)
+
+	sold := testManagedSpots()[2]
+	assert.Equal(t, ErrSpotSold, sold.Rename("B1"))
+}
+
+func TestUpdateSpots(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	spots := testManagedSpots()
+
+	changes, err := UpdateSpots(spots, []SpotUpdate{
+		{SpotID: "s1", Status: SpotStatusBlocked, Reason: "Imprensa"},
+		{SpotID: "s2", Name: "B2"},
+	}, "organizer@example.com", now)
+	assert.Nil(t, err)
+	assert.Equal(t, SpotStatusBlocked, spots[0].SpotStatus)
+	assert.Equal(t, "B2", spots[1].Name)
+	if assert.Len(t, changes, 2) {
+		assert.Equal(t, SpotChangeBlocked, changes[0].Action)
+		assert.Equal(t, "Imprensa", changes[0].Reason)
+		assert.Equal(t, "e1", changes[0].EventID)
+		assert.Equal(t, SpotChangeRenamed, changes[1].Action)
+		assert.Equal(t, "A2", changes[1].PreviousName)
+		assert.Equal(t, "B2", changes[1].SpotName)
+		assert.Equal(t, "organizer@example.com", changes[1].Actor)
+		assert.Equal(t, now, changes[1].CreatedAt)
+	}
+
+	//two spots may swap names in the same change
+	_, err = UpdateSpots(spots, []SpotUpdate{{SpotID: "s1", Name: "B2"}, {SpotID: "s2", Name: "A1"}}, "organizer@example.com", now)
+	assert.Nil(t, err)
+	assert.Equal(t, "B2", spots[0].Name)
+	assert.Equal(t, "A1", spots[1].Name)
+
+	//a refused update leaves every spot unchanged
+	before := testManagedSpots()
+	spots = testManagedSpots()
+	_, err = UpdateSpots(spots, []SpotUpdate{{SpotID: "s1", Status: SpotStatusBlocked, Reason: "Imprensa"}, {SpotID: "s3", Name: "C3"}}, "organizer@example.com", now)
+	assert.ErrorIs(t, err, ErrSpotSold)
+	assert.Equal(t, before, spots)
+
+	_, err = UpdateSpots(spots, []SpotUpdate{{SpotID: "s1", Name: "A2"}}, "organizer@example.com", now)
+	assert.ErrorIs(t, err, ErrSpotNameAlreadyExists)
+	_, err = UpdateSpots(spots, []SpotUpdate{{SpotID: "s9", Name: "A9"}}, "organizer@example.com", now)
+	assert.ErrorIs(t, err, ErrorSpotNotFound)
+	_, err = UpdateSpots(spots, []SpotUpdate{{SpotID: "s1", Status: SpotStatusSold}}, "organizer@example.com", now)
+	assert.Equal(t, ErrSpotStatusChangeInvalid, err)
+	_, err = UpdateSpots(spots, []SpotUpdate{{SpotID: "s1"}}, "organizer@example.com", now)
+	assert.Equal(t, ErrSpotUpdateEmpty, err)
+	_, err = UpdateSpots(spots, []SpotUpdate{{SpotID: "s1", Name: "A9"}}, " ", now)
+	assert.Equal(t, ErrSpotChangeActorRequired, err)
+	assert.Equal(t, before, spots)
+}
+
+func TestDeleteSpots(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	spots := testManagedSpots()
+	spots[1].SpotStatus = SpotStatusBlocked
+
+	changes, err := DeleteSpots(spots, []string{"s1", "s2", "s1"}, "organizer@example.com", now)
+	assert.Nil(t, err)
+	if assert.Len(t, changes, 2) {
+		assert.Equal(t, SpotChangeDeleted, changes[0].Action)
+		assert.Equal(t, "A1", changes[0].SpotName)
+		assert.Equal(t, "s2", changes[1].SpotID)
+	}
+
+	_, err = DeleteSpots(spots, []string{"s1", "s3"}, "organizer@example.com", now)
+	assert.ErrorIs(t, err, ErrSpotSold)
+	_, err = DeleteSpots(spots, []string{"s4"}, "organizer@example.com", now)
+	assert.ErrorIs(t, err, ErrSpotHeld)
+	_, err = DeleteSpots(spots, []string{"s9"}, "organizer@example.com", now)
+	assert.ErrorIs(t, err, ErrorSpotNotFound)
+	_, err = DeleteSpots(spots, nil, "organizer@example.com", now)
+	assert.Equal(t, ErrInvalidNumberSpot, err)
+}
@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrSpotRangeInvalid    = errors.New(`spot range must be a spot name or a block such as "A1-A20", separated by commas`)
+	ErrSpotTemplateInvalid = errors.New("spot template must use {seat} once, and {row} only together with rows")
+	ErrSpotCountTooLarge   = errors.New("too many spots in one request")
+)
+
+// MaxSpotsPerRequest is how many spots one request may create
+const MaxSpotsPerRequest = 10000
+
+// ParseSpotRanges lists the spot names of spec, a list separated by commas
+// of single names, such as "C5", and blocks, such as "A1-A20". A block
+// whose ends are in different rows covers the same seats in every row
+// between them: "A1-C10" is A1 to A10, B1 to B10 and C1 to C10.
+func ParseSpotRanges(spec string) ([]string, error) {
+	names := []string{}
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.ToUpper(strings.TrimSpace(item))
+		first, last, isBlock := strings.Cut(item, "-")
+		if !isBlock {
+			last = first
+		}
+		firstRow, firstSeat, ok := parseSpotName(first)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrSpotRangeInvalid, item)
+		}
+		lastRow, lastSeat, ok := parseSpotName(last)
+		if !ok || lastRow < firstRow || lastSeat < firstSeat {
+			return nil, fmt.Errorf("%w: %s", ErrSpotRangeInvalid, item)
+		}
+
+		if len(names)+(lastRow-firstRow+1)*(lastSeat-firstSeat+1) > MaxSpotsPerRequest {
+			return nil, ErrSpotCountTooLarge
+		}
+		for row := firstRow; row <= lastRow; row++ {
+			for seat := firstSeat; seat <= lastSeat; seat++ {
+				names = append(names, SpotName(SeatRowLabel(row), seat))
+			}
+		}
+	}
+	return names, nil
+}
+
+// parseSpotName reads a name such as "AB12" as the index of its row label
+// and its seat number
+func parseSpotName(name string) (int, int, bool) {
+	i := 0
+	for i < len(name) && name[i] >= 'A' && name[i] <= 'Z' {
+		i++
+	}
+	if !isSeatRowLabel(name[:i]) || i == len(name) || strings.Trim(name[i:], "0123456789") != "" {
+		return 0, 0, false
+	}
+	seat, err := strconv.Atoi(name[i:])
+	if err != nil || seat < 1 || seat > maxSeatNumber {
+		return 0, 0, false
+	}
+	return seatRowIndex(name[:i]), seat, true
+}
+
+// SpotNameTemplate names the spots from Pattern, where {row} is replaced by
+// each row label of Rows, such as "A-D", and {seat} by each number of Seats,
+// such as "1-20". {seat:3} pads the number with zeros to three digits.
+// Without {row} the template names a single row of seats, such as "VIP{seat}".
+type SpotNameTemplate struct {
+	Pattern string `json:"pattern"`
+	Rows    string `json:"rows"`
+	Seats   string `json:"seats"`
+}
+
+// Names lists the spot names of the template, row by row
+func (t SpotNameTemplate) Names() ([]string, error) {
+	prefix, rest, ok := strings.Cut(t.Pattern, "{seat")
+	if !ok {
+		return nil, ErrSpotTemplateInvalid
+	}
+	width, suffix, ok := strings.Cut(rest, "}")
+	if !ok || strings.Contains(suffix, "{seat") {
+		return nil, ErrSpotTemplateInvalid
+	}
+	padding := 0
+	if width != "" {
+		var err error
+		padding, err = strconv.Atoi(strings.TrimPrefix(width, ":"))
+		if !strings.HasPrefix(width, ":") || err != nil || padding < 1 || padding > 4 {
+			return nil, ErrSpotTemplateInvalid
+		}
+	}
+
+	//sem {row} o template nomeia uma única fileira
+	hasRow := strings.Contains(t.Pattern, "{row}")
+	if hasRow != (t.Rows != "") || strings.Count(t.Pattern, "{row}") > 1 {
+		return nil, ErrSpotTemplateInvalid
+	}
+	rows := []string{""}
+	if hasRow {
+		first, last, err := parseTemplateRange(t.Rows, func(label string) (int, bool) {
+			return seatRowIndex(label), isSeatRowLabel(label)
+		})
+		if err != nil {
+			return nil, err
+		}
+		rows = rows[:0]
+		for row := first; row <= last; row++ {
+			rows = append(rows, SeatRowLabel(row))
+		}
+	}
+	firstSeat, lastSeat, err := parseTemplateRange(t.Seats, func(number string) (int, bool) {
+		seat, err := strconv.Atoi(number)
+		return seat, err == nil && seat >= 0 && seat <= maxSeatNumber
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows)*(lastSeat-firstSeat+1) > MaxSpotsPerRequest {
+		return nil, ErrSpotCountTooLarge
+	}
+	names := make([]string, 0, len(rows)*(lastSeat-firstSeat+1))
+	for _, row := range rows {
+		for seat := firstSeat; seat <= lastSeat; seat++ {
+			name := strings.ReplaceAll(prefix, "{row}", row) + fmt.Sprintf("%0*d", padding, seat) + strings.ReplaceAll(suffix, "{row}", row)
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// parseTemplateRange reads "first-last" or a single value with parse
+func parseTemplateRange(spec string, parse func(string) (int, bool)) (int, int, error) {
+	firstText, lastText, isRange := strings.Cut(strings.ToUpper(strings.TrimSpace(spec)), "-")
+	if !isRange {
+		lastText = firstText
+	}
+	first, ok := parse(strings.TrimSpace(firstText))
+	if !ok {
+		return 0, 0, fmt.Errorf("%w: %s", ErrSpotTemplateInvalid, spec)
+	}
+	last, ok := parse(strings.TrimSpace(lastText))
+	if !ok || last < first {
+		return 0, 0, fmt.Errorf("%w: %s", ErrSpotTemplateInvalid, spec)
+	}
+	return first, last, nil
+}
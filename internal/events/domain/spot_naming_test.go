@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSpotRanges(t *testing.T) {
+	names, err := ParseSpotRanges("A1-A3, b2-B3,C5")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"A1", "A2", "A3", "B2", "B3", "C5"}, names)
+
+	//a block across rows covers the same seats in every row
+	names, err = ParseSpotRanges("Y9-AA10")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Y9", "Y10", "Z9", "Z10", "AA9", "AA10"}, names)
+
+	for _, spec := range []string{"", "A", "1-5", "A0", "A5-A1", "B1-A3", "A1-", "A1x", "ABCD1"} {
+		_, err := ParseSpotRanges(spec)
+		assert.ErrorIs(t, err, ErrSpotRangeInvalid, spec)
+	}
+
+	_, err = ParseSpotRanges("A1-Z500")
+	assert.Equal(t, ErrSpotCountTooLarge, err)
+}
+
+func TestSpotNameTemplate_Names(t *testing.T) {
+	names, err := SpotNameTemplate{Pattern: "{row}{seat:2}", Rows: "A-B", Seats: "9-10"}.Names()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"A09", "A10", "B09", "B10"}, names)
+
+	names, err = SpotNameTemplate{Pattern: "VIP{seat}", Seats: "1-3"}.Names()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"VIP1", "VIP2", "VIP3"}, names)
+
+	invalid := []SpotNameTemplate{
+		{Pattern: "{row}", Rows: "A"},
+		{Pattern: "{row}{seat}", Seats: "1-3"},
+		{Pattern: "VIP{seat}", Rows: "A", Seats: "1-3"},
+		{Pattern: "{seat}{seat}", Seats: "1"},
+		{Pattern: "V{seat:9}", Seats: "1"},
+		{Pattern: "{row}{seat}", Rows: "C-A", Seats: "1"},
+		{Pattern: "{row}{seat}", Rows: "A", Seats: "x"},
+	}
+	for _, template := range invalid {
+		_, err := template.Names()
+		assert.ErrorIs(t, err, ErrSpotTemplateInvalid, template.Pattern)
+	}
+}
+
+func TestSpotService_GenerateNamedSpots(t *testing.T) {
+	event, _ := CreatedNewEvent("Event Test", "Location Test", "Organization Test", RatingFree, time.Now().Add(24*time.Hour), "image_url", 4, 50.00, 1)
+
+	assert.Nil(t, NewSpotService().GenerateNamedSpots(event, []string{"A1", "A2"}))
+	assert.Len(t, event.Spots, 2)
+	assert.Equal(t, SpotStatusAvailable, event.Spots[1].SpotStatus)
+
+	err := NewSpotService().GenerateNamedSpots(event, []string{"B1", "B1"})
+	assert.ErrorIs(t, err, ErrSpotNameAlreadyExists)
+	err = NewSpotService().GenerateNamedSpots(event, []string{"A2"})
+	assert.ErrorIs(t, err, ErrSpotNameAlreadyExists)
+	err = NewSpotService().GenerateNamedSpots(event, []string{"ABCDEFGHI12"})
+	assert.ErrorIs(t, err, ErrSpotNameTooLong)
+	err = NewSpotService().GenerateNamedSpots(event, []string{"B1", "B2", "B3"})
+	assert.Equal(t, ErrEventCapacityExceeded, err)
+	assert.Equal(t, ErrInvalidNumberSpot, NewSpotService().GenerateNamedSpots(event, nil))
+	assert.Len(t, event.Spots, 2)
+}
@@ -53,6 +53,15 @@ func TestSpot_Validate(t *testing.T) {
 	spot.Name = "A1"
 	err = spot.Validate()
 	assert.Nil(t, err)
+
+	spot.Name = "AB12"
+	assert.Nil(t, spot.Validate())
+
+	spot.Name = "A-1"
+	assert.Equal(t, ErrSportNameFormatEnd, spot.Validate())
+
+	spot.Name = "A1B"
+	assert.Equal(t, ErrSportNameFormatEnd, spot.Validate())
 }
 
 func TestSpot_Reserve(t *testing.T) {
@@ -66,4 +75,35 @@ func TestSpot_Reserve(t *testing.T) {
 	 assert.Nil(t, err)
 	 assert.Equal(t, SpotStatusReserved, spot.SpotStatus)
 	 assert.Equal(t, "Ticket123", spot.TicketID)
-}
\ No newline at end of file
+}
+
+func TestSpot_Hold(t *testing.T) {
+	event, _ := CreatedNewEvent("Event Test", "Location Test", "Organization Test", RatingFree, time.Now().Add(24*time.Hour), "image_url", 100, 50.00, 1)
+	spot, _ := CreatedNewSpot(*event, "A1")
+	now := time.Now()
+
+	err := spot.Hold(" Buyer@Example.com ", now.Add(15*time.Minute))
+	assert.Nil(t, err)
+	assert.Equal(t, SpotStatusHeld, spot.SpotStatus)
+	assert.Equal(t, "buyer@example.com", spot.HeldBy)
+	assert.Equal(t, ErrSpotNotAvailable, spot.Hold("other@example.com", now.Add(time.Minute)))
+
+	assert.Nil(t, spot.CheckPurchasableBy("buyer@example.com", now))
+	assert.Equal(t, ErrSpotHeld, spot.CheckPurchasableBy("other@example.com", now))
+	assert.False(t, spot.IsHoldExpired(now))
+	assert.True(t, spot.IsHeldFor("BUYER@example.com", now))
+	assert.False(t, spot.IsHeldFor("other@example.com", now))
+
+	later := now.Add(16 * time.Minute)
+	assert.True(t, spot.IsHoldExpired(later))
+	assert.False(t, spot.IsHeldFor("buyer@example.com", later))
+	assert.Nil(t, spot.CheckPurchasableBy("other@example.com", later))
+
+	spot.ReleaseHold()
+	assert.Equal(t, SpotStatusAvailable, spot.SpotStatus)
+	assert.Empty(t, spot.HeldBy)
+	assert.True(t, spot.HoldExpiresAt.IsZero())
+
+	spot.ReserveSpot("ticket-1")
+	assert.Equal(t, ErrSpotNotAvailable, spot.CheckPurchasableBy("buyer@example.com", now))
+}
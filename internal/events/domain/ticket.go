@@ -2,11 +2,17 @@ package domain
 
 import (
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 )
 
-type TicketStatus string
+// TicketKind identifies the ticket category a ticket was sold in, such as
+// "full", "half" or an event specific category like "vip".
+type TicketKind string
+
+// Deprecated: TicketStatus is the former name of TicketKind.
+type TicketStatus = TicketKind
 
 var (
 	ErrTicketSpotRequired  = errors.New("ticket spot is required")
@@ -15,53 +21,121 @@ var (
 )
 
 const (
-	TicketStatusHalf TicketStatus = "half"
-	TicketStatusFull TicketStatus = "full"
+	TicketKindHalf TicketKind = "half"
+	TicketKindFull TicketKind = "full"
+
+	// Deprecated: use TicketKindHalf.
+	TicketStatusHalf = TicketKindHalf
+	// Deprecated: use TicketKindFull.
+	TicketStatusFull = TicketKindFull
 )
 
+// IsValidTicketStatus reports whether the kind is one of the built in kinds
+// every event accepts unless it overrides them with its own categories.
 func IsValidTicketStatus(status TicketStatus) bool {
-	return status == TicketStatusHalf || status == TicketStatusFull
+	return status == TicketKindHalf || status == TicketKindFull
+}
+
+// IsValidTicketKind checks the format of a ticket kind code: lowercase
+// letters, digits and underscores, starting with a letter.
+func IsValidTicketKind(kind TicketKind) bool {
+	if len(kind) == 0 || len(kind) > 20 {
+		return false
+	}
+	for i, c := range kind {
+		switch {
+		case c >= 'a' && c <= 'z':
+		case (c >= '0' && c <= '9') || c == '_':
+			if i == 0 {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
 }
 
 type Ticket struct {
 	ID           string       `json:"id"`
 	EventID      string       `json:"event_id"`
+	OrderID      string       `json:"order_id"`
 	Spot         *Spot        `json:"spot"`
-	TicketKind TicketStatus `json:"ticket_kind"`
+	TicketKind TicketKind `json:"ticket_kind"`
 	Price        float64      `json:"price"`
+	CouponID     string       `json:"coupon_id"`
+	Discount     float64      `json:"discount"`
+	AttendeeName string       `json:"attendee_name"`
+	AttendeeDocument string   `json:"attendee_document"`
+	HolderEmail  string       `json:"holder_email"`
+	CredentialVersion int     `json:"credential_version"`
+	CheckedInAt  *time.Time   `json:"checked_in_at"`
+	CheckedInGate string      `json:"checked_in_gate"`
+	complimentary bool
 }
 
 
-func CreatedNewTicket(e *Event, s *Spot, status TicketStatus) (*Ticket, error) {
+func CreatedNewTicket(e *Event, s *Spot, kind TicketKind) (*Ticket, error) {
+	category, err := e.TicketCategory(kind)
+	if err != nil {
+		return nil, err
+	}
 	t := &Ticket{
 		ID:           uuid.New().String(),
 		EventID:      e.ID,
 		Spot:         s,
-		TicketKind: status,
+		TicketKind: kind,
 		Price:        e.Price,
+		CredentialVersion: 1,
 	}
-	t.CalculatePrice()
+	t.CalculatePrice(category)
 	if err := t.Validate(); err != nil {
 		return nil, err
 	}
 	return t, nil
 }
 
-func (t *Ticket) CalculatePrice() {
-	if t.TicketKind == TicketStatusHalf {
-		t.Price = t.Price / 2
-	}
+// CalculatePrice applies the category multiplier to the event price.
+// Categories with a zero multiplier issue complimentary tickets.
+func (t *Ticket) CalculatePrice(category *TicketCategory) {
+	t.Price = category.PriceFor(t.Price)
+	t.complimentary = category.IsComplimentary()
 }
 
 func (t Ticket) Validate() error {
 	if t.Spot == nil {
 		return ErrTicketSpotRequired
 	}
-	if t.Price <= 0 {
+	if t.Price < 0 || (t.Price == 0 && !t.complimentary) {
 		return ErrTicketPriceInvalid
 	}
-	if !IsValidTicketStatus(t.TicketKind) {
+	if !IsValidTicketKind(t.TicketKind) {
 		return ErrTicketStatusInvalid
 	}
 	return nil
-}
\ No newline at end of file
+}
+
+// ApplyCoupon discounts the ticket with the given coupon. The coupon must
+// already have been checked with Coupon.CheckApplicable.
+func (t *Ticket) ApplyCoupon(c *Coupon) {
+	t.CouponID = c.ID
+	t.Discount = c.DiscountFor(t.Price)
+}
+
+// AssignAttendee records who uses the ticket. The attendee must already have
+// been checked with Event.CheckAttendeeAge.
+func (t *Ticket) AssignAttendee(attendee Attendee) {
+	t.AttendeeName = attendee.Name
+	t.AttendeeDocument = attendee.Document
+}
+
+// ReissueCredential invalidates the credential issued for the ticket so far,
+// such as the one held by the previous holder of a transferred ticket
+func (t *Ticket) ReissueCredential() {
+	t.CredentialVersion++
+}
+
+// Total returns the amount charged for the ticket after discounts
+func (t Ticket) Total() float64 {
+	return t.Price - t.Discount
+}
@@ -0,0 +1,199 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTicketCategoryKindInvalid       = errors.New("ticket category kind must be lowercase letters, digits or underscores")
+	ErrTicketCategoryNameRequired      = errors.New("ticket category name is required")
+	ErrTicketCategoryMultiplierInvalid = errors.New("ticket category price multiplier must not be negative")
+	ErrTicketCategoryQuotaInvalid      = errors.New("ticket category quota must not be negative")
+	ErrTicketCategoryQuotaPercent      = errors.New("ticket category quota percent must be between 0 and 100")
+	ErrTicketCategoryRequirementBlank  = errors.New("ticket category requirement must not be blank")
+	ErrTicketCategoryDuplicated        = errors.New("ticket category kind already exists for this event")
+	ErrTicketCategoryNotFound          = errors.New("ticket category not found for this event")
+	ErrTicketCategoryNotEligible       = errors.New("buyer does not meet the ticket category requirements")
+)
+
+// Common eligibility requirements. Categories may declare any requirement
+// name; the buyer must present a document for each of them.
+const (
+	RequirementStudentID  = "student_id"
+	RequirementSeniorID   = "senior_id"
+	RequirementInvitation = "invitation_code"
+)
+
+// TicketCategory is a kind of ticket sold for an event, like VIP, student or
+// courtesy. The ticket price is the event price times PriceMultiplier.
+// Sales are limited by Quota tickets and by QuotaPercent of the event
+// capacity; zero disables the respective limit.
+// PartnerTicketKind is the value sent to the event partner for this category;
+// when empty the Kind itself is sent.
+type TicketCategory struct {
+	ID                string     `json:"id"`
+	EventID           string     `json:"event_id"`
+	Kind              TicketKind `json:"kind"`
+	Name              string     `json:"name"`
+	PriceMultiplier   float64    `json:"price_multiplier"`
+	Requirements      []string   `json:"requirements"`
+	Quota             int        `json:"quota"`
+	QuotaPercent      float64    `json:"quota_percent"`
+	PartnerTicketKind string     `json:"partner_ticket_kind"`
+}
+
+func CreatedNewTicketCategory(e Event, kind TicketKind, name string, priceMultiplier float64, requirements []string, quota int, quotaPercent float64, partnerTicketKind string) (*TicketCategory, error) {
+	category := &TicketCategory{
+		ID:                uuid.New().String(),
+		EventID:           e.ID,
+		Kind:              kind,
+		Name:              name,
+		PriceMultiplier:   priceMultiplier,
+		Requirements:      requirements,
+		Quota:             quota,
+		QuotaPercent:      quotaPercent,
+		PartnerTicketKind: partnerTicketKind,
+	}
+	if err := category.Validate(); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+// HalfPriceQuotaPercent is the share of the capacity reserved for half price
+// tickets by the Brazilian meia-entrada law (Lei 12.933/2013).
+const HalfPriceQuotaPercent = 40
+
+// DefaultTicketCategories returns the full and half price categories every
+// event accepts unless it configures a category of the same kind.
+func DefaultTicketCategories(eventID string) []TicketCategory {
+	return []TicketCategory{
+		{EventID: eventID, Kind: TicketKindFull, Name: "Inteira", PriceMultiplier: 1},
+		{EventID: eventID, Kind: TicketKindHalf, Name: "Meia-entrada", PriceMultiplier: 0.5, QuotaPercent: HalfPriceQuotaPercent},
+	}
+}
+
+func (c TicketCategory) Validate() error {
+	if !IsValidTicketKind(c.Kind) {
+		return ErrTicketCategoryKindInvalid
+	}
+	if c.Name == "" {
+		return ErrTicketCategoryNameRequired
+	}
+	if c.PriceMultiplier < 0 {
+		return ErrTicketCategoryMultiplierInvalid
+	}
+	if c.Quota < 0 {
+		return ErrTicketCategoryQuotaInvalid
+	}
+	if c.QuotaPercent < 0 || c.QuotaPercent > 100 {
+		return ErrTicketCategoryQuotaPercent
+	}
+	for _, requirement := range c.Requirements {
+		if strings.TrimSpace(requirement) == "" {
+			return ErrTicketCategoryRequirementBlank
+		}
+	}
+	return nil
+}
+
+// PriceFor returns the category price for the given event price
+func (c TicketCategory) PriceFor(eventPrice float64) float64 {
+	return math.Round(eventPrice*c.PriceMultiplier*100) / 100
+}
+
+// UnlimitedQuota is returned by QuotaFor for categories without a quota
+const UnlimitedQuota = -1
+
+// QuotaFor returns the maximum number of tickets of the category an event of
+// the given capacity can sell, or UnlimitedQuota. A percent of a small
+// capacity may round down to zero, which blocks the category.
+func (c TicketCategory) QuotaFor(capacity int) int {
+	quota := UnlimitedQuota
+	if c.Quota > 0 {
+		quota = c.Quota
+	}
+	if c.QuotaPercent > 0 {
+		byPercent := int(math.Floor(float64(capacity) * c.QuotaPercent / 100))
+		if quota == UnlimitedQuota || byPercent < quota {
+			quota = byPercent
+		}
+	}
+	return quota
+}
+
+// IsComplimentary reports whether tickets of this category are free
+func (c TicketCategory) IsComplimentary() bool {
+	return c.PriceMultiplier == 0
+}
+
+// PartnerKind returns the ticket kind understood by the event partner
+func (c TicketCategory) PartnerKind() string {
+	if c.PartnerTicketKind != "" {
+		return c.PartnerTicketKind
+	}
+	return string(c.Kind)
+}
+
+// CheckEligibility verifies the buyer presented a document for every
+// requirement of the category.
+func (c TicketCategory) CheckEligibility(documents map[string]string) error {
+	for _, requirement := range c.Requirements {
+		if strings.TrimSpace(documents[requirement]) == "" {
+			return ErrTicketCategoryNotEligible
+		}
+	}
+	return nil
+}
+
+// AvailableTicketCategories returns the configured categories of the event
+// followed by the default full and half categories it does not override.
+func (e Event) AvailableTicketCategories() []TicketCategory {
+	categories := append([]TicketCategory{}, e.TicketCategories...)
+	for _, category := range DefaultTicketCategories(e.ID) {
+		overridden := false
+		for _, configured := range e.TicketCategories {
+			if configured.Kind == category.Kind {
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			categories = append(categories, category)
+		}
+	}
+	return categories
+}
+
+// TicketCategory returns the event category of the given kind, falling back
+// to the default full and half categories the event does not override.
+func (e Event) TicketCategory(kind TicketKind) (*TicketCategory, error) {
+	categories := e.AvailableTicketCategories()
+	for i := range categories {
+		if categories[i].Kind == kind {
+			return &categories[i], nil
+		}
+	}
+	return nil, ErrTicketCategoryNotFound
+}
+
+// AddTicketCategory adds a category to the event, rejecting duplicated kinds
+func (e *Event) AddTicketCategory(kind TicketKind, name string, priceMultiplier float64, requirements []string, quota int, quotaPercent float64, partnerTicketKind string) (*TicketCategory, error) {
+	for _, existing := range e.TicketCategories {
+		if existing.Kind == kind {
+			return nil, ErrTicketCategoryDuplicated
+		}
+	}
+
+	category, err := CreatedNewTicketCategory(*e, kind, name, priceMultiplier, requirements, quota, quotaPercent, partnerTicketKind)
+	if err != nil {
+		return nil, err
+	}
+
+	e.TicketCategories = append(e.TicketCategories, *category)
+	return category, nil
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatedNewTicketCategory(t *testing.T) {
+	event, _ := CreatedNewEvent("Event Test", "Location Test", "Organization Test", RatingFree, time.Now().Add(24*time.Hour), "image_url", 100, 50.00, 1)
+
+	category, err := CreatedNewTicketCategory(*event, "student", "Estudante", 0.5, []string{RequirementStudentID}, 20, 0, "half")
+	assert.Nil(t, err)
+	assert.NotNil(t, category)
+	assert.NotEmpty(t, category.ID)
+	assert.Equal(t, event.ID, category.EventID)
+	assert.Equal(t, TicketKind("student"), category.Kind)
+	assert.Equal(t, 0.5, category.PriceMultiplier)
+	assert.Equal(t, 20, category.Quota)
+	assert.Equal(t, "half", category.PartnerKind())
+}
+
+func TestTicketCategory_Validate(t *testing.T) {
+	category := TicketCategory{Kind: "VIP", Name: "VIP", PriceMultiplier: 2}
+	assert.Equal(t, ErrTicketCategoryKindInvalid, category.Validate())
+
+	category.Kind = "vip"
+	category.Name = ""
+	assert.Equal(t, ErrTicketCategoryNameRequired, category.Validate())
+
+	category.Name = "VIP"
+	category.PriceMultiplier = -1
+	assert.Equal(t, ErrTicketCategoryMultiplierInvalid, category.Validate())
+
+	category.PriceMultiplier = 2
+	category.Quota = -1
+	assert.Equal(t, ErrTicketCategoryQuotaInvalid, category.Validate())
+
+	category.Quota = 0
+	category.Requirements = []string{" "}
+	assert.Equal(t, ErrTicketCategoryRequirementBlank, category.Validate())
+
+	category.Requirements = nil
+	assert.Nil(t, category.Validate())
+	assert.Equal(t, "vip", category.PartnerKind())
+}
+
+func TestTicketCategory_CheckEligibility(t *testing.T) {
+	category := TicketCategory{Kind: "senior", Name: "Idoso", PriceMultiplier: 0.5, Requirements: []string{RequirementSeniorID}}
+
+	assert.Equal(t, ErrTicketCategoryNotEligible, category.CheckEligibility(nil))
+	assert.Equal(t, ErrTicketCategoryNotEligible, category.CheckEligibility(map[string]string{RequirementStudentID: "123"}))
+	assert.Nil(t, category.CheckEligibility(map[string]string{RequirementSeniorID: "123.456.789-00"}))
+}
+
+func TestEvent_TicketCategory(t *testing.T) {
+	event, _ := CreatedNewEvent("Event Test", "Location Test", "Organization Test", RatingFree, time.Now().Add(24*time.Hour), "image_url", 100, 50.00, 1)
+
+	full, err := event.TicketCategory(TicketKindFull)
+	assert.Nil(t, err)
+	assert.Equal(t, 1.0, full.PriceMultiplier)
+	half, err := event.TicketCategory(TicketKindHalf)
+	assert.Nil(t, err)
+	assert.Equal(t, 0.5, half.PriceMultiplier)
+
+	_, err = event.AddTicketCategory("vip", "VIP", 2, nil, 10, 0, "full")
+	assert.Nil(t, err)
+	_, err = event.AddTicketCategory("vip", "VIP 2", 3, nil, 10, 0, "full")
+	assert.Equal(t, ErrTicketCategoryDuplicated, err)
+
+	vip, err := event.TicketCategory("vip")
+	assert.Nil(t, err)
+	assert.Equal(t, 2.0, vip.PriceMultiplier)
+
+	//as categorias padrão continuam valendo junto das configuradas
+	full, err = event.TicketCategory(TicketKindFull)
+	assert.Nil(t, err)
+	assert.Equal(t, 1.0, full.PriceMultiplier)
+	half, err = event.TicketCategory(TicketKindHalf)
+	assert.Nil(t, err)
+	assert.Equal(t, float64(HalfPriceQuotaPercent), half.QuotaPercent)
+
+	//uma categoria do mesmo tipo substitui a padrão
+	_, err = event.AddTicketCategory(TicketKindHalf, "Meia estudante", 0.4, nil, 0, 0, "")
+	assert.Nil(t, err)
+	half, err = event.TicketCategory(TicketKindHalf)
+	assert.Nil(t, err)
+	assert.Equal(t, 0.4, half.PriceMultiplier)
+	assert.Equal(t, 0.0, half.QuotaPercent)
+
+	_, err = event.TicketCategory("student")
+	assert.Equal(t, ErrTicketCategoryNotFound, err)
+
+	kinds := []TicketKind{}
+	for _, category := range event.AvailableTicketCategories() {
+		kinds = append(kinds, category.Kind)
+	}
+	assert.Equal(t, []TicketKind{"vip", TicketKindHalf, TicketKindFull}, kinds)
+}
+
+func TestCreateNewTicket_WithCategory(t *testing.T) {
+	event, _ := CreatedNewEvent("Event Test", "Location Test", "Organization Test", RatingFree, time.Now().Add(24*time.Hour), "image_url", 100, 50.00, 1)
+	event.AddTicketCategory("vip", "VIP", 2.5, nil, 10, 0, "full")
+	event.AddTicketCategory("courtesy", "Cortesia", 0, []string{RequirementInvitation}, 5, 0, "full")
+	spot, _ := CreatedNewSpot(*event, "A1")
+
+	vip, err := CreatedNewTicket(event, spot, "vip")
+	assert.Nil(t, err)
+	assert.Equal(t, 125.00, vip.Price)
+
+	courtesy, err := CreatedNewTicket(event, spot, "courtesy")
+	assert.Nil(t, err)
+	assert.Equal(t, 0.0, courtesy.Price)
+
+	_, err = CreatedNewTicket(event, spot, "student")
+	assert.Equal(t, ErrTicketCategoryNotFound, err)
+}
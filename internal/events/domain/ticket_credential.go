@@ -0,0 +1,184 @@
+package domain
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	ErrCredentialInvalid          = errors.New("ticket credential is malformed")
+	ErrCredentialSignatureInvalid = errors.New("ticket credential signature is invalid")
+	ErrCredentialKeyUnknown       = errors.New("ticket credential was signed with an unknown key")
+	ErrCredentialRevoked          = errors.New("ticket credential was replaced by a newer one")
+	ErrCredentialKeyInvalid       = errors.New("ticket credential key is invalid")
+)
+
+// credentialPrefix identifies the token format, so it can change without
+// breaking the credentials already issued
+const credentialPrefix = "TK1"
+
+// TicketCredential is what a ticket credential proves: who holds which
+// ticket. Version is the CredentialVersion of the ticket when it was issued,
+// so the credentials of a transferred ticket stop working.
+type TicketCredential struct {
+	KeyID       string `json:"kid"`
+	TicketID    string `json:"tid"`
+	EventID     string `json:"eid"`
+	SpotID      string `json:"sid"`
+	SpotName    string `json:"spn"`
+	HolderEmail string `json:"hld"`
+	Version     int    `json:"ver"`
+	IssuedAt    int64  `json:"iat"`
+}
+
+// CheckTicket verifies the credential was issued for the current version of
+// the ticket
+func (c TicketCredential) CheckTicket(ticket Ticket) error {
+	if c.TicketID != ticket.ID || c.EventID != ticket.EventID {
+		return ErrCredentialInvalid
+	}
+	if c.Version != ticket.CredentialVersion {
+		return ErrCredentialRevoked
+	}
+	return nil
+}
+
+// CredentialKeyRing signs ticket credentials with the active key and
+// verifies them with any key of the ring. Keys are rotated by adding a new
+// key and making it the active one: the previous keys stay in the ring, so
+// the credentials already issued keep working until their key is removed.
+type CredentialKeyRing struct {
+	activeKeyID string
+	keys        map[string]ed25519.PrivateKey
+}
+
+func CreatedNewCredentialKeyRing(activeKeyID string, keys map[string]ed25519.PrivateKey) (*CredentialKeyRing, error) {
+	ring := &CredentialKeyRing{keys: map[string]ed25519.PrivateKey{}}
+	for keyID, key := range keys {
+		if keyID == "" || strings.ContainsAny(keyID, ".,=") || len(key) != ed25519.PrivateKeySize {
+			return nil, ErrCredentialKeyInvalid
+		}
+		ring.keys[keyID] = key
+	}
+	if _, ok := ring.keys[activeKeyID]; !ok {
+		return nil, fmt.Errorf("%w: active key %q is not in the ring", ErrCredentialKeyInvalid, activeKeyID)
+	}
+	ring.activeKeyID = activeKeyID
+	return ring, nil
+}
+
+// GenerateCredentialKeyRing creates a ring with a single random key
+func GenerateCredentialKeyRing(keyID string) (*CredentialKeyRing, error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return CreatedNewCredentialKeyRing(keyID, map[string]ed25519.PrivateKey{keyID: key})
+}
+
+// ParseCredentialKeys reads keys written as "id=seed" pairs separated by
+// commas, where seed is the base64 encoded 32 byte Ed25519 seed
+func ParseCredentialKeys(spec string) (map[string]ed25519.PrivateKey, error) {
+	keys := map[string]ed25519.PrivateKey{}
+	for _, pair := range strings.Split(spec, ",") {
+		keyID, encoded, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			return nil, ErrCredentialKeyInvalid
+		}
+		seed, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(seed) != ed25519.SeedSize {
+			return nil, fmt.Errorf("%w: key %q", ErrCredentialKeyInvalid, keyID)
+		}
+		keys[keyID] = ed25519.NewKeyFromSeed(seed)
+	}
+	return keys, nil
+}
+
+func (r *CredentialKeyRing) ActiveKeyID() string {
+	return r.activeKeyID
+}
+
+// PublicKeys returns the verification keys of the ring by key ID, for
+// scanners that check credentials without calling the API
+func (r *CredentialKeyRing) PublicKeys() map[string]ed25519.PublicKey {
+	publicKeys := make(map[string]ed25519.PublicKey, len(r.keys))
+	for keyID, key := range r.keys {
+		publicKeys[keyID] = key.Public().(ed25519.PublicKey)
+	}
+	return publicKeys
+}
+
+// KeyIDs returns the IDs of the keys of the ring in order
+func (r *CredentialKeyRing) KeyIDs() []string {
+	keyIDs := make([]string, 0, len(r.keys))
+	for keyID := range r.keys {
+		keyIDs = append(keyIDs, keyID)
+	}
+	sort.Strings(keyIDs)
+	return keyIDs
+}
+
+// Issue signs a credential for the current holder of the ticket. The token
+// is "TK1.<payload>.<signature>", both parts base64url encoded.
+func (r *CredentialKeyRing) Issue(ticket Ticket, now time.Time) (string, error) {
+	credential := TicketCredential{
+		KeyID:       r.activeKeyID,
+		TicketID:    ticket.ID,
+		EventID:     ticket.EventID,
+		HolderEmail: ticket.HolderEmail,
+		Version:     ticket.CredentialVersion,
+		IssuedAt:    now.Unix(),
+	}
+	if ticket.Spot != nil {
+		credential.SpotID = ticket.Spot.ID
+		credential.SpotName = ticket.Spot.Name
+	}
+	payload, err := json.Marshal(credential)
+	if err != nil {
+		return "", err
+	}
+	signed := credentialPrefix + "." + base64.RawURLEncoding.EncodeToString(payload)
+	signature := ed25519.Sign(r.keys[r.activeKeyID], []byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// Verify checks the signature of a token and returns its credential. The
+// caller still checks it against the ticket with TicketCredential.CheckTicket.
+func (r *CredentialKeyRing) Verify(token string) (*TicketCredential, error) {
+	return VerifyTicketCredential(token, r.PublicKeys())
+}
+
+// VerifyTicketCredential checks a token with the given public keys
+func VerifyTicketCredential(token string, publicKeys map[string]ed25519.PublicKey) (*TicketCredential, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != credentialPrefix {
+		return nil, ErrCredentialInvalid
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrCredentialInvalid
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrCredentialInvalid
+	}
+	var credential TicketCredential
+	if err := json.Unmarshal(payload, &credential); err != nil {
+		return nil, ErrCredentialInvalid
+	}
+	publicKey, ok := publicKeys[credential.KeyID]
+	if !ok {
+		return nil, ErrCredentialKeyUnknown
+	}
+	if !ed25519.Verify(publicKey, []byte(parts[0]+"."+parts[1]), signature) {
+		return nil, ErrCredentialSignatureInvalid
+	}
+	return &credential, nil
+}
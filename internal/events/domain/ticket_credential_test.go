@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func credentialTicket() Ticket {
+	return Ticket{
+		ID:                "ticket-1",
+		EventID:           "event-1",
+		Spot:              &Spot{ID: "spot-1", Name: "A1"},
+		HolderEmail:       "ana@example.com",
+		CredentialVersion: 1,
+	}
+}
+
+func TestCredentialKeyRing_IssueVerify(t *testing.T) {
+	ring, err := GenerateCredentialKeyRing("k1")
+	assert.Nil(t, err)
+	ticket := credentialTicket()
+
+	token, err := ring.Issue(ticket, time.Unix(1700000000, 0))
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(token, "TK1."))
+
+	credential, err := ring.Verify(token)
+	assert.Nil(t, err)
+	assert.Equal(t, "k1", credential.KeyID)
+	assert.Equal(t, "ticket-1", credential.TicketID)
+	assert.Equal(t, "event-1", credential.EventID)
+	assert.Equal(t, "A1", credential.SpotName)
+	assert.Equal(t, "ana@example.com", credential.HolderEmail)
+	assert.Equal(t, int64(1700000000), credential.IssuedAt)
+	assert.Nil(t, credential.CheckTicket(ticket))
+
+	//um ingresso transferido invalida a credencial anterior
+	ticket.ReissueCredential()
+	assert.Equal(t, ErrCredentialRevoked, credential.CheckTicket(ticket))
+}
+
+func TestCredentialKeyRing_VerifyTampered(t *testing.T) {
+	ring, _ := GenerateCredentialKeyRing("k1")
+	token, _ := ring.Issue(credentialTicket(), time.Now())
+	parts := strings.Split(token, ".")
+
+	other := credentialTicket()
+	other.HolderEmail = "mallory@example.com"
+	forged, _ := ring.Issue(other, time.Now())
+	forgedParts := strings.Split(forged, ".")
+
+	_, err := ring.Verify(parts[0] + "." + forgedParts[1] + "." + parts[2])
+	assert.Equal(t, ErrCredentialSignatureInvalid, err)
+	_, err = ring.Verify("TK1.not-a-token")
+	assert.Equal(t, ErrCredentialInvalid, err)
+	_, err = ring.Verify("TK2." + parts[1] + "." + parts[2])
+	assert.Equal(t, ErrCredentialInvalid, err)
+
+	stranger, _ := GenerateCredentialKeyRing("k2")
+	_, err = stranger.Verify(token)
+	assert.Equal(t, ErrCredentialKeyUnknown, err)
+}
+
+func TestCredentialKeyRing_Rotation(t *testing.T) {
+	seed1 := base64.StdEncoding.EncodeToString(make([]byte, ed25519.SeedSize))
+	seed2 := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("x", ed25519.SeedSize)))
+
+	keys, err := ParseCredentialKeys("k1=" + seed1)
+	assert.Nil(t, err)
+	old, err := CreatedNewCredentialKeyRing("k1", keys)
+	assert.Nil(t, err)
+	token, _ := old.Issue(credentialTicket(), time.Now())
+
+	keys, err = ParseCredentialKeys("k1=" + seed1 + ", k2=" + seed2)
+	assert.Nil(t, err)
+	rotated, err := CreatedNewCredentialKeyRing("k2", keys)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"k1", "k2"}, rotated.KeyIDs())
+
+	_, err = rotated.Verify(token)
+	assert.Nil(t, err)
+	newToken, _ := rotated.Issue(credentialTicket(), time.Now())
+	credential, err := VerifyTicketCredential(newToken, rotated.PublicKeys())
+	assert.Nil(t, err)
+	assert.Equal(t, "k2", credential.KeyID)
+
+	_, err = ParseCredentialKeys("k1=short")
+	assert.ErrorIs(t, err, ErrCredentialKeyInvalid)
+	_, err = ParseCredentialKeys("k1")
+	assert.ErrorIs(t, err, ErrCredentialKeyInvalid)
+	_, err = CreatedNewCredentialKeyRing("k3", keys)
+	assert.ErrorIs(t, err, ErrCredentialKeyInvalid)
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrTicketQuotaExceeded = errors.New("ticket category quota exceeded")
+)
+
+// TicketSales summarizes the tickets already sold for an event. ByBuyer
+// counts the tickets held by the buyer of the purchase being checked.
+type TicketSales struct {
+	Total   int
+	ByKind  map[TicketKind]int
+	ByBuyer int
+}
+
+// CheckTicketSale verifies that selling quantity tickets of the given kind
+// keeps the event within its capacity, the category within its quota and the
+// buyer within the event limit of tickets per buyer.
+func (e Event) CheckTicketSale(kind TicketKind, quantity int, sales TicketSales) error {
+	category, err := e.TicketCategory(kind)
+	if err != nil {
+		return err
+	}
+
+	if sales.Total+quantity > e.Capacity {
+		return fmt.Errorf("%w: %d of %d tickets already sold", ErrEventCapacityExceeded, sales.Total, e.Capacity)
+	}
+
+	quota := category.QuotaFor(e.Capacity)
+	sold := sales.ByKind[kind]
+	if quota != UnlimitedQuota && sold+quantity > quota {
+		return fmt.Errorf("%w: %s allows %d tickets and %d are already sold", ErrTicketQuotaExceeded, kind, quota, sold)
+	}
+	return e.CheckBuyerLimit(sales.ByBuyer, quantity)
+}
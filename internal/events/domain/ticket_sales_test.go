@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTicketCategory_QuotaFor(t *testing.T) {
+	category := TicketCategory{Quota: 0, QuotaPercent: 0}
+	assert.Equal(t, UnlimitedQuota, category.QuotaFor(100))
+
+	category.Quota = 30
+	assert.Equal(t, 30, category.QuotaFor(100))
+
+	category.QuotaPercent = 40
+	assert.Equal(t, 30, category.QuotaFor(100))
+	assert.Equal(t, 20, category.QuotaFor(50))
+
+	category.Quota = 0
+	assert.Equal(t, 4, category.QuotaFor(11))
+	assert.Equal(t, 0, category.QuotaFor(2))
+}
+
+func TestEvent_CheckTicketSale(t *testing.T) {
+	event, _ := CreatedNewEvent("Event Test", "Location Test", "Organization Test", RatingFree, time.Now().Add(24*time.Hour), "image_url", 10, 50.00, 1)
+
+	sales := TicketSales{Total: 0, ByKind: map[TicketKind]int{}}
+	assert.Nil(t, event.CheckTicketSale(TicketKindFull, 10, sales))
+	assert.Nil(t, event.CheckTicketSale(TicketKindHalf, 4, sales))
+
+	err := event.CheckTicketSale(TicketKindHalf, 5, sales)
+	assert.ErrorIs(t, err, ErrTicketQuotaExceeded)
+
+	sales = TicketSales{Total: 8, ByKind: map[TicketKind]int{TicketKindFull: 5, TicketKindHalf: 3}}
+	assert.Nil(t, event.CheckTicketSale(TicketKindHalf, 1, sales))
+	assert.ErrorIs(t, event.CheckTicketSale(TicketKindHalf, 2, sales), ErrTicketQuotaExceeded)
+	assert.ErrorIs(t, event.CheckTicketSale(TicketKindFull, 3, sales), ErrEventCapacityExceeded)
+
+	assert.Equal(t, ErrTicketCategoryNotFound, event.CheckTicketSale("vip", 1, sales))
+
+	//a cota da meia arredondada para zero não libera a venda
+	small, _ := CreatedNewEvent("Event Test", "Location Test", "Organization Test", RatingFree, time.Now().Add(24*time.Hour), "image_url", 2, 50.00, 1)
+	empty := TicketSales{ByKind: map[TicketKind]int{}}
+	assert.ErrorIs(t, small.CheckTicketSale(TicketKindHalf, 1, empty), ErrTicketQuotaExceeded)
+	assert.Nil(t, small.CheckTicketSale(TicketKindFull, 2, empty))
+}
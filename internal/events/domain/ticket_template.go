@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	ErrTicketTemplateNotFound             = errors.New("ticket template not found")
+	ErrTicketTemplateOrganizationRequired = errors.New("ticket template organization is required")
+	ErrTicketTemplateColorInvalid         = errors.New("ticket template color must be a hex color like #1a2b3c")
+	ErrTicketTemplateTextTooLong          = errors.New("ticket template texts must have at most 200 characters")
+	ErrTicketTemplateLayoutInvalid        = errors.New("ticket template layout is invalid")
+)
+
+const (
+	defaultTicketTemplateColor = "#1F2937"
+	maxTicketTemplateText      = 200
+)
+
+// TicketTemplateLayout is the arrangement of the printed ticket: a full A4
+// page per ticket, or a compact ticket that fits several per page.
+type TicketTemplateLayout string
+
+const (
+	TicketTemplateLayoutPage    TicketTemplateLayout = "page"
+	TicketTemplateLayoutCompact TicketTemplateLayout = "compact"
+)
+
+// TicketTemplate is how the printed tickets of an organization look. The
+// organization is the Organization of its events, so every event of the
+// organization prints with the same template.
+type TicketTemplate struct {
+	Organization string               `json:"organization"`
+	Layout       TicketTemplateLayout `json:"layout"`
+	AccentColor  string               `json:"accent_color"`
+	HeaderText   string               `json:"header_text"`
+	FooterText   string               `json:"footer_text"`
+	ShowPrice    bool                 `json:"show_price"`
+}
+
+// DefaultTicketTemplate is used for organizations without a template
+func DefaultTicketTemplate(organization string) TicketTemplate {
+	return TicketTemplate{
+		Organization: organization,
+		Layout:       TicketTemplateLayoutPage,
+		AccentColor:  defaultTicketTemplateColor,
+		HeaderText:   organization,
+		FooterText:   "Apresente este ingresso na entrada. O QR code é pessoal e deixa de valer se o ingresso for transferido.",
+		ShowPrice:    true,
+	}
+}
+
+func CreatedNewTicketTemplate(organization string, layout TicketTemplateLayout, accentColor, headerText, footerText string, showPrice bool) (*TicketTemplate, error) {
+	template := &TicketTemplate{
+		Organization: strings.TrimSpace(organization),
+		Layout:       layout,
+		AccentColor:  strings.ToUpper(strings.TrimSpace(accentColor)),
+		HeaderText:   strings.TrimSpace(headerText),
+		FooterText:   strings.TrimSpace(footerText),
+		ShowPrice:    showPrice,
+	}
+	if template.Layout == "" {
+		template.Layout = TicketTemplateLayoutPage
+	}
+	if template.AccentColor == "" {
+		template.AccentColor = defaultTicketTemplateColor
+	}
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+func (t TicketTemplate) Validate() error {
+	if t.Organization == "" {
+		return ErrTicketTemplateOrganizationRequired
+	}
+	if t.Layout != TicketTemplateLayoutPage && t.Layout != TicketTemplateLayoutCompact {
+		return ErrTicketTemplateLayoutInvalid
+	}
+	if _, _, _, err := t.AccentRGB(); err != nil {
+		return err
+	}
+	if utf8.RuneCountInString(t.HeaderText) > maxTicketTemplateText || utf8.RuneCountInString(t.FooterText) > maxTicketTemplateText {
+		return ErrTicketTemplateTextTooLong
+	}
+	return nil
+}
+
+// AccentRGB returns the red, green and blue components of the accent color
+func (t TicketTemplate) AccentRGB() (int, int, int, error) {
+	if len(t.AccentColor) != 7 || t.AccentColor[0] != '#' {
+		return 0, 0, 0, ErrTicketTemplateColorInvalid
+	}
+	rgb, err := strconv.ParseUint(t.AccentColor[1:], 16, 32)
+	if err != nil {
+		return 0, 0, 0, ErrTicketTemplateColorInvalid
+	}
+	return int(rgb >> 16 & 0xFF), int(rgb >> 8 & 0xFF), int(rgb & 0xFF), nil
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatedNewTicketTemplate(t *testing.T) {
+	template, err := CreatedNewTicketTemplate(" Full Cycle ", "", "#1a2b3c", " Full Cycle Shows ", "", false)
+	assert.Nil(t, err)
+	assert.Equal(t, "Full Cycle", template.Organization)
+	assert.Equal(t, TicketTemplateLayoutPage, template.Layout)
+	assert.Equal(t, "#1A2B3C", template.AccentColor)
+	assert.Equal(t, "Full Cycle Shows", template.HeaderText)
+
+	template, err = CreatedNewTicketTemplate("Full Cycle", TicketTemplateLayoutCompact, "", "", "", true)
+	assert.Nil(t, err)
+	assert.Equal(t, defaultTicketTemplateColor, template.AccentColor)
+
+	_, err = CreatedNewTicketTemplate(" ", "", "", "", "", true)
+	assert.Equal(t, ErrTicketTemplateOrganizationRequired, err)
+	_, err = CreatedNewTicketTemplate("Full Cycle", "poster", "", "", "", true)
+	assert.Equal(t, ErrTicketTemplateLayoutInvalid, err)
+	_, err = CreatedNewTicketTemplate("Full Cycle", "", "red", "", "", true)
+	assert.Equal(t, ErrTicketTemplateColorInvalid, err)
+	_, err = CreatedNewTicketTemplate("Full Cycle", "", "#12345G", "", "", true)
+	assert.Equal(t, ErrTicketTemplateColorInvalid, err)
+	_, err = CreatedNewTicketTemplate("Full Cycle", "", "", "", strings.Repeat("á", 201), true)
+	assert.Equal(t, ErrTicketTemplateTextTooLong, err)
+}
+
+func TestTicketTemplate_AccentRGB(t *testing.T) {
+	r, g, b, err := TicketTemplate{AccentColor: "#FF8000"}.AccentRGB()
+	assert.Nil(t, err)
+	assert.Equal(t, []int{255, 128, 0}, []int{r, g, b})
+
+	assert.Nil(t, DefaultTicketTemplate("Full Cycle").Validate())
+}
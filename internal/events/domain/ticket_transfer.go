@@ -0,0 +1,203 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrTicketNotFound               = errors.New("ticket not found")
+	ErrTicketNotHeldBy              = errors.New("ticket is not held by this email")
+	ErrEventTransferDeadlineInvalid = errors.New("event transfer deadline must not be negative")
+	ErrTicketTransferClosed         = errors.New("ticket transfers are closed for this event")
+	ErrTicketTransferEmailInvalid   = errors.New("ticket transfer email is invalid")
+	ErrTicketTransferSameHolder     = errors.New("ticket cannot be transferred to its current holder")
+	ErrTicketTransferPending        = errors.New("ticket already has a pending transfer")
+	ErrTicketTransferNotFound       = errors.New("ticket transfer not found")
+	ErrTicketTransferNotPending     = errors.New("ticket transfer is no longer pending")
+	ErrTicketTransferWrongRecipient = errors.New("ticket transfer was sent to another email")
+	ErrTicketTransferCodeInvalid    = errors.New("ticket transfer acceptance code is invalid")
+)
+
+// DefaultTicketTransferTTL is how long the recipient has to accept a
+// transfer, unless the event transfer deadline comes first.
+const DefaultTicketTransferTTL = 72 * time.Hour
+
+type TicketTransferStatus string
+
+const (
+	TicketTransferStatusPending   TicketTransferStatus = "pending"
+	TicketTransferStatusAccepted  TicketTransferStatus = "accepted"
+	TicketTransferStatusDeclined  TicketTransferStatus = "declined"
+	TicketTransferStatusCancelled TicketTransferStatus = "cancelled"
+	TicketTransferStatusExpired   TicketTransferStatus = "expired"
+)
+
+// TicketTransfer moves a ticket from its holder to another email. The
+// recipient accepts it with the AcceptanceCode sent to them, naming the new
+// attendee. Transfers are never deleted, so the transfers of a ticket are
+// its ownership history.
+type TicketTransfer struct {
+	ID                string               `json:"id"`
+	TicketID          string               `json:"ticket_id"`
+	EventID           string               `json:"event_id"`
+	FromEmail         string               `json:"from_email"`
+	ToEmail           string               `json:"to_email"`
+	Status            TicketTransferStatus `json:"status"`
+	AcceptanceCode    string               `json:"-"`
+	CredentialVersion int                  `json:"credential_version"`
+	CreatedAt         time.Time            `json:"created_at"`
+	ExpiresAt         time.Time            `json:"expires_at"`
+	ResolvedAt        time.Time            `json:"resolved_at"`
+}
+
+// TransferDeadline returns the instant after which tickets of the event can
+// no longer be transferred, TransferDeadlineHours before it starts
+func (e Event) TransferDeadline() time.Time {
+	return e.Date.Add(-time.Duration(e.TransferDeadlineHours) * time.Hour)
+}
+
+// CheckTransferOpen verifies tickets of the event can change hands at now
+func (e Event) CheckTransferOpen(now time.Time) error {
+	if e.Status.IsFinal() || !now.Before(e.TransferDeadline()) {
+		return ErrTicketTransferClosed
+	}
+	return nil
+}
+
+// IsHeldBy reports whether email is the holder of the ticket. Tickets
+// without a holder, such as the ones sold before holders existed, are held
+// by no one.
+func (t Ticket) IsHeldBy(email string) bool {
+	return t.HolderEmail != "" && NormalizeEmail(email) == t.HolderEmail
+}
+
+func CreatedNewTicketTransfer(e Event, ticket Ticket, fromEmail, toEmail string, now time.Time) (*TicketTransfer, error) {
+	fromEmail = NormalizeEmail(fromEmail)
+	toEmail = NormalizeEmail(toEmail)
+	if !IsValidEmail(toEmail) {
+		return nil, ErrTicketTransferEmailInvalid
+	}
+	if !ticket.IsHeldBy(fromEmail) {
+		return nil, ErrTicketNotHeldBy
+	}
+	if toEmail == fromEmail {
+		return nil, ErrTicketTransferSameHolder
+	}
+	if ticket.IsCheckedIn() {
+		return nil, ErrTicketCheckedIn
+	}
+	if err := e.CheckTransferOpen(now); err != nil {
+		return nil, err
+	}
+
+	code, err := newAcceptanceCode()
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := now.Add(DefaultTicketTransferTTL)
+	if deadline := e.TransferDeadline(); deadline.Before(expiresAt) {
+		expiresAt = deadline
+	}
+	return &TicketTransfer{
+		ID:             uuid.New().String(),
+		TicketID:       ticket.ID,
+		EventID:        e.ID,
+		FromEmail:      fromEmail,
+		ToEmail:        toEmail,
+		Status:         TicketTransferStatusPending,
+		AcceptanceCode: code,
+		CreatedAt:      now.UTC(),
+		ExpiresAt:      expiresAt.UTC(),
+	}, nil
+}
+
+// IsOpen reports whether the transfer still waits for the recipient at now
+func (t TicketTransfer) IsOpen(now time.Time) bool {
+	return t.Status == TicketTransferStatusPending && now.Before(t.ExpiresAt)
+}
+
+// Accept gives the ticket to the recipient with a new attendee and reissues
+// its credential, so the one held by the previous holder stops working.
+func (t *TicketTransfer) Accept(e Event, ticket *Ticket, email, code string, attendee Attendee, now time.Time) error {
+	if !t.IsOpen(now) {
+		return ErrTicketTransferNotPending
+	}
+	if NormalizeEmail(email) != t.ToEmail {
+		return ErrTicketTransferWrongRecipient
+	}
+	if subtle.ConstantTimeCompare([]byte(code), []byte(t.AcceptanceCode)) != 1 {
+		return ErrTicketTransferCodeInvalid
+	}
+	if ticket.HolderEmail != t.FromEmail {
+		return ErrTicketNotHeldBy
+	}
+	if ticket.IsCheckedIn() {
+		return ErrTicketCheckedIn
+	}
+	if err := e.CheckTransferOpen(now); err != nil {
+		return err
+	}
+	if err := e.CheckAttendeeAge(attendee); err != nil {
+		return err
+	}
+
+	ticket.HolderEmail = t.ToEmail
+	ticket.AssignAttendee(attendee)
+	ticket.ReissueCredential()
+	t.CredentialVersion = ticket.CredentialVersion
+	t.resolve(TicketTransferStatusAccepted, now)
+	return nil
+}
+
+// Decline refuses the transfer on behalf of the recipient
+func (t *TicketTransfer) Decline(email string, now time.Time) error {
+	if !t.IsOpen(now) {
+		return ErrTicketTransferNotPending
+	}
+	if NormalizeEmail(email) != t.ToEmail {
+		return ErrTicketTransferWrongRecipient
+	}
+	t.resolve(TicketTransferStatusDeclined, now)
+	return nil
+}
+
+// Cancel withdraws the transfer on behalf of the holder who started it
+func (t *TicketTransfer) Cancel(email string, now time.Time) error {
+	if !t.IsOpen(now) {
+		return ErrTicketTransferNotPending
+	}
+	if NormalizeEmail(email) != t.FromEmail {
+		return ErrTicketNotHeldBy
+	}
+	t.resolve(TicketTransferStatusCancelled, now)
+	return nil
+}
+
+// Expire closes a pending transfer that was not accepted in time. It
+// reports whether the transfer expired.
+func (t *TicketTransfer) Expire(now time.Time) bool {
+	if t.Status != TicketTransferStatusPending || now.Before(t.ExpiresAt) {
+		return false
+	}
+	t.resolve(TicketTransferStatusExpired, now)
+	return true
+}
+
+func (t *TicketTransfer) resolve(status TicketTransferStatus, now time.Time) {
+	t.Status = status
+	t.ResolvedAt = now.UTC()
+}
+
+func newAcceptanceCode() (string, error) {
+	code := make([]byte, 16)
+	if _, err := rand.Read(code); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(code), nil
+}
@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func transferFixture(now time.Time) (Event, Ticket) {
+	event := Event{
+		ID:                    "event-1",
+		Date:                  now.Add(10 * 24 * time.Hour),
+		Rating:                Rating18,
+		Status:                EventStatusOnSale,
+		TransferDeadlineHours: 24,
+	}
+	ticket := Ticket{
+		ID:                "ticket-1",
+		EventID:           event.ID,
+		HolderEmail:       "ana@example.com",
+		AttendeeName:      "Ana",
+		CredentialVersion: 1,
+	}
+	return event, ticket
+}
+
+func TestCreatedNewTicketTransfer(t *testing.T) {
+	now := time.Now()
+	event, ticket := transferFixture(now)
+
+	transfer, err := CreatedNewTicketTransfer(event, ticket, "ANA@example.com", " Bia@Example.com ", now)
+	assert.Nil(t, err)
+	assert.Equal(t, TicketTransferStatusPending, transfer.Status)
+	assert.Equal(t, "ana@example.com", transfer.FromEmail)
+	assert.Equal(t, "bia@example.com", transfer.ToEmail)
+	assert.Len(t, transfer.AcceptanceCode, 32)
+	assert.Equal(t, now.Add(DefaultTicketTransferTTL).UTC(), transfer.ExpiresAt)
+
+	_, err = CreatedNewTicketTransfer(event, ticket, "ana@example.com", "bia", now)
+	assert.Equal(t, ErrTicketTransferEmailInvalid, err)
+	_, err = CreatedNewTicketTransfer(event, ticket, "carla@example.com", "bia@example.com", now)
+	assert.Equal(t, ErrTicketNotHeldBy, err)
+	_, err = CreatedNewTicketTransfer(event, ticket, "ana@example.com", "ana@example.com", now)
+	assert.Equal(t, ErrTicketTransferSameHolder, err)
+}
+
+func TestTicket_IsHeldBy(t *testing.T) {
+	_, ticket := transferFixture(time.Now())
+	assert.True(t, ticket.IsHeldBy(" ANA@example.com"))
+	assert.False(t, ticket.IsHeldBy("bia@example.com"))
+	assert.False(t, ticket.IsHeldBy(""))
+
+	//ingressos sem titular não pertencem a ninguém, nem a um email vazio
+	ticket.HolderEmail = ""
+	assert.False(t, ticket.IsHeldBy(""))
+	_, err := CreatedNewTicketTransfer(Event{}, ticket, "", "bia@example.com", time.Now())
+	assert.Equal(t, ErrTicketNotHeldBy, err)
+}
+
+func TestCreatedNewTicketTransfer_Deadline(t *testing.T) {
+	now := time.Now()
+	event, ticket := transferFixture(now)
+
+	//o prazo de aceite não passa do prazo de transferência do evento
+	event.Date = now.Add(48 * time.Hour)
+	transfer, err := CreatedNewTicketTransfer(event, ticket, "ana@example.com", "bia@example.com", now)
+	assert.Nil(t, err)
+	assert.Equal(t, event.TransferDeadline().UTC(), transfer.ExpiresAt)
+
+	event.Date = now.Add(12 * time.Hour)
+	_, err = CreatedNewTicketTransfer(event, ticket, "ana@example.com", "bia@example.com", now)
+	assert.Equal(t, ErrTicketTransferClosed, err)
+
+	event.Date = now.Add(48 * time.Hour)
+	event.Status = EventStatusCancelled
+	_, err = CreatedNewTicketTransfer(event, ticket, "ana@example.com", "bia@example.com", now)
+	assert.Equal(t, ErrTicketTransferClosed, err)
+}
+
+func TestTicketTransfer_Accept(t *testing.T) {
+	now := time.Now()
+	event, ticket := transferFixture(now)
+	transfer, _ := CreatedNewTicketTransfer(event, ticket, "ana@example.com", "bia@example.com", now)
+	adult := Attendee{Name: "Bia", Document: "123", BirthDate: time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	err := transfer.Accept(event, &ticket, "carla@example.com", transfer.AcceptanceCode, adult, now)
+	assert.Equal(t, ErrTicketTransferWrongRecipient, err)
+	err = transfer.Accept(event, &ticket, "bia@example.com", "wrong", adult, now)
+	assert.Equal(t, ErrTicketTransferCodeInvalid, err)
+
+	minor := Attendee{Name: "Caio", Document: "456", BirthDate: now.AddDate(-15, 0, 0)}
+	err = transfer.Accept(event, &ticket, "bia@example.com", transfer.AcceptanceCode, minor, now)
+	var rejection *PurchaseRejection
+	assert.ErrorAs(t, err, &rejection)
+	assert.Equal(t, "ana@example.com", ticket.HolderEmail)
+
+	err = transfer.Accept(event, &ticket, "bia@example.com", transfer.AcceptanceCode, adult, now)
+	assert.Nil(t, err)
+	assert.Equal(t, TicketTransferStatusAccepted, transfer.Status)
+	assert.Equal(t, "bia@example.com", ticket.HolderEmail)
+	assert.Equal(t, "Bia", ticket.AttendeeName)
+	assert.Equal(t, 2, ticket.CredentialVersion)
+	assert.Equal(t, 2, transfer.CredentialVersion)
+
+	err = transfer.Accept(event, &ticket, "bia@example.com", transfer.AcceptanceCode, adult, now)
+	assert.Equal(t, ErrTicketTransferNotPending, err)
+}
+
+func TestTicketTransfer_DeclineCancelExpire(t *testing.T) {
+	now := time.Now()
+	event, ticket := transferFixture(now)
+
+	transfer, _ := CreatedNewTicketTransfer(event, ticket, "ana@example.com", "bia@example.com", now)
+	assert.Equal(t, ErrTicketTransferWrongRecipient, transfer.Decline("ana@example.com", now))
+	assert.Nil(t, transfer.Decline("bia@example.com", now))
+	assert.Equal(t, TicketTransferStatusDeclined, transfer.Status)
+	assert.False(t, transfer.ResolvedAt.IsZero())
+
+	transfer, _ = CreatedNewTicketTransfer(event, ticket, "ana@example.com", "bia@example.com", now)
+	assert.Equal(t, ErrTicketNotHeldBy, transfer.Cancel("bia@example.com", now))
+	assert.Nil(t, transfer.Cancel("ana@example.com", now))
+	assert.Equal(t, TicketTransferStatusCancelled, transfer.Status)
+	assert.False(t, transfer.Expire(now.Add(DefaultTicketTransferTTL)))
+
+	transfer, _ = CreatedNewTicketTransfer(event, ticket, "ana@example.com", "bia@example.com", now)
+	assert.False(t, transfer.Expire(now))
+	later := now.Add(DefaultTicketTransferTTL)
+	assert.False(t, transfer.IsOpen(later))
+	assert.True(t, transfer.Expire(later))
+	assert.Equal(t, TicketTransferStatusExpired, transfer.Status)
+	assert.Equal(t, ErrTicketTransferNotPending, transfer.Decline("bia@example.com", later))
+}
+
+func TestCreatedNewTicketTransfer_CheckedIn(t *testing.T) {
+	now := time.Now()
+	event, ticket := transferFixture(now)
+	assert.Nil(t, ticket.CheckIn("A", now))
+
+	_, err := CreatedNewTicketTransfer(event, ticket, "ana@example.com", "bia@example.com", now)
+	assert.Equal(t, ErrTicketCheckedIn, err)
+}
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrVenueNotFound              = errors.New("venue not found")
+	ErrVenueNameRequired          = errors.New("venue name is required")
+	ErrVenueCoordinatesInvalid    = errors.New("venue latitude must be between -90 and 90 and longitude between -180 and 180")
+	ErrVenueTimezoneInvalid       = errors.New("venue timezone must be a valid IANA time zone")
+	ErrVenueCapacityInvalid       = errors.New("venue capacity must be greater than zero")
+	ErrVenueLayoutExceedsCapacity = errors.New("venue layout has more seats than the venue capacity")
+	ErrVenueInUse                 = errors.New("venue is referenced by events")
+	ErrVenueCapacityExceeded      = errors.New("event capacity exceeds the venue capacity")
+)
+
+// Venue is a place that hosts events. Events at a venue default to its
+// capacity and their spots are generated from its seat layout, when it has one.
+type Venue struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	Address   string     `json:"address"`
+	City      string     `json:"city"`
+	Latitude  float64    `json:"latitude"`
+	Longitude float64    `json:"longitude"`
+	Timezone  string     `json:"timezone"`
+	Capacity  int        `json:"capacity"`
+	Layout    SeatLayout `json:"layout"`
+}
+
+func CreatedNewVenue(name, address, city string, latitude, longitude float64, timezone string, capacity int, layout SeatLayout) (*Venue, error) {
+	venue := &Venue{
+		ID:        uuid.New().String(),
+		Name:      strings.TrimSpace(name),
+		Address:   strings.TrimSpace(address),
+		City:      strings.TrimSpace(city),
+		Latitude:  latitude,
+		Longitude: longitude,
+		Timezone:  strings.TrimSpace(timezone),
+		Capacity:  capacity,
+		Layout:    layout,
+	}
+	if err := venue.Validate(); err != nil {
+		return nil, err
+	}
+	return venue, nil
+}
+
+func (v Venue) Validate() error {
+	if v.Name == "" {
+		return ErrVenueNameRequired
+	}
+	if v.Latitude < -90 || v.Latitude > 90 || v.Longitude < -180 || v.Longitude > 180 {
+		return ErrVenueCoordinatesInvalid
+	}
+	if _, err := time.LoadLocation(v.Timezone); v.Timezone == "" || err != nil {
+		return ErrVenueTimezoneInvalid
+	}
+	if v.Capacity <= 0 {
+		return ErrVenueCapacityInvalid
+	}
+	if v.HasLayout() {
+		if err := v.Layout.Validate(); err != nil {
+			return err
+		}
+		if v.Layout.SeatCount() > v.Capacity {
+			return ErrVenueLayoutExceedsCapacity
+		}
+	}
+	return nil
+}
+
+// HasLayout reports whether the venue has a seat layout to generate spots from
+func (v Venue) HasLayout() bool {
+	return len(v.Layout.Sections) > 0
+}
+
+// FullAddress describes where the venue is, used as the location of its events
+func (v Venue) FullAddress() string {
+	parts := []string{v.Name}
+	for _, part := range []string{v.Address, v.City} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// CheckEventCapacity verifies an event at the venue fits in it
+func (v Venue) CheckEventCapacity(capacity int) error {
+	if capacity > v.Capacity {
+		return ErrVenueCapacityExceeded
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatedNewVenue(t *testing.T) {
+	venue, err := CreatedNewVenue(" Allianz Parque ", "Av. Francisco Matarazzo, 1705", "São Paulo", -23.5275, -46.6783, "America/Sao_Paulo", 100, testSeatLayout())
+	assert.Nil(t, err)
+	assert.NotEmpty(t, venue.ID)
+	assert.Equal(t, "Allianz Parque", venue.Name)
+	assert.True(t, venue.HasLayout())
+	assert.Equal(t, "Allianz Parque, Av. Francisco Matarazzo, 1705, São Paulo", venue.FullAddress())
+
+	venue, err = CreatedNewVenue("Arena", "", "", 0, 0, "UTC", 10, SeatLayout{})
+	assert.Nil(t, err)
+	assert.False(t, venue.HasLayout())
+	assert.Equal(t, "Arena", venue.FullAddress())
+}
+
+func TestVenue_Validate(t *testing.T) {
+	venue := Venue{Name: "Arena", Timezone: "America/Sao_Paulo", Capacity: 4, Layout: testSeatLayout()}
+	assert.Nil(t, venue.Validate())
+
+	venue.Name = ""
+	assert.Equal(t, ErrVenueNameRequired, venue.Validate())
+
+	venue.Name = "Arena"
+	venue.Latitude = 91
+	assert.Equal(t, ErrVenueCoordinatesInvalid, venue.Validate())
+
+	venue.Latitude = 0
+	venue.Longitude = -181
+	assert.Equal(t, ErrVenueCoordinatesInvalid, venue.Validate())
+
+	venue.Longitude = 0
+	for _, timezone := range []string{"", "America/Nowhere", "-03:00"} {
+		venue.Timezone = timezone
+		assert.Equal(t, ErrVenueTimezoneInvalid, venue.Validate(), timezone)
+	}
+
+	venue.Timezone = "Europe/Lisbon"
+	venue.Capacity = 0
+	assert.Equal(t, ErrVenueCapacityInvalid, venue.Validate())
+
+	venue.Capacity = 3
+	assert.Equal(t, ErrVenueLayoutExceedsCapacity, venue.Validate())
+
+	venue.Capacity = 4
+	venue.Layout.Sections[0].Rows[0].Label = "a"
+	assert.Equal(t, ErrSeatRowLabelInvalid, venue.Validate())
+}
+
+func TestVenue_CheckEventCapacity(t *testing.T) {
+	venue := Venue{Capacity: 100}
+	assert.Nil(t, venue.CheckEventCapacity(100))
+	assert.Equal(t, ErrVenueCapacityExceeded, venue.CheckEventCapacity(101))
+}
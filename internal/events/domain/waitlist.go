@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrWaitlistEmailInvalid    = errors.New("waitlist email is invalid")
+	ErrWaitlistQuantityInvalid = errors.New("waitlist quantity must be greater than zero")
+	ErrWaitlistAlreadyJoined   = errors.New("email is already on the waitlist for this event")
+	ErrWaitlistEntryNotFound   = errors.New("waitlist entry not found")
+	ErrWaitlistEntryNotWaiting = errors.New("waitlist entry is not waiting for an offer")
+	ErrWaitlistEntryNotOffered = errors.New("waitlist entry has no open offer")
+	ErrWaitlistSpotsAvailable  = errors.New("event still has spots available for the requested quantity")
+)
+
+// DefaultWaitlistOfferTTL is how long released spots stay held for the
+// waitlisted buyer they were offered to.
+const DefaultWaitlistOfferTTL = 15 * time.Minute
+
+type WaitlistStatus string
+
+const (
+	WaitlistStatusWaiting   WaitlistStatus = "waiting"
+	WaitlistStatusOffered   WaitlistStatus = "offered"
+	WaitlistStatusFulfilled WaitlistStatus = "fulfilled"
+	WaitlistStatusExpired   WaitlistStatus = "expired"
+)
+
+// WaitlistEntry is a buyer waiting for spots of a sold out event. When spots
+// are released the entry is offered an exclusive hold on them until
+// OfferExpiresAt.
+type WaitlistEntry struct {
+	ID             string         `json:"id"`
+	EventID        string         `json:"event_id"`
+	Email          string         `json:"email"`
+	Quantity       int            `json:"quantity"`
+	Status         WaitlistStatus `json:"status"`
+	OfferedSpotIDs []string       `json:"offered_spot_ids"`
+	OfferExpiresAt time.Time      `json:"offer_expires_at"`
+	CreatedAt      time.Time      `json:"created_at"`
+}
+
+func CreatedNewWaitlistEntry(e Event, email string, quantity int) (*WaitlistEntry, error) {
+	entry := &WaitlistEntry{
+		ID:        uuid.New().String(),
+		EventID:   e.ID,
+		Email:     NormalizeEmail(email),
+		Quantity:  quantity,
+		Status:    WaitlistStatusWaiting,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := entry.Validate(); err != nil {
+		return nil, err
+	}
+	if err := e.CheckBuyerLimit(0, quantity); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (w WaitlistEntry) Validate() error {
+	if !IsValidEmail(w.Email) {
+		return ErrWaitlistEmailInvalid
+	}
+	if w.Quantity <= 0 {
+		return ErrWaitlistQuantityInvalid
+	}
+	return nil
+}
+
+// IsActive reports whether the entry is still waiting or holding an offer
+func (w WaitlistEntry) IsActive() bool {
+	return w.Status == WaitlistStatusWaiting || w.Status == WaitlistStatusOffered
+}
+
+// Offer records that the given spots are held for the entry until the time given
+func (w *WaitlistEntry) Offer(spotIDs []string, until time.Time) error {
+	if w.Status != WaitlistStatusWaiting {
+		return ErrWaitlistEntryNotWaiting
+	}
+	w.Status = WaitlistStatusOffered
+	w.OfferedSpotIDs = spotIDs
+	w.OfferExpiresAt = until
+	return nil
+}
+
+// ExpireOffer closes an offer the buyer did not take in time
+func (w *WaitlistEntry) ExpireOffer() error {
+	if w.Status != WaitlistStatusOffered {
+		return ErrWaitlistEntryNotOffered
+	}
+	w.Status = WaitlistStatusExpired
+	return nil
+}
+
+// Fulfill closes an offer the buyer purchased
+func (w *WaitlistEntry) Fulfill() error {
+	if w.Status != WaitlistStatusOffered {
+		return ErrWaitlistEntryNotOffered
+	}
+	w.Status = WaitlistStatusFulfilled
+	return nil
+}
+
+// WaitlistPosition returns the 1-based position of the entry among the waiting
+// entries, which must be ordered by CreatedAt. Entries that are not waiting
+// have position zero.
+func WaitlistPosition(entries []WaitlistEntry, entryID string) int {
+	position := 0
+	for _, entry := range entries {
+		if entry.Status != WaitlistStatusWaiting {
+			continue
+		}
+		position++
+		if entry.ID == entryID {
+			return position
+		}
+	}
+	return 0
+}
+
+// NextWaitlistEntry returns the first waiting entry, in order, whose quantity
+// fits in the available spots, or nil when none fits.
+func NextWaitlistEntry(entries []WaitlistEntry, available int) *WaitlistEntry {
+	for i := range entries {
+		if entries[i].Status == WaitlistStatusWaiting && entries[i].Quantity <= available {
+			return &entries[i]
+		}
+	}
+	return nil
+}
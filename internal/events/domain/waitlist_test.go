@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatedNewWaitlistEntry(t *testing.T) {
+	event, _ := CreatedNewEvent("Event Test", "Location Test", "Organization Test", RatingFree, time.Now().Add(24*time.Hour), "image_url", 100, 50.00, 1)
+
+	entry, err := CreatedNewWaitlistEntry(*event, " Buyer@Example.com ", 2)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, entry.ID)
+	assert.Equal(t, event.ID, entry.EventID)
+	assert.Equal(t, "buyer@example.com", entry.Email)
+	assert.Equal(t, WaitlistStatusWaiting, entry.Status)
+	assert.True(t, entry.IsActive())
+
+	_, err = CreatedNewWaitlistEntry(*event, "not an email", 2)
+	assert.Equal(t, ErrWaitlistEmailInvalid, err)
+
+	_, err = CreatedNewWaitlistEntry(*event, "buyer@example.com", 0)
+	assert.Equal(t, ErrWaitlistQuantityInvalid, err)
+
+	event.MaxTicketsPerBuyer = 1
+	_, err = CreatedNewWaitlistEntry(*event, "buyer@example.com", 2)
+	assert.ErrorIs(t, err, ErrPurchaseRejected)
+}
+
+func TestWaitlistEntry_Offer(t *testing.T) {
+	entry := WaitlistEntry{Email: "buyer@example.com", Quantity: 1, Status: WaitlistStatusWaiting}
+	until := time.Now().Add(DefaultWaitlistOfferTTL)
+
+	assert.Equal(t, ErrWaitlistEntryNotOffered, entry.Fulfill())
+	assert.Nil(t, entry.Offer([]string{"spot-1"}, until))
+	assert.Equal(t, WaitlistStatusOffered, entry.Status)
+	assert.Equal(t, []string{"spot-1"}, entry.OfferedSpotIDs)
+	assert.Equal(t, until, entry.OfferExpiresAt)
+	assert.Equal(t, ErrWaitlistEntryNotWaiting, entry.Offer([]string{"spot-2"}, until))
+
+	fulfilled := entry
+	assert.Nil(t, fulfilled.Fulfill())
+	assert.Equal(t, WaitlistStatusFulfilled, fulfilled.Status)
+	assert.False(t, fulfilled.IsActive())
+
+	assert.Nil(t, entry.ExpireOffer())
+	assert.Equal(t, WaitlistStatusExpired, entry.Status)
+	assert.Equal(t, ErrWaitlistEntryNotOffered, entry.ExpireOffer())
+}
+
+func TestWaitlistPosition(t *testing.T) {
+	entries := []WaitlistEntry{
+		{ID: "1", Status: WaitlistStatusFulfilled},
+		{ID: "2", Status: WaitlistStatusWaiting},
+		{ID: "3", Status: WaitlistStatusOffered},
+		{ID: "4", Status: WaitlistStatusWaiting},
+	}
+
+	assert.Equal(t, 1, WaitlistPosition(entries, "2"))
+	assert.Equal(t, 2, WaitlistPosition(entries, "4"))
+	assert.Equal(t, 0, WaitlistPosition(entries, "3"))
+	assert.Equal(t, 0, WaitlistPosition(entries, "5"))
+}
+
+func TestNextWaitlistEntry(t *testing.T) {
+	entries := []WaitlistEntry{
+		{ID: "1", Quantity: 1, Status: WaitlistStatusOffered},
+		{ID: "2", Quantity: 3, Status: WaitlistStatusWaiting},
+		{ID: "3", Quantity: 2, Status: WaitlistStatusWaiting},
+	}
+
+	assert.Nil(t, NextWaitlistEntry(entries, 1))
+	assert.Equal(t, "3", NextWaitlistEntry(entries, 2).ID)
+	assert.Equal(t, "2", NextWaitlistEntry(entries, 3).ID)
+
+	//the entry returned belongs to the slice, so offering it is visible to later calls
+	next := NextWaitlistEntry(entries, 3)
+	next.Offer([]string{"a", "b", "c"}, time.Now())
+	assert.Equal(t, "3", NextWaitlistEntry(entries, 3).ID)
+}
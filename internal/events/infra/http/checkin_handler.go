@@ -0,0 +1,161 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"go-backend-api/internal/events/domain"
+	"go-backend-api/internal/events/usecase"
+	"net/http"
+)
+
+// CheckInHandler handles HTTP the requests of the door scanners
+type CheckInHandler struct {
+	checkInTicketUseCase      *usecase.CheckInTicketUseCase
+	syncCheckInsUseCase       *usecase.SyncCheckInsUseCase
+	getCheckInManifestUseCase *usecase.GetCheckInManifestUseCase
+}
+
+// NewCheckInHandler creates a new CheckInHandler
+func NewCheckInHandler(
+	checkInTicketUseCase *usecase.CheckInTicketUseCase,
+	syncCheckInsUseCase *usecase.SyncCheckInsUseCase,
+	getCheckInManifestUseCase *usecase.GetCheckInManifestUseCase,
+) *CheckInHandler {
+	return &CheckInHandler{
+		checkInTicketUseCase:      checkInTicketUseCase,
+		syncCheckInsUseCase:       syncCheckInsUseCase,
+		getCheckInManifestUseCase: getCheckInManifestUseCase,
+	}
+}
+
+// DuplicateCheckInResponse rejects a ticket that was already used, with the
+// first scan so the door staff can tell a copy from a second attempt
+type DuplicateCheckInResponse struct {
+	Code      string                 `json:"code"`
+	Message   string                 `json:"message"`
+	FirstScan usecase.CheckInScanDto `json:"first_scan"`
+}
+
+// CheckIn handles the request to check a ticket in at the door.
+// @Summary Check a ticket in
+// @Description Verify a ticket credential and mark the ticket as used at the gate. A ticket already used is rejected with its first scan.
+// @Tags Check-in
+// @Accept json
+// @Produce json
+// @Param body body usecase.CheckInInputDto true "Scanned credential and gate"
+// @Success 200 {object} usecase.CheckInOutputDto
+// @Failure 400 {object} string
+// @Failure 403 {object} string
+// @Failure 404 {object} string
+// @Failure 409 {object} DuplicateCheckInResponse
+// @Failure 500 {object} string
+// @Router /checkin [post]
+func (h *CheckInHandler) CheckIn(w http.ResponseWriter, r *http.Request) {
+	var input usecase.CheckInInputDto
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.checkInTicketUseCase.Execute(input)
+	if err != nil {
+		var duplicate *domain.DuplicateCheckInError
+		if errors.As(err, &duplicate) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			json.NewEncoder(w).Encode(DuplicateCheckInResponse{
+				Code:      "ALREADY_CHECKED_IN",
+				Message:   duplicate.Error(),
+				FirstScan: usecase.CheckInScanDto{CheckedInAt: duplicate.CheckedInAt, Gate: duplicate.Gate},
+			})
+			return
+		}
+		http.Error(w, err.Error(), checkInErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// SyncCheckIns handles the request of an offline scanner to send its scans.
+// @Summary Sync offline scans
+// @Description Apply the scans made offline with the check-in manifest. The earliest scan of a ticket wins and sending the same scans again is safe.
+// @Tags Check-in
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event ID"
+// @Param body body usecase.SyncCheckInsInputDto true "Scanner and its scans"
+// @Success 200 {object} usecase.SyncCheckInsOutputDto
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /events/{eventId}/checkin/sync [post]
+func (h *CheckInHandler) SyncCheckIns(w http.ResponseWriter, r *http.Request) {
+	var input usecase.SyncCheckInsInputDto
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	input.EventID = r.PathValue("eventId")
+
+	output, err := h.syncCheckInsUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), checkInErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// GetCheckInManifest handles the request to download the check-in manifest of an event.
+// @Summary Get the check-in manifest
+// @Description Get the signed list of tickets of an event, with their current credential version and check-in, for scanners that work offline. Verify the signature over the manifest bytes prefixed with "MF1." with the credential keys.
+// @Tags Check-in
+// @Produce json
+// @Param eventId path string true "Event ID"
+// @Success 200 {object} domain.SignedCheckInManifest
+// @Failure 404 {object} string
+// @Failure 409 {object} string
+// @Failure 500 {object} string
+// @Router /events/{eventId}/checkin-manifest [get]
+func (h *CheckInHandler) GetCheckInManifest(w http.ResponseWriter, r *http.Request) {
+	input := usecase.GetCheckInManifestInputDto{EventID: r.PathValue("eventId")}
+
+	output, err := h.getCheckInManifestUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), checkInErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+func checkInErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrTicketNotFound),
+		errors.Is(err, domain.ErrEventNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrCredentialInvalid),
+		errors.Is(err, domain.ErrCredentialSignatureInvalid),
+		errors.Is(err, domain.ErrCredentialKeyUnknown),
+		errors.Is(err, domain.ErrCredentialRevoked),
+		errors.Is(err, domain.ErrCheckInEventMismatch):
+		return http.StatusForbidden
+	case errors.Is(err, domain.ErrTicketCheckedIn),
+		errors.Is(err, domain.ErrCheckInClosed):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrCheckInGateRequired),
+		errors.Is(err, domain.ErrCheckInScanTimeInvalid),
+		errors.Is(err, domain.ErrCheckInSyncBatchTooLarge):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,86 @@
+package http
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// TrustedProxies are the networks of the reverse proxies allowed to report
+// the client address in X-Forwarded-For. Without them the header is ignored.
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies reads a comma separated list of addresses and CIDR
+// networks, such as "10.0.0.0/8,192.168.1.10"
+func ParseTrustedProxies(spec string) (TrustedProxies, error) {
+	proxies := TrustedProxies{}
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", entry)
+			}
+			bits := 8 * net.IPv4len
+			if ip.To4() == nil {
+				bits = 8 * net.IPv6len
+			}
+			entry = fmt.Sprintf("%s/%d", entry, bits)
+		}
+		_, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q", entry)
+		}
+		proxies = append(proxies, network)
+	}
+	return proxies, nil
+}
+
+func (p TrustedProxies) trusts(address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+	for _, network := range p {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP returns the address of the client. X-Forwarded-For is only read
+// when the request comes from a trusted proxy, and then from the right, where
+// each proxy appends the address it received the request from: the client is
+// the first hop that is not a trusted proxy.
+func (p TrustedProxies) ClientIP(r *http.Request) string {
+	address := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		address = host
+	}
+	if !p.trusts(address) {
+		return address
+	}
+
+	//o cabeçalho pode vir repetido, cada proxy acrescenta o seu ao final
+	hops := []string{}
+	for _, header := range r.Header.Values("X-Forwarded-For") {
+		hops = append(hops, strings.Split(header, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if net.ParseIP(hop) == nil {
+			//um valor inválido não é confiável, fica o último endereço conhecido
+			return address
+		}
+		address = hop
+		if !p.trusts(hop) {
+			return address
+		}
+	}
+	return address
+}
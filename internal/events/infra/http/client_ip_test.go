@@ -0,0 +1,53 @@
+package http
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTrustedProxies(t *testing.T) {
+	proxies, err := ParseTrustedProxies("10.0.0.0/8, 192.168.1.10,,::1")
+	assert.Nil(t, err)
+	assert.Len(t, proxies, 3)
+	assert.True(t, proxies.trusts("10.1.2.3"))
+	assert.True(t, proxies.trusts("192.168.1.10"))
+	assert.False(t, proxies.trusts("192.168.1.11"))
+	assert.True(t, proxies.trusts("::1"))
+
+	_, err = ParseTrustedProxies("proxy.local")
+	assert.NotNil(t, err)
+	_, err = ParseTrustedProxies("10.0.0.0/40")
+	assert.NotNil(t, err)
+}
+
+func TestTrustedProxies_ClientIP(t *testing.T) {
+	proxies, err := ParseTrustedProxies("10.0.0.0/8")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//sem proxy confiável o cabeçalho forjado pelo cliente é ignorado
+	r := httptest.NewRequest("POST", "/events/buy-tickets", nil)
+	r.RemoteAddr = "203.0.113.7:51234"
+	r.Header.Set("X-Forwarded-For", "198.51.100.1")
+	assert.Equal(t, "203.0.113.7", proxies.ClientIP(r))
+	assert.Equal(t, "203.0.113.7", TrustedProxies(nil).ClientIP(r))
+
+	//atrás do proxy vale o último endereço que não é um proxy, não o primeiro
+	r.RemoteAddr = "10.0.0.2:443"
+	r.Header.Set("X-Forwarded-For", "198.51.100.1, 203.0.113.7, 10.0.0.5")
+	assert.Equal(t, "203.0.113.7", proxies.ClientIP(r))
+
+	r.Header.Del("X-Forwarded-For")
+	r.Header.Add("X-Forwarded-For", "198.51.100.1")
+	r.Header.Add("X-Forwarded-For", "203.0.113.9")
+	assert.Equal(t, "203.0.113.9", proxies.ClientIP(r))
+
+	r.Header.Set("X-Forwarded-For", "not-an-ip, 10.0.0.5")
+	assert.Equal(t, "10.0.0.5", proxies.ClientIP(r))
+
+	r.Header.Del("X-Forwarded-For")
+	assert.Equal(t, "10.0.0.2", proxies.ClientIP(r))
+}
@@ -0,0 +1,180 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"go-backend-api/internal/events/domain"
+	"go-backend-api/internal/events/usecase"
+	"net/http"
+)
+
+// CouponsHandler handles HTTP the coupons admin requests
+type CouponsHandler struct {
+	listCouponsUseCase  *usecase.ListCouponsUseCase
+	getCouponUseCase    *usecase.GetCouponUseCase
+	createCouponUseCase *usecase.CreateCouponUseCase
+	updateCouponUseCase *usecase.UpdateCouponUseCase
+	deleteCouponUseCase *usecase.DeleteCouponUseCase
+}
+
+// NewCouponsHandler creates a new CouponsHandler
+func NewCouponsHandler(
+	listCouponsUseCase *usecase.ListCouponsUseCase,
+	getCouponUseCase *usecase.GetCouponUseCase,
+	createCouponUseCase *usecase.CreateCouponUseCase,
+	updateCouponUseCase *usecase.UpdateCouponUseCase,
+	deleteCouponUseCase *usecase.DeleteCouponUseCase,
+) *CouponsHandler {
+	return &CouponsHandler{
+		listCouponsUseCase:  listCouponsUseCase,
+		getCouponUseCase:    getCouponUseCase,
+		createCouponUseCase: createCouponUseCase,
+		updateCouponUseCase: updateCouponUseCase,
+		deleteCouponUseCase: deleteCouponUseCase,
+	}
+}
+
+// ListCoupons handles the request to list all coupons.
+// @Summary List all coupons
+// @Description Get all promotional coupons
+// @Tags Coupons
+// @Produce json
+// @Success 200 {object} usecase.ListCouponsOutputDto
+// @Failure 500 {object} string
+// @Router /coupons [get]
+func (h *CouponsHandler) ListCoupons(w http.ResponseWriter, r *http.Request) {
+	output, err := h.listCouponsUseCase.Execute()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// GetCoupon handles the request to get a coupon by its ID.
+// @Summary Get a coupon
+// @Description Get a coupon by its ID
+// @Tags Coupons
+// @Produce json
+// @Param couponId path string true "Coupon ID"
+// @Success 200 {object} usecase.CouponDto
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /coupons/{couponId} [get]
+func (h *CouponsHandler) GetCoupon(w http.ResponseWriter, r *http.Request) {
+	input := usecase.GetCouponInputDto{ID: r.PathValue("couponId")}
+
+	output, err := h.getCouponUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), couponErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// CreateCoupon handles the request to create a new coupon.
+// @Summary Create a coupon
+// @Description Create a new promotional coupon
+// @Tags Coupons
+// @Accept json
+// @Produce json
+// @Param body body usecase.CreateCouponInputDto true "Coupon data"
+// @Success 201 {object} usecase.CouponDto
+// @Failure 400 {object} string
+// @Failure 409 {object} string
+// @Failure 500 {object} string
+// @Router /coupons [post]
+func (h *CouponsHandler) CreateCoupon(w http.ResponseWriter, r *http.Request) {
+	var input usecase.CreateCouponInputDto
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.createCouponUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), couponErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(output)
+}
+
+// UpdateCoupon handles the request to update a coupon.
+// @Summary Update a coupon
+// @Description Replace the configuration of a coupon
+// @Tags Coupons
+// @Accept json
+// @Produce json
+// @Param couponId path string true "Coupon ID"
+// @Param body body usecase.CreateCouponInputDto true "Coupon data"
+// @Success 200 {object} usecase.CouponDto
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /coupons/{couponId} [put]
+func (h *CouponsHandler) UpdateCoupon(w http.ResponseWriter, r *http.Request) {
+	var input usecase.UpdateCouponInputDto
+	if err := json.NewDecoder(r.Body).Decode(&input.CreateCouponInputDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	input.ID = r.PathValue("couponId")
+
+	output, err := h.updateCouponUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), couponErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// DeleteCoupon handles the request to delete a coupon.
+// @Summary Delete a coupon
+// @Description Delete a coupon and its redemption history
+// @Tags Coupons
+// @Param couponId path string true "Coupon ID"
+// @Success 204
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /coupons/{couponId} [delete]
+func (h *CouponsHandler) DeleteCoupon(w http.ResponseWriter, r *http.Request) {
+	input := usecase.DeleteCouponInputDto{ID: r.PathValue("couponId")}
+
+	if err := h.deleteCouponUseCase.Execute(input); err != nil {
+		http.Error(w, err.Error(), couponErrorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func couponErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrCouponNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrCouponAlreadyExists):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrCouponCodeRequired),
+		errors.Is(err, domain.ErrCouponTypeInvalid),
+		errors.Is(err, domain.ErrCouponValueInvalid),
+		errors.Is(err, domain.ErrCouponPercentageInvalid),
+		errors.Is(err, domain.ErrCouponValidityInvalid),
+		errors.Is(err, domain.ErrCouponUsageInvalid),
+		errors.Is(err, domain.ErrTicketStatusInvalid):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
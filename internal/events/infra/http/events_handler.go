@@ -2,8 +2,12 @@ package http
 
 import (
 	"encoding/json"
+	"errors"
+	"go-backend-api/internal/events/domain"
 	"go-backend-api/internal/events/usecase"
 	"net/http"
+	"strconv"
+	"strings"
 )
 
 // EventsHandler handles HTTP the events requests
@@ -14,6 +18,12 @@ type EventsHandler struct {
 	buyTicketsUseCase	*usecase.BuyTicketsUseCase
 	createSpotsUseCase	*usecase.CreateSpotsUseCase
 	listSpotsUseCase	*usecase.ListSpotsUseCase
+	getSeatMapUseCase	*usecase.GetSeatMapUseCase
+	holdBestAvailableSeatsUseCase	*usecase.HoldBestAvailableSeatsUseCase
+	searchEventsUseCase	*usecase.SearchEventsUseCase
+	nearbyEventsUseCase	*usecase.NearbyEventsUseCase
+	changeEventStatusUseCase	*usecase.ChangeEventStatusUseCase
+	trustedProxies	TrustedProxies
 }
 
 // NewEventsHandler creates a new EventsHandler
@@ -24,6 +34,12 @@ func NewEventsHandler(
 	buyTicketsUseCase *usecase.BuyTicketsUseCase,
 	createSpotsUseCase *usecase.CreateSpotsUseCase,
 	listSpotsUseCase *usecase.ListSpotsUseCase,
+	getSeatMapUseCase *usecase.GetSeatMapUseCase,
+	holdBestAvailableSeatsUseCase *usecase.HoldBestAvailableSeatsUseCase,
+	searchEventsUseCase *usecase.SearchEventsUseCase,
+	nearbyEventsUseCase *usecase.NearbyEventsUseCase,
+	changeEventStatusUseCase *usecase.ChangeEventStatusUseCase,
+	trustedProxies TrustedProxies,
 ) *EventsHandler {
 	return &EventsHandler{
 		listEventsUseCase: listEventsUseCase,
@@ -32,6 +48,12 @@ func NewEventsHandler(
 		buyTicketsUseCase: buyTicketsUseCase,
 		createSpotsUseCase: createSpotsUseCase,
 		listSpotsUseCase: listSpotsUseCase,
+		getSeatMapUseCase: getSeatMapUseCase,
+		holdBestAvailableSeatsUseCase: holdBestAvailableSeatsUseCase,
+		searchEventsUseCase: searchEventsUseCase,
+		nearbyEventsUseCase: nearbyEventsUseCase,
+		changeEventStatusUseCase: changeEventStatusUseCase,
+		trustedProxies: trustedProxies,
 	}
 }
 
@@ -56,6 +78,88 @@ func (h *EventsHandler) ListEvents(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(output)
 }
 
+// SearchEvents handles the request to search events by text.
+// @Summary Search events
+// @Description Full-text search across the event name, location and organization, ignoring accents and case. Every word must start a word of the event; results are ordered by relevance, name matches first.
+// @Tags Events
+// @Produce json
+// @Param q query string true "Search text"
+// @Param limit query int false "Maximum number of events, 20 by default and at most 100"
+// @Success 200 {object} usecase.SearchEventsOutputDto
+// @Failure 400 {object} string
+// @Failure 500 {object} string
+// @Router /events/search [get]
+func (h *EventsHandler) SearchEvents(w http.ResponseWriter, r *http.Request) {
+	input := usecase.SearchEventsInputDto{Query: r.URL.Query().Get("q")}
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		value, err := strconv.Atoi(limit)
+		if err != nil {
+			http.Error(w, domain.ErrSearchLimitInvalid.Error(), http.StatusBadRequest)
+			return
+		}
+		input.Limit = value
+	}
+
+	output, err := h.searchEventsUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), searchErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// NearbyEvents handles the request to find events near a location.
+// @Summary Find events near a location
+// @Description Get the events within radius_km of the coordinates, the closest first, with their distance in km
+// @Tags Events
+// @Produce json
+// @Param lat query number true "Latitude"
+// @Param lng query number true "Longitude"
+// @Param radius_km query number false "Search radius in km, 50 by default and at most 500"
+// @Param limit query int false "Maximum number of events, 20 by default and at most 100"
+// @Success 200 {object} usecase.NearbyEventsOutputDto
+// @Failure 400 {object} string
+// @Failure 500 {object} string
+// @Router /events/nearby [get]
+func (h *EventsHandler) NearbyEvents(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	var input usecase.NearbyEventsInputDto
+	var err error
+	if input.Latitude, err = strconv.ParseFloat(query.Get("lat"), 64); err != nil {
+		http.Error(w, domain.ErrGeoPointInvalid.Error(), http.StatusBadRequest)
+		return
+	}
+	if input.Longitude, err = strconv.ParseFloat(query.Get("lng"), 64); err != nil {
+		http.Error(w, domain.ErrGeoPointInvalid.Error(), http.StatusBadRequest)
+		return
+	}
+	if radius := query.Get("radius_km"); radius != "" {
+		if input.RadiusKm, err = strconv.ParseFloat(radius, 64); err != nil {
+			http.Error(w, domain.ErrNearbyRadiusInvalid.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	if limit := query.Get("limit"); limit != "" {
+		if input.Limit, err = strconv.Atoi(limit); err != nil {
+			http.Error(w, domain.ErrSearchLimitInvalid.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	output, err := h.nearbyEventsUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), searchErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
 // GetEvent handles the request to get an event by its ID.
 // @Summary Get an event
 // @Description Get an event by its ID
@@ -83,6 +187,39 @@ func (h *EventsHandler) GetEvent(w http.ResponseWriter, r *http.Request){
 	json.NewEncoder(w).Encode(output)
 }
 
+// ChangeEventStatus handles the request to move an event through its lifecycle.
+// @Summary Change the status of an event
+// @Description Move the event to draft, published, on_sale, finished or cancelled; sold_out is set from the ticket sales and a sold out event only goes back on sale while tickets are left
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event ID"
+// @Param body body usecase.ChangeEventStatusInputDto true "New status"
+// @Success 200 {object} usecase.EventDto
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 409 {object} string
+// @Failure 500 {object} string
+// @Router /events/{eventId}/status [put]
+func (h *EventsHandler) ChangeEventStatus(w http.ResponseWriter, r *http.Request) {
+	var input usecase.ChangeEventStatusInputDto
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	input.EventID = r.PathValue("eventId")
+
+	output, err := h.changeEventStatusUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), lifecycleErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
 // CreateEvent handles the request to create a new event.
 // @Summary Create an event
 // @Description Create a new event
@@ -92,6 +229,7 @@ func (h *EventsHandler) GetEvent(w http.ResponseWriter, r *http.Request){
 // @Param event body usecase.CreateEventInputDto true "Event data"
 // @Success 201 {object} usecase.CreateEventOutputDto
 // @Failure 400 {object} string
+// @Failure 404 {object} string
 // @Failure 500 {object} string
 func (h *EventsHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
 	var input usecase.CreateEventInputDto
@@ -102,7 +240,7 @@ func (h *EventsHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
 
 	output, err := h.createEventUseCase.Execute(input)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), createEventErrorStatus(err))
 		return
 	}
 
@@ -113,7 +251,7 @@ func (h *EventsHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
 
 // BuyTickets handles the request to buy tickets for an event.
 // @Summary Buy tickets
-// @Description Buy tickets for an event
+// @Description Buy tickets for an event. Events with an age rating need one attendee per spot old enough for it
 // @Tags Events
 // @Accept json
 // @Produce json
@@ -128,10 +266,19 @@ func (h *EventsHandler) BuyTickets(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	input.IPAddress = h.trustedProxies.ClientIP(r)
+	if input.Locale == "" {
+		input.Locale = preferredLanguage(r)
+	}
 
 	output, err := h.buyTicketsUseCase.Execute(input)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		var rejection *domain.PurchaseRejection
+		if errors.As(err, &rejection) {
+			h.WriteErrorResponse(w, http.StatusForbidden, rejection.Code, rejection.Error())
+			return
+		}
+		http.Error(w, err.Error(), salesErrorStatus(err))
 		return
 	}
 
@@ -142,7 +289,7 @@ func (h *EventsHandler) BuyTickets(w http.ResponseWriter, r *http.Request) {
 
 // CreateSpots handles the request to create spots for an event.
 // @Summary Create spots
-// @Description Create spots for an event
+// @Description Create spots for an event by quantity, seat layout, list of names, ranges such as "A1-A20,B1-B20" or name template
 // @Tags Events
 // @Accept json
 // @Produce json
@@ -164,7 +311,7 @@ func (h *EventsHandler) CreateSpots(w http.ResponseWriter, r *http.Request) {
 
 	output, err := h.createSpotsUseCase.Execute(input)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), salesErrorStatus(err))
 		return
 	}
 
@@ -174,14 +321,16 @@ func (h *EventsHandler) CreateSpots(w http.ResponseWriter, r *http.Request) {
 }
 
 //WriteErrorResponse writes an error response json format
-func (h *EventsHandler) WriteErrorResponse(w http.ResponseWriter, statusCode int, message string) {
+func (h *EventsHandler) WriteErrorResponse(w http.ResponseWriter, statusCode int, code, message string) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
-	json.NewEncoder(w).Encode(ErrorResponse{Message: message})
+	json.NewEncoder(w).Encode(ErrorResponse{Code: code, Message: message})
 }
 
-// ErrorResponse represents an error response
+// ErrorResponse represents an error response. Code is a stable machine
+// readable identifier, set for errors clients are expected to handle.
 type ErrorResponse struct {
+	Code    string `json:"code,omitempty"`
 	Message string `json:"message"`
 }
 
@@ -204,4 +353,181 @@ func(h *EventsHandler) ListSpots(w http.ResponseWriter, r *http.Request){
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(output)
-}
\ No newline at end of file
+}
+
+// GetSeatMap handles the request to get the seat map of an event.
+// @Summary Get the seat map
+// @Description Get the spots of an event grouped by section and row, with coordinates and status, ready to be drawn
+// @Tags Events
+// @Produce json
+// @Param eventId path string true "Event ID"
+// @Success 200 {object} usecase.GetSeatMapOutputDto
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /events/{eventId}/seatmap [get]
+func (h *EventsHandler) GetSeatMap(w http.ResponseWriter, r *http.Request) {
+	input := usecase.GetSeatMapInputDto{EventID: r.PathValue("eventId")}
+
+	output, err := h.getSeatMapUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), salesErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// HoldBestAvailableSeats handles the request to pick and hold the best available seats.
+// @Summary Hold the best available seats
+// @Description Pick the best contiguous available seats of an event, optionally in one section, and hold them for the buyer. The held spots are then bought with buy-tickets. Seats the buyer already holds or bought count towards the limit of tickets per buyer.
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event ID"
+// @Param body body usecase.HoldBestAvailableSeatsInputDto true "Selection data"
+// @Success 201 {object} usecase.HoldBestAvailableSeatsOutputDto
+// @Failure 400 {object} string
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} string
+// @Failure 409 {object} string
+// @Failure 500 {object} string
+// @Router /events/{eventId}/best-available [post]
+func (h *EventsHandler) HoldBestAvailableSeats(w http.ResponseWriter, r *http.Request) {
+	var input usecase.HoldBestAvailableSeatsInputDto
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	input.EventID = r.PathValue("eventId")
+
+	output, err := h.holdBestAvailableSeatsUseCase.Execute(input)
+	if err != nil {
+		var rejection *domain.PurchaseRejection
+		if errors.As(err, &rejection) {
+			h.WriteErrorResponse(w, http.StatusForbidden, rejection.Code, rejection.Error())
+			return
+		}
+		http.Error(w, err.Error(), salesErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(output)
+}
+
+func searchErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrSearchQueryEmpty),
+		errors.Is(err, domain.ErrSearchLimitInvalid),
+		errors.Is(err, domain.ErrGeoPointInvalid),
+		errors.Is(err, domain.ErrNearbyRadiusInvalid):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// lifecycleErrorStatus maps the errors of event status changes to HTTP status codes
+func lifecycleErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrEventNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrEventTransitionInvalid),
+		errors.Is(err, domain.ErrEventSoldOutAutomatic),
+		errors.Is(err, domain.ErrEventStillSoldOut),
+		errors.Is(err, domain.ErrEventAlreadyStarted),
+		errors.Is(err, domain.ErrEventNotStarted),
+		errors.Is(err, domain.ErrEventStatusConflict):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrEventStatusInvalid):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// createEventErrorStatus maps the errors of event creation to HTTP status codes
+func createEventErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrVenueNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrEventNameRequired),
+		errors.Is(err, domain.ErrEventDateInFuture),
+		errors.Is(err, domain.ErrEventCapacityInvalid),
+		errors.Is(err, domain.ErrEventPriceInvalid),
+		errors.Is(err, domain.ErrEventBuyerLimitInvalid),
+		errors.Is(err, domain.ErrEventTimezoneInvalid),
+		errors.Is(err, domain.ErrGeoPointInvalid),
+		errors.Is(err, domain.ErrEventScheduleInvalid),
+		errors.Is(err, domain.ErrEventRatingInvalid),
+		errors.Is(err, domain.ErrEventTransferDeadlineInvalid),
+		errors.Is(err, domain.ErrVenueCapacityExceeded):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// salesErrorStatus maps the errors of ticket sales and spot creation to
+// HTTP status codes, so buyers can tell rejections from failures.
+func salesErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrEventNotFound),
+		errors.Is(err, domain.ErrorSpotNotFound),
+		errors.Is(err, domain.ErrCouponNotFound),
+		errors.Is(err, domain.ErrVenueNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrEventCapacityExceeded),
+		errors.Is(err, domain.ErrTicketQuotaExceeded),
+		errors.Is(err, domain.ErrCouponUsageLimitReached),
+		errors.Is(err, domain.ErrCouponEmailLimitReached),
+		errors.Is(err, domain.ErrSpotNotAvailable),
+		errors.Is(err, domain.ErrSpotHeld),
+		errors.Is(err, domain.ErrSpotNameAlreadyExists),
+		errors.Is(err, domain.ErrNoContiguousSeats),
+		errors.Is(err, domain.ErrEventNotOnSale):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrTicketCategoryNotFound),
+		errors.Is(err, domain.ErrTicketCategoryNotEligible),
+		errors.Is(err, domain.ErrInvalidNumberSpot),
+		errors.Is(err, domain.ErrSeatQuantityInvalid),
+		errors.Is(err, domain.ErrSeatLayoutEmpty),
+		errors.Is(err, domain.ErrSeatSectionNameRequired),
+		errors.Is(err, domain.ErrSeatRowLabelInvalid),
+		errors.Is(err, domain.ErrSeatNumberInvalid),
+		errors.Is(err, domain.ErrSeatCoordinatesInvalid),
+		errors.Is(err, domain.ErrSeatAttributeBlank),
+		errors.Is(err, domain.ErrSeatDuplicated),
+		errors.Is(err, domain.ErrSpotRangeInvalid),
+		errors.Is(err, domain.ErrSpotTemplateInvalid),
+		errors.Is(err, domain.ErrSpotCountTooLarge),
+		errors.Is(err, domain.ErrSpotNameRequired),
+		errors.Is(err, domain.ErrSpotNameCharMin),
+		errors.Is(err, domain.ErrSpotNameTooLong),
+		errors.Is(err, domain.ErrSportNameFormatInit),
+		errors.Is(err, domain.ErrSportNameFormatEnd),
+		errors.Is(err, usecase.ErrCreateSpotsModeConflict),
+		errors.Is(err, domain.ErrOrderEmailInvalid),
+		errors.Is(err, domain.ErrCouponNotActive),
+		errors.Is(err, domain.ErrCouponNotApplicable),
+		errors.Is(err, domain.ErrAttendeesMismatch),
+		errors.Is(err, domain.ErrAttendeeNameRequired),
+		errors.Is(err, domain.ErrAttendeeDocumentRequired),
+		errors.Is(err, domain.ErrAttendeeBirthDateInvalid),
+		errors.Is(err, domain.ErrAttendeeBirthDateRequired):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// preferredLanguage returns the first language of the Accept-Language
+// header, such as "en-US" for "en-US,en;q=0.9"
+func preferredLanguage(r *http.Request) string {
+	language, _, _ := strings.Cut(r.Header.Get("Accept-Language"), ",")
+	language, _, _ = strings.Cut(language, ";")
+	return strings.TrimSpace(language)
+}
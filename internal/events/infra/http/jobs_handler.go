@@ -0,0 +1,119 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"go-backend-api/internal/events/domain"
+	"go-backend-api/internal/events/usecase"
+	"io"
+	"net/http"
+)
+
+// JobsHandler handles HTTP the requests about the scheduled jobs
+type JobsHandler struct {
+	listJobsUseCase    *usecase.ListJobsUseCase
+	listJobRunsUseCase *usecase.ListJobRunsUseCase
+	scheduleJobUseCase *usecase.ScheduleJobUseCase
+}
+
+// NewJobsHandler creates a new JobsHandler
+func NewJobsHandler(
+	listJobsUseCase *usecase.ListJobsUseCase,
+	listJobRunsUseCase *usecase.ListJobRunsUseCase,
+	scheduleJobUseCase *usecase.ScheduleJobUseCase,
+) *JobsHandler {
+	return &JobsHandler{
+		listJobsUseCase:    listJobsUseCase,
+		listJobRunsUseCase: listJobRunsUseCase,
+		scheduleJobUseCase: scheduleJobUseCase,
+	}
+}
+
+// ListJobs handles the request to list the scheduled jobs.
+// @Summary List the scheduled jobs
+// @Description Get the recurring and one-off jobs with their schedule and next run
+// @Tags Jobs
+// @Produce json
+// @Success 200 {object} usecase.ListJobsOutputDto
+// @Failure 500 {object} string
+// @Router /jobs [get]
+func (h *JobsHandler) ListJobs(w http.ResponseWriter, r *http.Request) {
+	output, err := h.listJobsUseCase.Execute()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// ListJobRuns handles the request to get the run history of a job.
+// @Summary List the runs of a job
+// @Description Get the latest runs of a job, the most recent first
+// @Tags Jobs
+// @Produce json
+// @Param name path string true "Job name"
+// @Success 200 {object} usecase.ListJobRunsOutputDto
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /jobs/{name}/runs [get]
+func (h *JobsHandler) ListJobRuns(w http.ResponseWriter, r *http.Request) {
+	input := usecase.ListJobRunsInputDto{JobName: r.PathValue("name")}
+
+	output, err := h.listJobRunsUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), jobErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// ScheduleJob handles the request to run a job handler once.
+// @Summary Schedule a one-off job
+// @Description Run the handler of a job once, at run_at or as soon as possible when it is omitted
+// @Tags Jobs
+// @Accept json
+// @Produce json
+// @Param name path string true "Job handler"
+// @Param body body usecase.ScheduleJobInputDto false "Run time"
+// @Success 201 {object} usecase.JobDto
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /jobs/{name}/schedule [post]
+func (h *JobsHandler) ScheduleJob(w http.ResponseWriter, r *http.Request) {
+	var input usecase.ScheduleJobInputDto
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil && !errors.Is(err, io.EOF) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	input.Handler = r.PathValue("name")
+
+	output, err := h.scheduleJobUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), jobErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(output)
+}
+
+func jobErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrJobNotFound),
+		errors.Is(err, domain.ErrJobHandlerNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrJobRunAtInvalid),
+		errors.Is(err, domain.ErrJobNameInvalid):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,208 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"go-backend-api/internal/events/domain"
+	"go-backend-api/internal/events/infra/service"
+	"go-backend-api/internal/events/usecase"
+	"net/http"
+)
+
+// SeriesHandler handles HTTP the event series requests
+type SeriesHandler struct {
+	createEventSeriesUseCase        *usecase.CreateEventSeriesUseCase
+	getEventSeriesUseCase           *usecase.GetEventSeriesUseCase
+	updateEventSeriesUseCase        *usecase.UpdateEventSeriesUseCase
+	overrideSeriesOccurrenceUseCase *usecase.OverrideSeriesOccurrenceUseCase
+}
+
+// NewSeriesHandler creates a new SeriesHandler
+func NewSeriesHandler(
+	createEventSeriesUseCase *usecase.CreateEventSeriesUseCase,
+	getEventSeriesUseCase *usecase.GetEventSeriesUseCase,
+	updateEventSeriesUseCase *usecase.UpdateEventSeriesUseCase,
+	overrideSeriesOccurrenceUseCase *usecase.OverrideSeriesOccurrenceUseCase,
+) *SeriesHandler {
+	return &SeriesHandler{
+		createEventSeriesUseCase:        createEventSeriesUseCase,
+		getEventSeriesUseCase:           getEventSeriesUseCase,
+		updateEventSeriesUseCase:        updateEventSeriesUseCase,
+		overrideSeriesOccurrenceUseCase: overrideSeriesOccurrenceUseCase,
+	}
+}
+
+// CreateEventSeries handles the request to create a recurring event series.
+// @Summary Create an event series
+// @Description Create one event for each date of the recurrence rule (FREQ=DAILY or WEEKLY, INTERVAL, BYDAY, COUNT or UNTIL), with the same configuration, spots and ticket categories
+// @Tags Series
+// @Accept json
+// @Produce json
+// @Param body body usecase.CreateEventSeriesInputDto true "Series data"
+// @Success 201 {object} usecase.EventSeriesDto
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /series [post]
+func (h *SeriesHandler) CreateEventSeries(w http.ResponseWriter, r *http.Request) {
+	var input usecase.CreateEventSeriesInputDto
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.createEventSeriesUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), seriesErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(output)
+}
+
+// GetEventSeries handles the request to get an event series.
+// @Summary Get an event series
+// @Description Get an event series with its occurrences ordered by date
+// @Tags Series
+// @Produce json
+// @Param seriesId path string true "Series ID"
+// @Success 200 {object} usecase.EventSeriesDto
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /series/{seriesId} [get]
+func (h *SeriesHandler) GetEventSeries(w http.ResponseWriter, r *http.Request) {
+	input := usecase.GetEventSeriesInputDto{ID: r.PathValue("seriesId")}
+
+	output, err := h.getEventSeriesUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), seriesErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// UpdateEventSeries handles the request to update an event series.
+// @Summary Update an event series
+// @Description Replace the series configuration and apply it to the future occurrences, except for the fields they override
+// @Tags Series
+// @Accept json
+// @Produce json
+// @Param seriesId path string true "Series ID"
+// @Param body body usecase.UpdateEventSeriesInputDto true "Series data"
+// @Success 200 {object} usecase.EventSeriesDto
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 409 {object} string
+// @Failure 500 {object} string
+// @Router /series/{seriesId} [put]
+func (h *SeriesHandler) UpdateEventSeries(w http.ResponseWriter, r *http.Request) {
+	var input usecase.UpdateEventSeriesInputDto
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	input.ID = r.PathValue("seriesId")
+
+	output, err := h.updateEventSeriesUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), seriesErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// OverrideSeriesOccurrence handles the request to change one occurrence of a series.
+// @Summary Override an occurrence of a series
+// @Description Change fields of one future occurrence so they no longer follow the series; fields listed in reset follow the series again
+// @Tags Series
+// @Accept json
+// @Produce json
+// @Param seriesId path string true "Series ID"
+// @Param eventId path string true "Occurrence event ID"
+// @Param body body usecase.OverrideSeriesOccurrenceInputDto true "Fields to override"
+// @Success 200 {object} usecase.EventDto
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 409 {object} string
+// @Failure 500 {object} string
+// @Router /series/{seriesId}/occurrences/{eventId} [put]
+func (h *SeriesHandler) OverrideSeriesOccurrence(w http.ResponseWriter, r *http.Request) {
+	var input usecase.OverrideSeriesOccurrenceInputDto
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	input.SeriesID = r.PathValue("seriesId")
+	input.EventID = r.PathValue("eventId")
+
+	output, err := h.overrideSeriesOccurrenceUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), seriesErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+func seriesErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrEventSeriesNotFound),
+		errors.Is(err, domain.ErrEventNotFound),
+		errors.Is(err, domain.ErrEventNotInSeries),
+		errors.Is(err, domain.ErrVenueNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrSeriesOccurrenceAlreadyPast),
+		errors.Is(err, domain.ErrSeriesCapacityBelowSpots),
+		errors.Is(err, domain.ErrEventCapacityExceeded):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrEventNameRequired),
+		errors.Is(err, domain.ErrEventDateInFuture),
+		errors.Is(err, domain.ErrEventCapacityInvalid),
+		errors.Is(err, domain.ErrEventPriceInvalid),
+		errors.Is(err, domain.ErrEventBuyerLimitInvalid),
+		errors.Is(err, domain.ErrEventTimezoneInvalid),
+		errors.Is(err, domain.ErrGeoPointInvalid),
+		errors.Is(err, domain.ErrEventStatusInvalid),
+		errors.Is(err, domain.ErrEventRatingInvalid),
+		errors.Is(err, domain.ErrEventTransitionInvalid),
+		errors.Is(err, domain.ErrEventSoldOutAutomatic),
+		errors.Is(err, domain.ErrVenueCapacityExceeded),
+		errors.Is(err, domain.ErrRecurrenceRuleInvalid),
+		errors.Is(err, domain.ErrRecurrenceFrequencyInvalid),
+		errors.Is(err, domain.ErrRecurrenceIntervalInvalid),
+		errors.Is(err, domain.ErrRecurrenceWeekdayInvalid),
+		errors.Is(err, domain.ErrRecurrenceEndRequired),
+		errors.Is(err, domain.ErrRecurrenceTooLong),
+		errors.Is(err, domain.ErrRecurrenceEmpty),
+		errors.Is(err, domain.ErrSeriesFieldInvalid),
+		errors.Is(err, domain.ErrSeriesSpotsExceedCapacity),
+		errors.Is(err, domain.ErrSeatLayoutEmpty),
+		errors.Is(err, domain.ErrSeatSectionNameRequired),
+		errors.Is(err, domain.ErrSeatRowLabelInvalid),
+		errors.Is(err, domain.ErrSeatNumberInvalid),
+		errors.Is(err, domain.ErrSeatCoordinatesInvalid),
+		errors.Is(err, domain.ErrSeatAttributeBlank),
+		errors.Is(err, domain.ErrSeatDuplicated),
+		errors.Is(err, domain.ErrTicketCategoryKindInvalid),
+		errors.Is(err, domain.ErrTicketCategoryNameRequired),
+		errors.Is(err, domain.ErrTicketCategoryMultiplierInvalid),
+		errors.Is(err, domain.ErrTicketCategoryQuotaInvalid),
+		errors.Is(err, domain.ErrTicketCategoryQuotaPercent),
+		errors.Is(err, domain.ErrTicketCategoryRequirementBlank),
+		errors.Is(err, domain.ErrTicketCategoryDuplicated),
+		errors.Is(err, service.ErrUnsupportedTicketKind):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,211 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"go-backend-api/internal/events/domain"
+	"go-backend-api/internal/events/usecase"
+	"io"
+	"net/http"
+)
+
+// SpotsHandler handles HTTP the requests that change the spots of an event
+type SpotsHandler struct {
+	updateSpotsUseCase     *usecase.UpdateSpotsUseCase
+	deleteSpotsUseCase     *usecase.DeleteSpotsUseCase
+	listSpotChangesUseCase *usecase.ListSpotChangesUseCase
+}
+
+// NewSpotsHandler creates a new SpotsHandler
+func NewSpotsHandler(
+	updateSpotsUseCase *usecase.UpdateSpotsUseCase,
+	deleteSpotsUseCase *usecase.DeleteSpotsUseCase,
+	listSpotChangesUseCase *usecase.ListSpotChangesUseCase,
+) *SpotsHandler {
+	return &SpotsHandler{
+		updateSpotsUseCase:     updateSpotsUseCase,
+		deleteSpotsUseCase:     deleteSpotsUseCase,
+		listSpotChangesUseCase: listSpotChangesUseCase,
+	}
+}
+
+// updateSpotRequest changes one spot
+type updateSpotRequest struct {
+	Actor  string            `json:"actor"`
+	Status domain.SpotStatus `json:"status"`
+	Reason string            `json:"reason"`
+	Name   string            `json:"name"`
+}
+
+// UpdateSpot handles the request to block, unblock or rename a spot.
+// @Summary Update a spot
+// @Description Block a spot with a reason (status "blocked"), unblock it (status "available") or rename it. Sold spots cannot be changed.
+// @Tags Spots
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event ID"
+// @Param spotId path string true "Spot ID"
+// @Param body body updateSpotRequest true "Spot changes"
+// @Success 200 {object} usecase.UpdateSpotsOutputDto
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 409 {object} string
+// @Failure 500 {object} string
+// @Router /events/{eventId}/spots/{spotId} [patch]
+func (h *SpotsHandler) UpdateSpot(w http.ResponseWriter, r *http.Request) {
+	var request updateSpotRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	input := usecase.UpdateSpotsInputDto{
+		EventID: r.PathValue("eventId"),
+		Actor:   request.Actor,
+		Updates: []domain.SpotUpdate{{
+			SpotID: r.PathValue("spotId"),
+			Status: request.Status,
+			Reason: request.Reason,
+			Name:   request.Name,
+		}},
+	}
+	h.updateSpots(w, input)
+}
+
+// UpdateSpots handles the request to change several spots at once.
+// @Summary Update spots in bulk
+// @Description Block, unblock or rename several spots of an event. Either every update is applied or none. Unblocked spots are offered to the waitlist first.
+// @Tags Spots
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event ID"
+// @Param body body usecase.UpdateSpotsInputDto true "Spot updates"
+// @Success 200 {object} usecase.UpdateSpotsOutputDto
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 409 {object} string
+// @Failure 500 {object} string
+// @Router /events/{eventId}/spots [patch]
+func (h *SpotsHandler) UpdateSpots(w http.ResponseWriter, r *http.Request) {
+	var input usecase.UpdateSpotsInputDto
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	input.EventID = r.PathValue("eventId")
+	h.updateSpots(w, input)
+}
+
+func (h *SpotsHandler) updateSpots(w http.ResponseWriter, input usecase.UpdateSpotsInputDto) {
+	output, err := h.updateSpotsUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), spotErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// DeleteSpot handles the request to remove a spot.
+// @Summary Delete a spot
+// @Description Remove a spot that was not sold from the event
+// @Tags Spots
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event ID"
+// @Param spotId path string true "Spot ID"
+// @Param body body usecase.DeleteSpotsInputDto true "Actor of the removal"
+// @Success 200 {object} usecase.DeleteSpotsOutputDto
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 409 {object} string
+// @Failure 500 {object} string
+// @Router /events/{eventId}/spots/{spotId} [delete]
+func (h *SpotsHandler) DeleteSpot(w http.ResponseWriter, r *http.Request) {
+	var input usecase.DeleteSpotsInputDto
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil && !errors.Is(err, io.EOF) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	input.EventID = r.PathValue("eventId")
+	input.SpotIDs = []string{r.PathValue("spotId")}
+	h.deleteSpots(w, input)
+}
+
+// DeleteSpots handles the request to remove several spots at once.
+// @Summary Delete spots in bulk
+// @Description Remove several spots that were not sold from the event. Either every spot is removed or none.
+// @Tags Spots
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event ID"
+// @Param body body usecase.DeleteSpotsInputDto true "Spots to remove"
+// @Success 200 {object} usecase.DeleteSpotsOutputDto
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 409 {object} string
+// @Failure 500 {object} string
+// @Router /events/{eventId}/spots [delete]
+func (h *SpotsHandler) DeleteSpots(w http.ResponseWriter, r *http.Request) {
+	var input usecase.DeleteSpotsInputDto
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	input.EventID = r.PathValue("eventId")
+	h.deleteSpots(w, input)
+}
+
+func (h *SpotsHandler) deleteSpots(w http.ResponseWriter, input usecase.DeleteSpotsInputDto) {
+	output, err := h.deleteSpotsUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), spotErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// ListSpotChanges handles the request to get the audit trail of the spots.
+// @Summary List the changes of the spots
+// @Description Get every block, unblock, rename and removal of the spots of an event, the oldest first
+// @Tags Spots
+// @Produce json
+// @Param eventId path string true "Event ID"
+// @Success 200 {object} usecase.ListSpotChangesOutputDto
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /events/{eventId}/spot-changes [get]
+func (h *SpotsHandler) ListSpotChanges(w http.ResponseWriter, r *http.Request) {
+	input := usecase.ListSpotChangesInputDto{EventID: r.PathValue("eventId")}
+
+	output, err := h.listSpotChangesUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), spotErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+func spotErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrSpotSold),
+		errors.Is(err, domain.ErrSpotNotBlocked):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrSpotBlockReasonRequired),
+		errors.Is(err, domain.ErrSpotBlockReasonTooLong),
+		errors.Is(err, domain.ErrSpotStatusChangeInvalid),
+		errors.Is(err, domain.ErrSpotUpdateEmpty),
+		errors.Is(err, domain.ErrSpotChangeActorRequired):
+		return http.StatusBadRequest
+	default:
+		return salesErrorStatus(err)
+	}
+}
@@ -0,0 +1,101 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"go-backend-api/internal/events/domain"
+	"go-backend-api/internal/events/infra/service"
+	"go-backend-api/internal/events/usecase"
+	"net/http"
+)
+
+// TicketCategoriesHandler handles HTTP the ticket categories requests
+type TicketCategoriesHandler struct {
+	listTicketCategoriesUseCase *usecase.ListTicketCategoriesUseCase
+	createTicketCategoryUseCase *usecase.CreateTicketCategoryUseCase
+}
+
+// NewTicketCategoriesHandler creates a new TicketCategoriesHandler
+func NewTicketCategoriesHandler(
+	listTicketCategoriesUseCase *usecase.ListTicketCategoriesUseCase,
+	createTicketCategoryUseCase *usecase.CreateTicketCategoryUseCase,
+) *TicketCategoriesHandler {
+	return &TicketCategoriesHandler{
+		listTicketCategoriesUseCase: listTicketCategoriesUseCase,
+		createTicketCategoryUseCase: createTicketCategoryUseCase,
+	}
+}
+
+// ListTicketCategories handles the request to list the ticket categories of an event.
+// @Summary List ticket categories
+// @Description Get the ticket categories sold for an event
+// @Tags Events
+// @Produce json
+// @Param eventId path string true "Event ID"
+// @Success 200 {object} usecase.ListTicketCategoriesOutputDto
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /events/{eventId}/ticket-categories [get]
+func (h *TicketCategoriesHandler) ListTicketCategories(w http.ResponseWriter, r *http.Request) {
+	input := usecase.ListTicketCategoriesInputDto{EventID: r.PathValue("eventId")}
+
+	output, err := h.listTicketCategoriesUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), ticketCategoryErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// CreateTicketCategory handles the request to add a ticket category to an event.
+// @Summary Create a ticket category
+// @Description Add a ticket category, such as VIP or student, to an event
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event ID"
+// @Param body body usecase.CreateTicketCategoryInputDto true "Ticket category data"
+// @Success 201 {object} usecase.TicketCategoryDto
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /events/{eventId}/ticket-categories [post]
+func (h *TicketCategoriesHandler) CreateTicketCategory(w http.ResponseWriter, r *http.Request) {
+	var input usecase.CreateTicketCategoryInputDto
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	input.EventID = r.PathValue("eventId")
+
+	output, err := h.createTicketCategoryUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), ticketCategoryErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(output)
+}
+
+func ticketCategoryErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrEventNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrTicketCategoryDuplicated):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrTicketCategoryKindInvalid),
+		errors.Is(err, domain.ErrTicketCategoryNameRequired),
+		errors.Is(err, domain.ErrTicketCategoryMultiplierInvalid),
+		errors.Is(err, domain.ErrTicketCategoryQuotaInvalid),
+		errors.Is(err, domain.ErrTicketCategoryRequirementBlank),
+		errors.Is(err, service.ErrUnsupportedTicketKind):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,92 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"go-backend-api/internal/events/domain"
+	"go-backend-api/internal/events/usecase"
+	"net/http"
+)
+
+// TicketTemplatesHandler handles HTTP the printed ticket template requests
+type TicketTemplatesHandler struct {
+	getTicketTemplateUseCase  *usecase.GetTicketTemplateUseCase
+	saveTicketTemplateUseCase *usecase.SaveTicketTemplateUseCase
+}
+
+// NewTicketTemplatesHandler creates a new TicketTemplatesHandler
+func NewTicketTemplatesHandler(
+	getTicketTemplateUseCase *usecase.GetTicketTemplateUseCase,
+	saveTicketTemplateUseCase *usecase.SaveTicketTemplateUseCase,
+) *TicketTemplatesHandler {
+	return &TicketTemplatesHandler{
+		getTicketTemplateUseCase:  getTicketTemplateUseCase,
+		saveTicketTemplateUseCase: saveTicketTemplateUseCase,
+	}
+}
+
+// GetTicketTemplate handles the request to get the ticket template of an organization.
+// @Summary Get a ticket template
+// @Description Get the template the tickets of the organization are printed with, the default one when it has none
+// @Tags Tickets
+// @Produce json
+// @Param organization path string true "Organization of the events"
+// @Success 200 {object} usecase.TicketTemplateDto
+// @Failure 500 {object} string
+// @Router /organizations/{organization}/ticket-template [get]
+func (h *TicketTemplatesHandler) GetTicketTemplate(w http.ResponseWriter, r *http.Request) {
+	input := usecase.GetTicketTemplateInputDto{Organization: r.PathValue("organization")}
+
+	output, err := h.getTicketTemplateUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), ticketTemplateErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// SaveTicketTemplate handles the request to set the ticket template of an organization.
+// @Summary Save a ticket template
+// @Description Create or replace the template the tickets of the organization are printed with
+// @Tags Tickets
+// @Accept json
+// @Produce json
+// @Param organization path string true "Organization of the events"
+// @Param body body usecase.SaveTicketTemplateInputDto true "Template"
+// @Success 200 {object} usecase.TicketTemplateDto
+// @Failure 400 {object} string
+// @Failure 500 {object} string
+// @Router /organizations/{organization}/ticket-template [put]
+func (h *TicketTemplatesHandler) SaveTicketTemplate(w http.ResponseWriter, r *http.Request) {
+	var input usecase.SaveTicketTemplateInputDto
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	input.Organization = r.PathValue("organization")
+
+	output, err := h.saveTicketTemplateUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), ticketTemplateErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+func ticketTemplateErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrTicketTemplateOrganizationRequired),
+		errors.Is(err, domain.ErrTicketTemplateColorInvalid),
+		errors.Is(err, domain.ErrTicketTemplateTextTooLong),
+		errors.Is(err, domain.ErrTicketTemplateLayoutInvalid):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
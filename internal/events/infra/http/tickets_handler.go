@@ -0,0 +1,391 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"go-backend-api/internal/events/domain"
+	"go-backend-api/internal/events/infra/service"
+	"go-backend-api/internal/events/usecase"
+	"net/http"
+	"strconv"
+)
+
+// TicketsHandler handles HTTP the ticket transfer requests
+type TicketsHandler struct {
+	transferTicketUseCase        *usecase.TransferTicketUseCase
+	acceptTicketTransferUseCase  *usecase.AcceptTicketTransferUseCase
+	declineTicketTransferUseCase *usecase.DeclineTicketTransferUseCase
+	cancelTicketTransferUseCase  *usecase.CancelTicketTransferUseCase
+	listTicketTransfersUseCase   *usecase.ListTicketTransfersUseCase
+	getTicketCredentialUseCase   *usecase.GetTicketCredentialUseCase
+	getTicketQRCodeUseCase       *usecase.GetTicketQRCodeUseCase
+	listCredentialKeysUseCase    *usecase.ListCredentialKeysUseCase
+	getTicketPDFUseCase          *usecase.GetTicketPDFUseCase
+	getOrderPDFUseCase           *usecase.GetOrderPDFUseCase
+}
+
+// NewTicketsHandler creates a new TicketsHandler
+func NewTicketsHandler(
+	transferTicketUseCase *usecase.TransferTicketUseCase,
+	acceptTicketTransferUseCase *usecase.AcceptTicketTransferUseCase,
+	declineTicketTransferUseCase *usecase.DeclineTicketTransferUseCase,
+	cancelTicketTransferUseCase *usecase.CancelTicketTransferUseCase,
+	listTicketTransfersUseCase *usecase.ListTicketTransfersUseCase,
+	getTicketCredentialUseCase *usecase.GetTicketCredentialUseCase,
+	getTicketQRCodeUseCase *usecase.GetTicketQRCodeUseCase,
+	listCredentialKeysUseCase *usecase.ListCredentialKeysUseCase,
+	getTicketPDFUseCase *usecase.GetTicketPDFUseCase,
+	getOrderPDFUseCase *usecase.GetOrderPDFUseCase,
+) *TicketsHandler {
+	return &TicketsHandler{
+		transferTicketUseCase:        transferTicketUseCase,
+		acceptTicketTransferUseCase:  acceptTicketTransferUseCase,
+		declineTicketTransferUseCase: declineTicketTransferUseCase,
+		cancelTicketTransferUseCase:  cancelTicketTransferUseCase,
+		listTicketTransfersUseCase:   listTicketTransfersUseCase,
+		getTicketCredentialUseCase:   getTicketCredentialUseCase,
+		getTicketQRCodeUseCase:       getTicketQRCodeUseCase,
+		listCredentialKeysUseCase:    listCredentialKeysUseCase,
+		getTicketPDFUseCase:          getTicketPDFUseCase,
+		getOrderPDFUseCase:           getOrderPDFUseCase,
+	}
+}
+
+// TransferTicket handles the request to offer a ticket to another person.
+// @Summary Transfer a ticket
+// @Description Offer a ticket to another email. The recipient receives an acceptance code and the ticket only changes hands once accepted.
+// @Tags Tickets
+// @Accept json
+// @Produce json
+// @Param ticketId path string true "Ticket ID"
+// @Param body body usecase.TransferTicketInputDto true "Current holder and recipient emails"
+// @Success 201 {object} usecase.TicketTransferDto
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 409 {object} string
+// @Failure 500 {object} string
+// @Router /tickets/{ticketId}/transfer [post]
+func (h *TicketsHandler) TransferTicket(w http.ResponseWriter, r *http.Request) {
+	var input usecase.TransferTicketInputDto
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	input.TicketID = r.PathValue("ticketId")
+
+	output, err := h.transferTicketUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), ticketErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(output)
+}
+
+// AcceptTicketTransfer handles the request of the recipient to accept a transfer.
+// @Summary Accept a ticket transfer
+// @Description Accept the pending transfer of a ticket with the acceptance code and name the attendee. The ticket credential is reissued, so the previous one stops working.
+// @Tags Tickets
+// @Accept json
+// @Produce json
+// @Param ticketId path string true "Ticket ID"
+// @Param body body usecase.AcceptTicketTransferInputDto true "Recipient email, acceptance code and attendee"
+// @Success 200 {object} usecase.AcceptTicketTransferOutputDto
+// @Failure 400 {object} string
+// @Failure 403 {object} ErrorResponse
+// @Failure 404 {object} string
+// @Failure 409 {object} string
+// @Failure 500 {object} string
+// @Router /tickets/{ticketId}/transfer/accept [post]
+func (h *TicketsHandler) AcceptTicketTransfer(w http.ResponseWriter, r *http.Request) {
+	var input usecase.AcceptTicketTransferInputDto
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	input.TicketID = r.PathValue("ticketId")
+
+	output, err := h.acceptTicketTransferUseCase.Execute(input)
+	if err != nil {
+		var rejection *domain.PurchaseRejection
+		if errors.As(err, &rejection) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			json.NewEncoder(w).Encode(ErrorResponse{Code: rejection.Code, Message: rejection.Error()})
+			return
+		}
+		http.Error(w, err.Error(), ticketErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// DeclineTicketTransfer handles the request of the recipient to decline a transfer.
+// @Summary Decline a ticket transfer
+// @Description Decline the pending transfer of a ticket, which stays with the current holder
+// @Tags Tickets
+// @Accept json
+// @Produce json
+// @Param ticketId path string true "Ticket ID"
+// @Param body body usecase.DeclineTicketTransferInputDto true "Recipient email"
+// @Success 200 {object} usecase.TicketTransferDto
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /tickets/{ticketId}/transfer/decline [post]
+func (h *TicketsHandler) DeclineTicketTransfer(w http.ResponseWriter, r *http.Request) {
+	var input usecase.DeclineTicketTransferInputDto
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	input.TicketID = r.PathValue("ticketId")
+
+	output, err := h.declineTicketTransferUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), ticketErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// CancelTicketTransfer handles the request of the holder to cancel a transfer.
+// @Summary Cancel a ticket transfer
+// @Description Cancel the pending transfer of a ticket before the recipient accepts it
+// @Tags Tickets
+// @Accept json
+// @Produce json
+// @Param ticketId path string true "Ticket ID"
+// @Param body body usecase.CancelTicketTransferInputDto true "Current holder email"
+// @Success 200 {object} usecase.TicketTransferDto
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /tickets/{ticketId}/transfer/cancel [post]
+func (h *TicketsHandler) CancelTicketTransfer(w http.ResponseWriter, r *http.Request) {
+	var input usecase.CancelTicketTransferInputDto
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	input.TicketID = r.PathValue("ticketId")
+
+	output, err := h.cancelTicketTransferUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), ticketErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// ListTicketTransfers handles the request to list the transfers of a ticket.
+// @Summary List ticket transfers
+// @Description List every transfer of a ticket, oldest first, as its ownership history
+// @Tags Tickets
+// @Produce json
+// @Param ticketId path string true "Ticket ID"
+// @Success 200 {object} usecase.ListTicketTransfersOutputDto
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /tickets/{ticketId}/transfers [get]
+func (h *TicketsHandler) ListTicketTransfers(w http.ResponseWriter, r *http.Request) {
+	input := usecase.ListTicketTransfersInputDto{TicketID: r.PathValue("ticketId")}
+
+	output, err := h.listTicketTransfersUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), ticketErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// GetTicketCredential handles the request to get the signed credential of a ticket.
+// @Summary Get a ticket credential
+// @Description Get the Ed25519 signed credential of a ticket for its current holder. Credentials issued before a transfer are no longer accepted.
+// @Tags Tickets
+// @Produce json
+// @Param ticketId path string true "Ticket ID"
+// @Param email query string true "Current holder email"
+// @Success 200 {object} usecase.GetTicketCredentialOutputDto
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /tickets/{ticketId}/credential [get]
+func (h *TicketsHandler) GetTicketCredential(w http.ResponseWriter, r *http.Request) {
+	input := usecase.GetTicketCredentialInputDto{
+		TicketID: r.PathValue("ticketId"),
+		Email:    r.URL.Query().Get("email"),
+	}
+
+	output, err := h.getTicketCredentialUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), ticketErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// GetTicketQRCode handles the request to get the QR code of a ticket.
+// @Summary Get a ticket QR code
+// @Description Get the signed credential of a ticket as a PNG QR code to present at the door
+// @Tags Tickets
+// @Produce png
+// @Param ticketId path string true "Ticket ID"
+// @Param email query string true "Current holder email"
+// @Param size query int false "Image size in pixels, from 64 to 1024"
+// @Success 200 {file} binary
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /tickets/{ticketId}/qr [get]
+func (h *TicketsHandler) GetTicketQRCode(w http.ResponseWriter, r *http.Request) {
+	input := usecase.GetTicketQRCodeInputDto{
+		TicketID: r.PathValue("ticketId"),
+		Email:    r.URL.Query().Get("email"),
+	}
+	if size := r.URL.Query().Get("size"); size != "" {
+		var err error
+		input.Size, err = strconv.Atoi(size)
+		if err != nil {
+			http.Error(w, "size must be a number", http.StatusBadRequest)
+			return
+		}
+	}
+
+	output, err := h.getTicketQRCodeUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), ticketErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "image/png")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	w.Write(output.PNG)
+}
+
+// ListCredentialKeys handles the request to list the ticket credential keys.
+// @Summary List ticket credential keys
+// @Description List the Ed25519 public keys that verify ticket credentials, including the previous keys still accepted after a rotation
+// @Tags Tickets
+// @Produce json
+// @Success 200 {object} usecase.ListCredentialKeysOutputDto
+// @Failure 500 {object} string
+// @Router /tickets/credential-keys [get]
+func (h *TicketsHandler) ListCredentialKeys(w http.ResponseWriter, r *http.Request) {
+	output, err := h.listCredentialKeysUseCase.Execute()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// GetTicketPDF handles the request to get a printable ticket.
+// @Summary Get a ticket PDF
+// @Description Get the ticket as a PDF with the event details and its QR credential, printed with the template of the event organization
+// @Tags Tickets
+// @Produce application/pdf
+// @Param ticketId path string true "Ticket ID"
+// @Param email query string true "Current holder email"
+// @Success 200 {file} binary
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /tickets/{ticketId}/pdf [get]
+func (h *TicketsHandler) GetTicketPDF(w http.ResponseWriter, r *http.Request) {
+	input := usecase.GetTicketPDFInputDto{
+		TicketID: r.PathValue("ticketId"),
+		Email:    r.URL.Query().Get("email"),
+	}
+
+	output, err := h.getTicketPDFUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), ticketErrorStatus(err))
+		return
+	}
+	writePDF(w, output)
+}
+
+// GetOrderPDF handles the request to get the printable tickets of an order.
+// @Summary Get an order PDF
+// @Description Get the tickets of an order still held by the email as a single PDF
+// @Tags Tickets
+// @Produce application/pdf
+// @Param orderId path string true "Order ID"
+// @Param email query string true "Holder email"
+// @Success 200 {file} binary
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /orders/{orderId}/pdf [get]
+func (h *TicketsHandler) GetOrderPDF(w http.ResponseWriter, r *http.Request) {
+	input := usecase.GetOrderPDFInputDto{
+		OrderID: r.PathValue("orderId"),
+		Email:   r.URL.Query().Get("email"),
+	}
+
+	output, err := h.getOrderPDFUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), ticketErrorStatus(err))
+		return
+	}
+	writePDF(w, output)
+}
+
+func writePDF(w http.ResponseWriter, output *usecase.TicketPDFOutputDto) {
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", `inline; filename="`+output.FileName+`"`)
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	w.Write(output.PDF)
+}
+
+func ticketErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrTicketNotFound),
+		errors.Is(err, domain.ErrOrderNotFound),
+		errors.Is(err, domain.ErrTicketTransferNotFound),
+		errors.Is(err, domain.ErrEventNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrTicketTransferPending),
+		errors.Is(err, domain.ErrTicketTransferNotPending),
+		errors.Is(err, domain.ErrTicketTransferClosed),
+		errors.Is(err, domain.ErrTicketCheckedIn):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrTicketNotHeldBy),
+		errors.Is(err, domain.ErrTicketTransferEmailInvalid),
+		errors.Is(err, domain.ErrTicketTransferSameHolder),
+		errors.Is(err, domain.ErrTicketTransferWrongRecipient),
+		errors.Is(err, domain.ErrTicketTransferCodeInvalid),
+		errors.Is(err, domain.ErrAttendeeNameRequired),
+		errors.Is(err, domain.ErrAttendeeDocumentRequired),
+		errors.Is(err, domain.ErrAttendeeBirthDateInvalid),
+		errors.Is(err, domain.ErrAttendeeBirthDateRequired),
+		errors.Is(err, service.ErrQRCodeSizeInvalid):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,212 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"go-backend-api/internal/events/domain"
+	"go-backend-api/internal/events/usecase"
+	"net/http"
+)
+
+// VenuesHandler handles HTTP the venues requests
+type VenuesHandler struct {
+	listVenuesUseCase      *usecase.ListVenuesUseCase
+	getVenueUseCase        *usecase.GetVenueUseCase
+	createVenueUseCase     *usecase.CreateVenueUseCase
+	updateVenueUseCase     *usecase.UpdateVenueUseCase
+	deleteVenueUseCase     *usecase.DeleteVenueUseCase
+	listVenueEventsUseCase *usecase.ListVenueEventsUseCase
+}
+
+// NewVenuesHandler creates a new VenuesHandler
+func NewVenuesHandler(
+	listVenuesUseCase *usecase.ListVenuesUseCase,
+	getVenueUseCase *usecase.GetVenueUseCase,
+	createVenueUseCase *usecase.CreateVenueUseCase,
+	updateVenueUseCase *usecase.UpdateVenueUseCase,
+	deleteVenueUseCase *usecase.DeleteVenueUseCase,
+	listVenueEventsUseCase *usecase.ListVenueEventsUseCase,
+) *VenuesHandler {
+	return &VenuesHandler{
+		listVenuesUseCase:      listVenuesUseCase,
+		getVenueUseCase:        getVenueUseCase,
+		createVenueUseCase:     createVenueUseCase,
+		updateVenueUseCase:     updateVenueUseCase,
+		deleteVenueUseCase:     deleteVenueUseCase,
+		listVenueEventsUseCase: listVenueEventsUseCase,
+	}
+}
+
+// ListVenues handles the request to list all venues.
+// @Summary List all venues
+// @Description Get all venues with their seat layouts
+// @Tags Venues
+// @Produce json
+// @Success 200 {object} usecase.ListVenuesOutputDto
+// @Failure 500 {object} string
+// @Router /venues [get]
+func (h *VenuesHandler) ListVenues(w http.ResponseWriter, r *http.Request) {
+	output, err := h.listVenuesUseCase.Execute()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// GetVenue handles the request to get a venue by its ID.
+// @Summary Get a venue
+// @Description Get a venue by its ID
+// @Tags Venues
+// @Produce json
+// @Param venueId path string true "Venue ID"
+// @Success 200 {object} usecase.VenueDto
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /venues/{venueId} [get]
+func (h *VenuesHandler) GetVenue(w http.ResponseWriter, r *http.Request) {
+	input := usecase.GetVenueInputDto{ID: r.PathValue("venueId")}
+
+	output, err := h.getVenueUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), venueErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// CreateVenue handles the request to create a new venue.
+// @Summary Create a venue
+// @Description Create a venue with its address, coordinates, timezone, capacity and seat layout
+// @Tags Venues
+// @Accept json
+// @Produce json
+// @Param body body usecase.CreateVenueInputDto true "Venue data"
+// @Success 201 {object} usecase.VenueDto
+// @Failure 400 {object} string
+// @Failure 500 {object} string
+// @Router /venues [post]
+func (h *VenuesHandler) CreateVenue(w http.ResponseWriter, r *http.Request) {
+	var input usecase.CreateVenueInputDto
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	output, err := h.createVenueUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), venueErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(output)
+}
+
+// UpdateVenue handles the request to update a venue.
+// @Summary Update a venue
+// @Description Replace the data of a venue. Spots already created for events are not changed.
+// @Tags Venues
+// @Accept json
+// @Produce json
+// @Param venueId path string true "Venue ID"
+// @Param body body usecase.CreateVenueInputDto true "Venue data"
+// @Success 200 {object} usecase.VenueDto
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /venues/{venueId} [put]
+func (h *VenuesHandler) UpdateVenue(w http.ResponseWriter, r *http.Request) {
+	var input usecase.UpdateVenueInputDto
+	if err := json.NewDecoder(r.Body).Decode(&input.CreateVenueInputDto); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	input.ID = r.PathValue("venueId")
+
+	output, err := h.updateVenueUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), venueErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+// DeleteVenue handles the request to delete a venue.
+// @Summary Delete a venue
+// @Description Delete a venue that no event references
+// @Tags Venues
+// @Param venueId path string true "Venue ID"
+// @Success 204
+// @Failure 404 {object} string
+// @Failure 409 {object} string
+// @Failure 500 {object} string
+// @Router /venues/{venueId} [delete]
+func (h *VenuesHandler) DeleteVenue(w http.ResponseWriter, r *http.Request) {
+	input := usecase.DeleteVenueInputDto{ID: r.PathValue("venueId")}
+
+	if err := h.deleteVenueUseCase.Execute(input); err != nil {
+		http.Error(w, err.Error(), venueErrorStatus(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListVenueEvents handles the request to list the events of a venue.
+// @Summary List the events of a venue
+// @Description Get all events hosted at a venue
+// @Tags Venues
+// @Produce json
+// @Param venueId path string true "Venue ID"
+// @Success 200 {object} usecase.ListEventsOutputDto
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /venues/{venueId}/events [get]
+func (h *VenuesHandler) ListVenueEvents(w http.ResponseWriter, r *http.Request) {
+	input := usecase.ListVenueEventsInputDto{VenueID: r.PathValue("venueId")}
+
+	output, err := h.listVenueEventsUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), venueErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+func venueErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrVenueNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrVenueInUse):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrVenueNameRequired),
+		errors.Is(err, domain.ErrVenueCoordinatesInvalid),
+		errors.Is(err, domain.ErrVenueTimezoneInvalid),
+		errors.Is(err, domain.ErrVenueCapacityInvalid),
+		errors.Is(err, domain.ErrVenueLayoutExceedsCapacity),
+		errors.Is(err, domain.ErrSeatLayoutEmpty),
+		errors.Is(err, domain.ErrSeatSectionNameRequired),
+		errors.Is(err, domain.ErrSeatRowLabelInvalid),
+		errors.Is(err, domain.ErrSeatNumberInvalid),
+		errors.Is(err, domain.ErrSeatCoordinatesInvalid),
+		errors.Is(err, domain.ErrSeatAttributeBlank),
+		errors.Is(err, domain.ErrSeatDuplicated):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,111 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"go-backend-api/internal/events/domain"
+	"go-backend-api/internal/events/usecase"
+	"net/http"
+)
+
+// WaitlistHandler handles HTTP the waitlist requests
+type WaitlistHandler struct {
+	joinWaitlistUseCase     *usecase.JoinWaitlistUseCase
+	getWaitlistEntryUseCase *usecase.GetWaitlistEntryUseCase
+}
+
+// NewWaitlistHandler creates a new WaitlistHandler
+func NewWaitlistHandler(
+	joinWaitlistUseCase *usecase.JoinWaitlistUseCase,
+	getWaitlistEntryUseCase *usecase.GetWaitlistEntryUseCase,
+) *WaitlistHandler {
+	return &WaitlistHandler{
+		joinWaitlistUseCase:     joinWaitlistUseCase,
+		getWaitlistEntryUseCase: getWaitlistEntryUseCase,
+	}
+}
+
+// JoinWaitlist handles the request to join the waitlist of a sold out event.
+// @Summary Join the waitlist
+// @Description Join the waitlist of an event with an email and the desired quantity of tickets
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Param eventId path string true "Event ID"
+// @Param body body usecase.JoinWaitlistInputDto true "Waitlist data"
+// @Success 201 {object} usecase.WaitlistEntryDto
+// @Failure 400 {object} string
+// @Failure 404 {object} string
+// @Failure 409 {object} string
+// @Failure 500 {object} string
+// @Router /events/{eventId}/waitlist [post]
+func (h *WaitlistHandler) JoinWaitlist(w http.ResponseWriter, r *http.Request) {
+	var input usecase.JoinWaitlistInputDto
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	input.EventID = r.PathValue("eventId")
+
+	output, err := h.joinWaitlistUseCase.Execute(input)
+	if err != nil {
+		var rejection *domain.PurchaseRejection
+		if errors.As(err, &rejection) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			json.NewEncoder(w).Encode(ErrorResponse{Code: rejection.Code, Message: rejection.Error()})
+			return
+		}
+		http.Error(w, err.Error(), waitlistErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(output)
+}
+
+// GetWaitlistEntry handles the request to get a waitlist entry and its position.
+// @Summary Get a waitlist entry
+// @Description Get a waitlist entry with its position in line and any open offer
+// @Tags Events
+// @Produce json
+// @Param eventId path string true "Event ID"
+// @Param entryId path string true "Waitlist entry ID"
+// @Success 200 {object} usecase.WaitlistEntryDto
+// @Failure 404 {object} string
+// @Failure 500 {object} string
+// @Router /events/{eventId}/waitlist/{entryId} [get]
+func (h *WaitlistHandler) GetWaitlistEntry(w http.ResponseWriter, r *http.Request) {
+	input := usecase.GetWaitlistEntryInputDto{
+		EventID: r.PathValue("eventId"),
+		EntryID: r.PathValue("entryId"),
+	}
+
+	output, err := h.getWaitlistEntryUseCase.Execute(input)
+	if err != nil {
+		http.Error(w, err.Error(), waitlistErrorStatus(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(output)
+}
+
+func waitlistErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrEventNotFound),
+		errors.Is(err, domain.ErrWaitlistEntryNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrWaitlistAlreadyJoined),
+		errors.Is(err, domain.ErrWaitlistSpotsAvailable),
+		errors.Is(err, domain.ErrEventNotOnSale):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrWaitlistEmailInvalid),
+		errors.Is(err, domain.ErrWaitlistQuantityInvalid):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,221 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"go-backend-api/internal/events/domain"
+)
+
+type sqlCouponRepository struct {
+	db sqlDB
+}
+
+func NewMysqlCouponRepository(db *sql.DB) (domain.CouponRepository, error) {
+	return &sqlCouponRepository{db: mysqlDB{db}}, nil
+}
+
+const couponColumns = `id, code, discount_type, value, valid_from, valid_until,
+	max_uses, max_uses_per_email, used_count, event_ids, ticket_kinds`
+
+// couponSettingColumns are the columns an update of the coupon replaces
+const couponSettingColumns = `code, discount_type, value, valid_from, valid_until,
+	max_uses, max_uses_per_email, event_ids, ticket_kinds`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanCoupon(row rowScanner) (*domain.Coupon, error) {
+	var coupon domain.Coupon
+	var validFrom string
+	var validUntil sql.NullString
+	var eventIDs, ticketKinds string
+
+	err := row.Scan(&coupon.ID, &coupon.Code, &coupon.DiscountType, &coupon.Value,
+		&validFrom, &validUntil, &coupon.MaxUses, &coupon.MaxUsesPerEmail,
+		&coupon.UsedCount, &eventIDs, &ticketKinds,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrCouponNotFound
+		}
+		return nil, err
+	}
+
+	coupon.ValidFrom, err = parseMySQLDateTime(validFrom)
+	if err != nil {
+		return nil, err
+	}
+	if validUntil.Valid {
+		coupon.ValidUntil, err = parseMySQLDateTime(validUntil.String)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := json.Unmarshal([]byte(eventIDs), &coupon.EventIDs); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(ticketKinds), &coupon.TicketKinds); err != nil {
+		return nil, err
+	}
+	return &coupon, nil
+}
+
+// couponArgs returns the settings stored for a coupon, in couponSettingColumns
+// order. The usage counter is left out: only redemptions change it.
+func couponArgs(coupon *domain.Coupon) ([]any, error) {
+	eventIDs, err := json.Marshal(nonNilSlice(coupon.EventIDs))
+	if err != nil {
+		return nil, err
+	}
+	ticketKinds, err := json.Marshal(nonNilSlice(coupon.TicketKinds))
+	if err != nil {
+		return nil, err
+	}
+	var validUntil sql.NullString
+	if !coupon.ValidUntil.IsZero() {
+		validUntil = sql.NullString{String: coupon.ValidUntil.UTC().Format(mysqlDateTimeLayout), Valid: true}
+	}
+	return []any{
+		coupon.Code, coupon.DiscountType, coupon.Value,
+		coupon.ValidFrom.UTC().Format(mysqlDateTimeLayout), validUntil,
+		coupon.MaxUses, coupon.MaxUsesPerEmail,
+		string(eventIDs), string(ticketKinds),
+	}, nil
+}
+
+func nonNilSlice[T any](values []T) []T {
+	if values == nil {
+		return []T{}
+	}
+	return values
+}
+
+func (r *sqlCouponRepository) ListCoupons() ([]domain.Coupon, error) {
+	rows, err := r.db.Query(`SELECT ` + couponColumns + ` FROM coupons ORDER BY code`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	coupons := []domain.Coupon{}
+	for rows.Next() {
+		coupon, err := scanCoupon(rows)
+		if err != nil {
+			return nil, err
+		}
+		coupons = append(coupons, *coupon)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return coupons, nil
+}
+
+func (r *sqlCouponRepository) GetCouponByID(couponID string) (*domain.Coupon, error) {
+	row := r.db.QueryRow(`SELECT `+couponColumns+` FROM coupons WHERE id = ?`, couponID)
+	return scanCoupon(row)
+}
+
+func (r *sqlCouponRepository) GetCouponByCode(code string) (*domain.Coupon, error) {
+	row := r.db.QueryRow(`SELECT `+couponColumns+` FROM coupons WHERE code = ?`, domain.NormalizeCouponCode(code))
+	return scanCoupon(row)
+}
+
+func (r *sqlCouponRepository) CreateCoupon(coupon *domain.Coupon) error {
+	if _, err := r.GetCouponByCode(coupon.Code); err == nil {
+		return domain.ErrCouponAlreadyExists
+	} else if !errors.Is(err, domain.ErrCouponNotFound) {
+		return err
+	}
+
+	args, err := couponArgs(coupon)
+	if err != nil {
+		return err
+	}
+	query := `INSERT INTO coupons (id, used_count, ` + couponSettingColumns + `) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	_, err = r.db.Exec(query, append([]any{coupon.ID, coupon.UsedCount}, args...)...)
+	//a consulta acima não impede que outra requisição grave o mesmo código antes do INSERT
+	if r.db.isUniqueViolation(err) {
+		return domain.ErrCouponAlreadyExists
+	}
+	return err
+}
+
+func (r *sqlCouponRepository) UpdateCoupon(coupon *domain.Coupon) error {
+	args, err := couponArgs(coupon)
+	if err != nil {
+		return err
+	}
+	//o contador de usos fica de fora: só o resgate o altera, sob o bloqueio do cupom
+	query := `UPDATE coupons SET code = ?, discount_type = ?, value = ?, valid_from = ?, valid_until = ?,
+		max_uses = ?, max_uses_per_email = ?, event_ids = ?, ticket_kinds = ?
+		WHERE id = ?`
+	result, err := r.db.Exec(query, append(args, coupon.ID)...)
+	if r.db.isUniqueViolation(err) {
+		return domain.ErrCouponAlreadyExists
+	}
+	if err != nil {
+		return err
+	}
+	return requireAffected(result, domain.ErrCouponNotFound)
+}
+
+func (r *sqlCouponRepository) DeleteCoupon(couponID string) error {
+	result, err := r.db.Exec(`DELETE FROM coupons WHERE id = ?`, couponID)
+	if err != nil {
+		return err
+	}
+	return requireAffected(result, domain.ErrCouponNotFound)
+}
+
+// redeemCoupon locks the coupon row, so concurrent purchases are serialized
+// and the global and per email limits cannot be exceeded, and records the
+// redemption in the transaction of the purchase
+func redeemCoupon(tx sqlConn, redemption *domain.CouponRedemption) error {
+	var maxUses, maxUsesPerEmail, usedCount int
+	err := tx.QueryRow(`SELECT max_uses, max_uses_per_email, used_count FROM coupons WHERE id = ? FOR UPDATE`, redemption.CouponID).
+		Scan(&maxUses, &maxUsesPerEmail, &usedCount)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrCouponNotFound
+		}
+		return err
+	}
+	if maxUses > 0 && usedCount+redemption.Quantity > maxUses {
+		return domain.ErrCouponUsageLimitReached
+	}
+
+	if maxUsesPerEmail > 0 {
+		var usedByEmail int
+		err = tx.QueryRow(`SELECT COALESCE(SUM(quantity), 0) FROM coupon_redemptions WHERE coupon_id = ? AND email = ?`,
+			redemption.CouponID, redemption.Email).Scan(&usedByEmail)
+		if err != nil {
+			return err
+		}
+		if usedByEmail+redemption.Quantity > maxUsesPerEmail {
+			return domain.ErrCouponEmailLimitReached
+		}
+	}
+
+	_, err = tx.Exec(`INSERT INTO coupon_redemptions (id, coupon_id, email, quantity, created_at) VALUES (?, ?, ?, ?, ?)`,
+		redemption.ID, redemption.CouponID, redemption.Email, redemption.Quantity, redemption.CreatedAt.UTC().Format(mysqlDateTimeLayout))
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`UPDATE coupons SET used_count = used_count + ? WHERE id = ?`, redemption.Quantity, redemption.CouponID)
+	return err
+}
+
+func requireAffected(result sql.Result, notFound error) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return notFound
+	}
+	return nil
+}
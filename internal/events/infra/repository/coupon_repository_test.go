@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go-backend-api/internal/events/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testCouponRedemption checks that the coupon limits hold under concurrent
+// purchases and that updating the coupon keeps its usage counter
+func testCouponRedemption(t *testing.T, events domain.EventRepository, coupons domain.CouponRepository) {
+	event := createTestEvent(t, events, "A1", "A2")
+	coupon, err := domain.CreatedNewCoupon("ONCE"+strings.ToUpper(event.ID[:8]), domain.DiscountTypePercentage, 10,
+		time.Now().Add(-time.Hour), time.Time{}, 1, 0, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := coupons.CreateCoupon(coupon); err != nil {
+		t.Fatal(err)
+	}
+
+	//two buyers race for the last use of the coupon with different spots
+	errs := make([]error, 2)
+	var wg sync.WaitGroup
+	for i, email := range []string{"first@example.com", "second@example.com"} {
+		order, err := domain.CreatedNewOrder(event.ID, email, "card-"+email, "10.0.0.1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		ticket, err := domain.CreatedNewTicket(event, &event.Spots[i], domain.TicketKindFull)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ticket.ApplyCoupon(coupon)
+		redemption := domain.CreatedNewCouponRedemption(coupon, email, 1)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = events.SellTickets(order, []domain.Ticket{*ticket}, redemption, func(domain.TicketSales) error { return nil })
+		}()
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+		} else {
+			assert.ErrorIs(t, err, domain.ErrCouponUsageLimitReached)
+		}
+	}
+	assert.Equal(t, 1, succeeded)
+
+	//the refused purchase sold nothing
+	spots, err := events.FindAvailableSpotsByEventID(event.ID)
+	assert.Nil(t, err)
+	assert.Len(t, spots, 1)
+
+	//an update made from a copy read before the redemption keeps the counter
+	coupon.Value = 20
+	assert.Nil(t, coupons.UpdateCoupon(coupon))
+	stored, err := coupons.GetCouponByID(coupon.ID)
+	assert.Nil(t, err)
+	if assert.NotNil(t, stored) {
+		assert.Equal(t, 1, stored.UsedCount)
+		assert.Equal(t, 20.0, stored.Value)
+	}
+
+	missing := *coupon
+	missing.ID = "missing-coupon"
+	missing.Code = "MISSING" + strings.ToUpper(event.ID[:8])
+	assert.Equal(t, domain.ErrCouponNotFound, coupons.UpdateCoupon(&missing))
+}
+
+// TestMysqlCouponRedemption runs against a schema created in the server of
+// EVENTS_TEST_MYSQL_DSN
+func TestMysqlCouponRedemption(t *testing.T) {
+	db := openTestMySQL(t)
+	events, err := NewMysqlEventRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	coupons, err := NewMysqlCouponRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCouponRedemption(t, events, coupons)
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"strings"
+	"time"
+)
+
+// DatabaseConfig holds the DSN of the database and the limits of the
+// connection pool. Zero limits keep the defaults of database/sql.
+type DatabaseConfig struct {
+	DSN             string
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
+}
+
+// openDatabase opens the connection pool with the limits of the config
+func openDatabase(driver, dsn string, config DatabaseConfig) (*sql.DB, error) {
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if config.MaxOpenConns > 0 {
+		db.SetMaxOpenConns(config.MaxOpenConns)
+	}
+	if config.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(config.MaxIdleConns)
+	}
+	if config.ConnMaxLifetime > 0 {
+		db.SetConnMaxLifetime(config.ConnMaxLifetime)
+	}
+	if config.ConnMaxIdleTime > 0 {
+		db.SetConnMaxIdleTime(config.ConnMaxIdleTime)
+	}
+	return db, nil
+}
+
+// PingDatabase waits for the database to accept connections, trying up to
+// attempts times and doubling the wait after each failure up to 30 seconds.
+// It returns the last error when the database never answers.
+func PingDatabase(ctx context.Context, db *sql.DB, attempts int, wait time.Duration) error {
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if err = db.PingContext(ctx); err == nil {
+			return nil
+		}
+		if attempt == attempts {
+			break
+		}
+		log.Printf("Banco indisponível (tentativa %d de %d), nova tentativa em %s: %v\n", attempt, attempts, wait, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		wait = min(wait*2, 30*time.Second)
+	}
+	return err
+}
+
+// execer runs statements on the database or inside a transaction
+type execer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+}
+
+// queryer runs queries on the database or inside a transaction
+type queryer interface {
+	Query(query string, args ...any) (*sql.Rows, error)
+}
+
+// sqlConn runs the statements of the repositories
+type sqlConn interface {
+	execer
+	queryer
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+// sqlTx is a transaction of the repositories
+type sqlTx interface {
+	sqlConn
+	Commit() error
+	Rollback() error
+}
+
+// sqlDB is the database of the repositories. The queries are written with ?
+// placeholders and portable SQL; the few statements MySQL and PostgreSQL
+// write differently are built by the database.
+type sqlDB interface {
+	sqlConn
+	Begin() (sqlTx, error)
+	// upsertQuery returns the INSERT of the columns into table that, when a
+	// row with the same key exists, sets the update columns instead
+	upsertQuery(table, key string, columns, update []string) string
+	// insertIgnoreQuery returns the INSERT of the columns into table that
+	// keeps the row already stored with the same unique key
+	insertIgnoreQuery(table string, columns []string) string
+	// isUniqueViolation reports whether err is the error of a statement that
+	// repeated the value of a unique key
+	isUniqueViolation(err error) bool
+}
+
+// insertQuery returns the INSERT of the columns into table
+func insertQuery(table string, columns []string) string {
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ")
+	return `INSERT INTO ` + table + ` (` + strings.Join(columns, ", ") + `) VALUES (` + placeholders + `)`
+}
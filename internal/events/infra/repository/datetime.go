@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+)
+
+// mysqlDateTimeLayout is the format of DATETIME columns. They hold UTC
+// instants; the time zone of an event is kept in its own column.
+const mysqlDateTimeLayout = "2006-01-02 15:04:05"
+
+// mysqlZeroDateTime is what MySQL stores for a DATETIME it could not parse
+const mysqlZeroDateTime = "0000-00-00"
+
+func formatMySQLDateTime(t time.Time) string {
+	return t.UTC().Format(mysqlDateTimeLayout)
+}
+
+// parseMySQLDateTime reads a DATETIME column as a UTC instant, with or
+// without fractional seconds. With parseTime in the DSN the driver returns
+// time.Time values, which database/sql hands to string destinations in
+// RFC 3339. Zero dates, left by the old event date bug, are returned as the
+// zero time.
+func parseMySQLDateTime(value string) (time.Time, error) {
+	if strings.HasPrefix(value, mysqlZeroDateTime) {
+		return time.Time{}, nil
+	}
+	if len(value) > 10 && value[10] == 'T' {
+		t, err := time.Parse(time.RFC3339Nano, value)
+		return t.UTC(), err
+	}
+	return time.ParseInLocation(mysqlDateTimeLayout, value, time.UTC)
+}
+
+// nullMySQLDateTime formats an optional instant, NULL when t is nil
+func nullMySQLDateTime(t *time.Time) sql.NullString {
+	if t == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: formatMySQLDateTime(*t), Valid: true}
+}
+
+// parseNullMySQLDateTime reads a nullable DATETIME column, nil when NULL
+func parseNullMySQLDateTime(value sql.NullString) (*time.Time, error) {
+	if !value.Valid {
+		return nil, nil
+	}
+	t, err := parseMySQLDateTime(value.String)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
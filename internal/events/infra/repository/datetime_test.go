@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMySQLDateTime_RoundTrip(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	assert.Nil(t, err)
+	date := time.Date(2030, 7, 15, 21, 45, 10, 0, saoPaulo)
+
+	stored := formatMySQLDateTime(date)
+	assert.Equal(t, "2030-07-16 00:45:10", stored)
+
+	parsed, err := parseMySQLDateTime(stored)
+	assert.Nil(t, err)
+	assert.True(t, date.Equal(parsed))
+	assert.Equal(t, time.UTC, parsed.Location())
+}
+
+func TestMySQLDateTime_TruncatesSubseconds(t *testing.T) {
+	date := time.Date(2030, 7, 15, 21, 45, 10, 999, time.UTC)
+
+	parsed, err := parseMySQLDateTime(formatMySQLDateTime(date))
+	assert.Nil(t, err)
+	assert.Equal(t, date.Truncate(time.Second), parsed)
+}
+
+func TestParseMySQLDateTime_ZeroDate(t *testing.T) {
+	parsed, err := parseMySQLDateTime("0000-00-00 00:00:00")
+	assert.Nil(t, err)
+	assert.True(t, parsed.IsZero())
+
+	_, err = parseMySQLDateTime("15/07/2030")
+	assert.NotNil(t, err)
+}
+
+func TestParseMySQLDateTime_Formats(t *testing.T) {
+	want := time.Date(2030, 7, 16, 0, 45, 10, 123456000, time.UTC)
+
+	parsed, err := parseMySQLDateTime("2030-07-16 00:45:10.123456")
+	assert.Nil(t, err)
+	assert.Equal(t, want, parsed)
+
+	//with parseTime the driver returns time.Time, which database/sql converts to RFC 3339
+	parsed, err = parseMySQLDateTime(want.Format(time.RFC3339Nano))
+	assert.Nil(t, err)
+	assert.Equal(t, want, parsed)
+
+	parsed, err = parseMySQLDateTime(time.Time{}.Format(time.RFC3339Nano))
+	assert.Nil(t, err)
+	assert.True(t, parsed.IsZero())
+}
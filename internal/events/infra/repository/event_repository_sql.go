@@ -0,0 +1,880 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-backend-api/internal/events/domain"
+	"slices"
+	"strings"
+	"time"
+)
+
+// sqlEventRepository keeps the events in MySQL or PostgreSQL. The queries
+// are written with ? placeholders and portable SQL; the connection adapts
+// them to the database.
+type sqlEventRepository struct {
+	db sqlDB
+}
+
+func NewMysqlEventRepository(db *sql.DB) (domain.EventRepository, error) {
+	return &sqlEventRepository{db: mysqlDB{db}}, nil
+}
+
+// NewPostgresEventRepository keeps the events in the tables of
+// postgres-init/migrations
+func NewPostgresEventRepository(db *sql.DB) (domain.EventRepository, error) {
+	return &sqlEventRepository{db: postgresDB{db: db}}, nil
+}
+
+// ListEvents leaves out the archived events, which are still found by ID
+func (r *sqlEventRepository) ListEvents() ([]domain.Event, error) {
+	return r.listEvents(`WHERE e.archived_at IS NULL`)
+}
+
+func (r *sqlEventRepository) ListEventsByVenueID(venueID string) ([]domain.Event, error) {
+	return r.listEvents(`WHERE e.venue_id = ?`, venueID)
+}
+
+func (r *sqlEventRepository) FindEventsNearby(center domain.GeoPoint, radiusKm float64, limit int) ([]domain.NearbyEvent, error) {
+	//a caixa delimitadora usa o índice de coordenadas; a distância exata é calculada com haversine
+	southWest, northEast := center.BoundingBox(radiusKm)
+	//o raio vem por último: no PostgreSQL, 2 * ? tornaria o parâmetro um inteiro
+	query := `
+		SELECT id, distance_km FROM (
+			SELECT id, date, 2 * ASIN(LEAST(1, SQRT(
+				POWER(SIN(RADIANS(latitude - ?) / 2), 2) +
+				COS(RADIANS(?)) * COS(RADIANS(latitude)) * POWER(SIN(RADIANS(longitude - ?) / 2), 2)
+			))) * ? AS distance_km
+			FROM events
+			WHERE latitude BETWEEN ? AND ? AND longitude BETWEEN ? AND ? AND archived_at IS NULL
+		) nearby
+		WHERE distance_km <= ?
+		ORDER BY distance_km, date, id
+		LIMIT ?
+	`
+	rows, err := r.db.Query(query, center.Latitude, center.Latitude, center.Longitude, domain.EarthRadiusKm,
+		southWest.Latitude, northEast.Latitude, southWest.Longitude, northEast.Longitude, radiusKm, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []any{}
+	distances := map[string]float64{}
+	for rows.Next() {
+		var id string
+		var distance float64
+		if err := rows.Scan(&id, &distance); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+		distances[id] = distance
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []domain.NearbyEvent{}, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", ")
+	events, err := r.listEvents(`WHERE e.id IN (`+placeholders+`)`, ids...)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]domain.Event, len(events))
+	for _, event := range events {
+		byID[event.ID] = event
+	}
+
+	nearby := make([]domain.NearbyEvent, 0, len(ids))
+	for _, id := range ids {
+		if event, ok := byID[id.(string)]; ok {
+			nearby = append(nearby, domain.NearbyEvent{Event: event, DistanceKm: distances[id.(string)]})
+		}
+	}
+	return nearby, nil
+}
+
+func (r *sqlEventRepository) ListEventsBySeriesID(seriesID string) ([]domain.Event, error) {
+	events, err := r.listEvents(`WHERE e.series_id = ?`, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(events, func(a, b domain.Event) int {
+		return a.Date.Compare(b.Date)
+	})
+	return events, nil
+}
+
+// eventColumns are the columns of the events read by scanEvent
+const eventColumns = `e.id, e.name, e.location, e.organization, e.rating, e.date, e.image_url,
+	e.capacity, e.price, e.partner_id, e.max_tickets_per_buyer, e.venue_id, e.timezone,
+	e.series_id, e.series_overrides, e.latitude, e.longitude, e.status, e.publish_at,
+	e.on_sale_at, e.archived_at, e.transfer_deadline_hours`
+
+// scanEvent reads the columns of eventColumns followed by the extra
+// destinations, such as the joined spot and ticket of the row
+func scanEvent(row rowScanner, extra ...any) (*domain.Event, error) {
+	event := domain.Event{Spots: []domain.Spot{}, Tickets: []domain.Ticket{}}
+	var date, timezone, seriesOverrides, status string
+	var venueID, seriesID, publishAt, onSaleAt, archivedAt sql.NullString
+	var latitude, longitude sql.NullFloat64
+	dest := []any{&event.ID, &event.Name, &event.Location, &event.Organization, &event.Rating, &date, &event.ImageURL,
+		&event.Capacity, &event.Price, &event.PartnerID, &event.MaxTicketsPerBuyer, &venueID, &timezone,
+		&seriesID, &seriesOverrides, &latitude, &longitude, &status, &publishAt,
+		&onSaleAt, &archivedAt, &event.TransferDeadlineHours}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if event.Date, err = parseMySQLDateTime(date); err != nil {
+		return nil, err
+	}
+	event.VenueID = venueID.String
+	event.Timezone = timezone
+	event.SeriesID = seriesID.String
+	event.Coordinates = scanGeoPoint(latitude, longitude)
+	if err := scanSeriesOverrides(&event, seriesOverrides); err != nil {
+		return nil, err
+	}
+	if err := scanEventLifecycle(&event, status, publishAt, onSaleAt, archivedAt); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// listEvents loads the events matching the filter with their spots and
+// tickets, in the order of their first row
+func (r *sqlEventRepository) listEvents(filter string, args ...any) ([]domain.Event, error) {
+	query := `SELECT ` + eventColumns + `,
+	 s.id, s.event_id, s.name, s.status, s.ticket_id,
+	 t.id, t.event_id, t.spot_id, t.ticket_kind, t.price
+	 FROM events e
+	 LEFT JOIN spots s ON e.id = s.event_id
+	 LEFT JOIN tickets t ON s.id = t.spot_id ` + filter
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := []*domain.Event{}
+	eventMap := make(map[string]*domain.Event)
+	spotMap := make(map[string]*domain.Spot)
+	for rows.Next() {
+		var spotID, spotEventID, spotName, spotStatus, spotTicketID, ticketID, ticketEventID, ticketSpotID, ticketKind sql.NullString
+		var ticketPrice sql.NullFloat64
+		row, err := scanEvent(rows, &spotID, &spotEventID, &spotName, &spotStatus, &spotTicketID,
+			&ticketID, &ticketEventID, &ticketSpotID, &ticketKind, &ticketPrice)
+		if err != nil {
+			return nil, err
+		}
+
+		//cada linha repete o evento, que só é lido na primeira
+		event, exists := eventMap[row.ID]
+		if !exists {
+			event = row
+			eventMap[event.ID] = event
+			events = append(events, event)
+		}
+
+		if spotID.Valid {
+			spot, exists := spotMap[spotID.String]
+			if !exists {
+				spot = &domain.Spot{
+					ID: spotID.String,
+					EventID: spotEventID.String,
+					Name: spotName.String,
+					SpotStatus: domain.SpotStatus(spotStatus.String),
+					TicketID: spotTicketID.String,
+				}
+				event.Spots = append(event.Spots, *spot)
+				spotMap[spotID.String] = spot
+			}
+
+			if ticketID.Valid {
+				ticket := domain.Ticket{
+					ID: ticketID.String,
+					EventID: ticketEventID.String,
+					Spot: spot,
+					TicketKind: domain.TicketStatus(ticketKind.String),
+					Price: ticketPrice.Float64,
+				}
+				event.Tickets = append(event.Tickets, ticket)
+			}
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	list := make([]domain.Event, len(events))
+	for i, event := range events {
+		list[i] = *event
+	}
+	return list, nil
+}
+
+func (r *sqlEventRepository) GetEventByID(eventID string) (*domain.Event, error) {
+	events, err := r.listEvents(`WHERE e.id = ?`, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return nil, domain.ErrEventNotFound
+	}
+	event := &events[0]
+
+	event.TicketCategories, err = r.FindTicketCategoriesByEventID(event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func (r *sqlEventRepository) CreateEvent(event *domain.Event) error {
+	return insertEvent(r.db, event)
+}
+
+func insertEvent(db execer, event *domain.Event) error {
+	overrides, err := json.Marshal(nonNilSlice(event.SeriesOverrides))
+	if err != nil {
+		return err
+	}
+	query := `
+	INSERT INTO events (id, name, location, organization, rating, date, timezone, image_url, capacity, price, partner_id, max_tickets_per_buyer, venue_id, series_id, series_overrides,
+		status, publish_at, on_sale_at, transfer_deadline_hours, latitude, longitude, search_name, search_location, search_organization) 
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	args := []any{event.ID, event.Name, event.Location, event.Organization, event.Rating, formatMySQLDateTime(event.Date), event.Timezone, event.ImageURL, event.Capacity, event.Price, event.PartnerID, event.MaxTicketsPerBuyer,
+		sql.NullString{String: event.VenueID, Valid: event.VenueID != ""}, sql.NullString{String: event.SeriesID, Valid: event.SeriesID != ""}, string(overrides),
+		event.Status, nullMySQLDateTime(event.PublishAt), nullMySQLDateTime(event.OnSaleAt), event.TransferDeadlineHours}
+	args = append(args, geoPointArgs(event.Coordinates)...)
+	_, err = db.Exec(query, append(args, eventSearchArgs(event)...)...)
+	return err
+}
+
+// geoPointArgs returns the latitude and longitude columns, NULL when the point is unknown
+func geoPointArgs(point *domain.GeoPoint) []any {
+	if point == nil {
+		return []any{sql.NullFloat64{}, sql.NullFloat64{}}
+	}
+	return []any{point.Latitude, point.Longitude}
+}
+
+func scanGeoPoint(latitude, longitude sql.NullFloat64) *domain.GeoPoint {
+	if !latitude.Valid || !longitude.Valid {
+		return nil
+	}
+	return &domain.GeoPoint{Latitude: latitude.Float64, Longitude: longitude.Float64}
+}
+
+func scanSeriesOverrides(event *domain.Event, overrides string) error {
+	event.SeriesOverrides = []string{}
+	if overrides == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(overrides), &event.SeriesOverrides)
+}
+
+func scanEventLifecycle(event *domain.Event, status string, publishAt, onSaleAt, archivedAt sql.NullString) error {
+	var err error
+	event.Status = domain.EventStatus(status)
+	if event.PublishAt, err = parseNullMySQLDateTime(publishAt); err != nil {
+		return err
+	}
+	if event.OnSaleAt, err = parseNullMySQLDateTime(onSaleAt); err != nil {
+		return err
+	}
+	event.ArchivedAt, err = parseNullMySQLDateTime(archivedAt)
+	return err
+}
+
+func (r *sqlEventRepository) UpdateEventLifecycle(event *domain.Event, previous domain.EventStatus) error {
+	query := `UPDATE events SET status = ?, publish_at = ?, on_sale_at = ? WHERE id = ? AND status = ?`
+	result, err := r.db.Exec(query, event.Status, nullMySQLDateTime(event.PublishAt), nullMySQLDateTime(event.OnSaleAt), event.ID, previous)
+	if err != nil {
+		return err
+	}
+	if err := requireAffected(result, domain.ErrEventStatusConflict); err != nil {
+		//distinguindo o evento inexistente do evento que mudou de status
+		if _, getErr := r.GetEventByID(event.ID); getErr != nil {
+			return getErr
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *sqlEventRepository) FindEventsDueForLifecycle(now time.Time) ([]domain.Event, error) {
+	at := formatMySQLDateTime(now)
+	return r.listEvents(`WHERE e.status NOT IN (?, ?) AND (e.date <= ?
+		OR (e.status = ? AND (e.publish_at <= ? OR e.on_sale_at <= ?))
+		OR (e.status = ? AND e.on_sale_at <= ?))`,
+		domain.EventStatusFinished, domain.EventStatusCancelled, at,
+		domain.EventStatusDraft, at, at,
+		domain.EventStatusPublished, at)
+}
+
+func (r *sqlEventRepository) FindEventsStartingBetween(from, to time.Time) ([]domain.Event, error) {
+	return r.listEvents(`WHERE e.status IN (?, ?, ?) AND e.date > ? AND e.date <= ?`,
+		domain.EventStatusPublished, domain.EventStatusOnSale, domain.EventStatusSoldOut,
+		formatMySQLDateTime(from), formatMySQLDateTime(to))
+}
+
+func (r *sqlEventRepository) FindEventsToArchive(before time.Time) ([]domain.Event, error) {
+	return r.listEvents(`WHERE e.status IN (?, ?) AND e.archived_at IS NULL AND e.date < ?`,
+		domain.EventStatusFinished, domain.EventStatusCancelled, formatMySQLDateTime(before))
+}
+
+func (r *sqlEventRepository) ArchiveEvent(event *domain.Event) error {
+	query := `UPDATE events SET archived_at = ? WHERE id = ?`
+	result, err := r.db.Exec(query, nullMySQLDateTime(event.ArchivedAt), event.ID)
+	if err != nil {
+		return err
+	}
+	return requireAffected(result, domain.ErrEventNotFound)
+}
+
+func (r *sqlEventRepository) ReserveSpot(spotID, ticketID string) error {
+	query := `UPDATE spots SET status = ?, ticket_id = ? WHERE id = ?`
+	result, err := r.db.Exec(query, domain.SpotStatusReserved, ticketID, spotID)
+	if err != nil {
+		return err
+	}
+	return requireAffected(result, domain.ErrorSpotNotFound)
+}
+
+func (r *sqlEventRepository) CreateSpot(spot *domain.Spot) error {
+	return insertSpots(r.db, []domain.Spot{*spot})
+}
+
+func (r *sqlEventRepository) CreateSpots(eventID string, spots []domain.Spot) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	//bloqueando o evento para que criações concorrentes não repitam nomes nem passem da capacidade
+	event := domain.Event{ID: eventID}
+	err = tx.QueryRow(`SELECT capacity FROM events WHERE id = ? FOR UPDATE`, eventID).Scan(&event.Capacity)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrEventNotFound
+		}
+		return err
+	}
+
+	event.Spots, err = findSpots(tx, `SELECT `+spotColumns+` FROM spots WHERE event_id = ?`, eventID)
+	if err != nil {
+		return err
+	}
+	if err := event.CheckSpotCapacity(len(spots)); err != nil {
+		return err
+	}
+	existing := make(map[string]bool, len(event.Spots))
+	for _, spot := range event.Spots {
+		existing[spot.Name] = true
+	}
+	for _, spot := range spots {
+		if existing[spot.Name] {
+			return fmt.Errorf("%w: %s", domain.ErrSpotNameAlreadyExists, spot.Name)
+		}
+	}
+
+	if err := insertSpots(tx, spots); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// spotInsertBatchSize is how many spots each INSERT statement writes, well
+// below the limit of 65535 placeholders of a prepared statement
+const spotInsertBatchSize = 500
+
+// insertSpots writes the spots with multi-row INSERT statements
+func insertSpots(db execer, spots []domain.Spot) error {
+	const columns = `id, event_id, name, status, ticket_id, section, seat_row, seat_number, x, y, accessible, attributes`
+	for start := 0; start < len(spots); start += spotInsertBatchSize {
+		batch := spots[start:min(start+spotInsertBatchSize, len(spots))]
+		values := make([]string, len(batch))
+		args := make([]any, 0, len(batch)*12)
+		for i, spot := range batch {
+			attributes, err := json.Marshal(nonNilSlice(spot.Attributes))
+			if err != nil {
+				return err
+			}
+			values[i] = `(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+			args = append(args, spot.ID, spot.EventID, spot.Name, spot.SpotStatus, sql.NullString{String: spot.TicketID, Valid: spot.TicketID != ""},
+				spot.Section, spot.Row, spot.Number, spot.X, spot.Y, spot.Accessible, string(attributes))
+		}
+		query := `INSERT INTO spots (` + columns + `) VALUES ` + strings.Join(values, ", ")
+		if _, err := db.Exec(query, args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *sqlEventRepository) CreateTicket(ticket *domain.Ticket) error {
+	query := `INSERT INTO tickets (id, event_id, spot_id, ticket_kind, price, coupon_id, discount, attendee_name, attendee_document, holder_email, credential_version) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	_, err := r.db.Exec(query, ticket.ID, ticket.EventID, ticket.Spot.ID, ticket.TicketKind, ticket.Price, sql.NullString{String: ticket.CouponID, Valid: ticket.CouponID != ""}, ticket.Discount,
+		ticket.AttendeeName, ticket.AttendeeDocument, ticket.HolderEmail, ticket.CredentialVersion)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// spotColumns are the columns read by scanSpot
+const spotColumns = `id, event_id, name, status, ticket_id, held_by, hold_expires_at, blocked_reason, section, seat_row, seat_number, x, y, accessible, attributes`
+
+func scanSpot(row rowScanner) (*domain.Spot, error) {
+	var spot domain.Spot
+	var ticketID, heldBy, holdExpiresAt, blockedReason, attributes sql.NullString
+	err := row.Scan(&spot.ID, &spot.EventID, &spot.Name, &spot.SpotStatus, &ticketID, &heldBy, &holdExpiresAt, &blockedReason,
+		&spot.Section, &spot.Row, &spot.Number, &spot.X, &spot.Y, &spot.Accessible, &attributes)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrorSpotNotFound
+		}
+		return nil, err
+	}
+	spot.TicketID = ticketID.String
+	spot.BlockedReason = blockedReason.String
+	if err := scanSpotHold(&spot, heldBy, holdExpiresAt); err != nil {
+		return nil, err
+	}
+	if err := scanSpotAttributes(&spot, attributes); err != nil {
+		return nil, err
+	}
+	return &spot, nil
+}
+
+// findSpots runs a query selecting spotColumns
+func findSpots(db queryer, query string, args ...any) ([]domain.Spot, error) {
+	rows, err := db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	spots := []domain.Spot{}
+	for rows.Next() {
+		spot, err := scanSpot(rows)
+		if err != nil {
+			return nil, err
+		}
+		spots = append(spots, *spot)
+	}
+	return spots, rows.Err()
+}
+
+func (r *sqlEventRepository) FindSpotsEventID(eventID string) ([]domain.Spot, error) {
+	return findSpots(r.db, `SELECT `+spotColumns+` FROM spots WHERE event_id = ? ORDER BY name`, eventID)
+}
+
+func (r *sqlEventRepository) FindSpotById(spotID string) (*domain.Spot, error) {
+	row := r.db.QueryRow(`SELECT `+spotColumns+` FROM spots WHERE id = ?`, spotID)
+	return scanSpot(row)
+}
+
+func (r *sqlEventRepository) FindSpotByName(eventID, spotName string) (*domain.Spot, error) {
+	row := r.db.QueryRow(`SELECT `+spotColumns+` FROM spots WHERE event_id = ? AND name = ?`, eventID, spotName)
+	return scanSpot(row)
+}
+
+func (r *sqlEventRepository) CreateTicketCategory(category *domain.TicketCategory) error {
+	return insertTicketCategory(r.db, category)
+}
+
+func insertTicketCategory(db execer, category *domain.TicketCategory) error {
+	requirements, err := json.Marshal(nonNilSlice(category.Requirements))
+	if err != nil {
+		return err
+	}
+	query := `
+	INSERT INTO ticket_categories (id, event_id, kind, name, price_multiplier, requirements, quota, quota_percent, partner_ticket_kind)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+	_, err = db.Exec(query, category.ID, category.EventID, category.Kind, category.Name, category.PriceMultiplier, string(requirements), category.Quota, category.QuotaPercent, category.PartnerTicketKind)
+	return err
+}
+
+func (r *sqlEventRepository) FindTicketCategoriesByEventID(eventID string) ([]domain.TicketCategory, error) {
+	query := `
+		SELECT id, event_id, kind, name, price_multiplier, requirements, quota, quota_percent, partner_ticket_kind
+		FROM ticket_categories
+		WHERE event_id = ?
+		ORDER BY price_multiplier DESC, kind
+	`
+	rows, err := r.db.Query(query, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	categories := []domain.TicketCategory{}
+	for rows.Next() {
+		var category domain.TicketCategory
+		var requirements string
+		err := rows.Scan(&category.ID, &category.EventID, &category.Kind, &category.Name, &category.PriceMultiplier, &requirements, &category.Quota, &category.QuotaPercent, &category.PartnerTicketKind)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(requirements), &category.Requirements); err != nil {
+			return nil, err
+		}
+		categories = append(categories, category)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func countTicketSales(q queryer, eventID, email string) (domain.TicketSales, error) {
+	sales := domain.TicketSales{ByKind: map[domain.TicketKind]int{}}
+	//o PostgreSQL não soma booleanos, por isso o CASE
+	query := `
+		SELECT t.ticket_kind, COUNT(*), COALESCE(SUM(CASE WHEN o.email = ? THEN 1 ELSE 0 END), 0)
+		FROM tickets t
+		LEFT JOIN orders o ON o.id = t.order_id
+		WHERE t.event_id = ?
+		GROUP BY t.ticket_kind
+	`
+	rows, err := q.Query(query, domain.NormalizeEmail(email), eventID)
+	if err != nil {
+		return sales, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var kind domain.TicketKind
+		var count, byBuyer int
+		if err := rows.Scan(&kind, &count, &byBuyer); err != nil {
+			return sales, err
+		}
+		sales.ByKind[kind] = count
+		sales.Total += count
+		sales.ByBuyer += byBuyer
+	}
+
+	return sales, rows.Err()
+}
+
+func (r *sqlEventRepository) CountTicketSales(eventID, email string) (domain.TicketSales, error) {
+	return countTicketSales(r.db, eventID, email)
+}
+
+func (r *sqlEventRepository) SellTickets(order *domain.Order, tickets []domain.Ticket, redemption *domain.CouponRedemption, check func(sales domain.TicketSales) error) error {
+	eventID := order.EventID
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	//bloqueando o evento para serializar as vendas concorrentes
+	var lockedID string
+	err = tx.QueryRow(`SELECT id FROM events WHERE id = ? FOR UPDATE`, eventID).Scan(&lockedID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrEventNotFound
+		}
+		return err
+	}
+
+	sales, err := countTicketSales(tx, eventID, order.Email)
+	if err != nil {
+		return err
+	}
+	if err := check(sales); err != nil {
+		return err
+	}
+	if redemption != nil {
+		if err := redeemCoupon(tx, redemption); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec(`INSERT INTO orders (id, event_id, email, card_hash, ip_address, created_at) VALUES (?, ?, ?, ?, ?, ?)`,
+		order.ID, order.EventID, order.Email, order.CardHash, order.IPAddress, order.CreatedAt.UTC().Format(mysqlDateTimeLayout))
+	if err != nil {
+		return err
+	}
+
+	for _, ticket := range tickets {
+		_, err := tx.Exec(`INSERT INTO tickets (id, event_id, order_id, spot_id, ticket_kind, price, coupon_id, discount, attendee_name, attendee_document, holder_email, credential_version) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			ticket.ID, ticket.EventID, order.ID, ticket.Spot.ID, ticket.TicketKind, ticket.Price, sql.NullString{String: ticket.CouponID, Valid: ticket.CouponID != ""}, ticket.Discount,
+			ticket.AttendeeName, ticket.AttendeeDocument, ticket.HolderEmail, ticket.CredentialVersion)
+		if err != nil {
+			return err
+		}
+		//o lugar precisa estar disponível ou reservado para este comprador
+		result, err := tx.Exec(`UPDATE spots SET status = ?, ticket_id = ?, held_by = NULL, hold_expires_at = NULL
+			WHERE id = ? AND (status = ? OR (status = ? AND (held_by = ? OR hold_expires_at <= ?)))`,
+			domain.SpotStatusReserved, ticket.ID, ticket.Spot.ID,
+			domain.SpotStatusAvailable, domain.SpotStatusHeld, order.Email, order.CreatedAt.UTC().Format(mysqlDateTimeLayout))
+		if err != nil {
+			return err
+		}
+		if err := requireAffected(result, domain.ErrSpotNotAvailable); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *sqlEventRepository) FindBuyerEmailsByCardHash(cardHash string, since time.Time) ([]string, error) {
+	if cardHash == "" {
+		return nil, nil
+	}
+	return r.findDistinctEmails(`SELECT DISTINCT email FROM orders WHERE card_hash = ? AND created_at >= ?`, cardHash, since)
+}
+
+func (r *sqlEventRepository) FindBuyerEmailsByIP(ipAddress string, since time.Time) ([]string, error) {
+	if ipAddress == "" {
+		return nil, nil
+	}
+	return r.findDistinctEmails(`SELECT DISTINCT email FROM orders WHERE ip_address = ? AND created_at >= ?`, ipAddress, since)
+}
+
+func (r *sqlEventRepository) findDistinctEmails(query, key string, since time.Time) ([]string, error) {
+	rows, err := r.db.Query(query, key, since.UTC().Format(mysqlDateTimeLayout))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var emails []string
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, err
+		}
+		emails = append(emails, email)
+	}
+
+	return emails, rows.Err()
+}
+
+func scanSpotHold(spot *domain.Spot, heldBy, holdExpiresAt sql.NullString) error {
+	spot.HeldBy = heldBy.String
+	if !holdExpiresAt.Valid {
+		return nil
+	}
+	expiresAt, err := parseMySQLDateTime(holdExpiresAt.String)
+	if err != nil {
+		return err
+	}
+	spot.HoldExpiresAt = expiresAt
+	return nil
+}
+
+func scanSpotAttributes(spot *domain.Spot, attributes sql.NullString) error {
+	if !attributes.Valid {
+		return nil
+	}
+	return json.Unmarshal([]byte(attributes.String), &spot.Attributes)
+}
+
+func (r *sqlEventRepository) FindAvailableSpotsByEventID(eventID string) ([]domain.Spot, error) {
+	query := `
+		SELECT id, event_id, name, status, section, seat_row, seat_number, x, y, accessible, attributes
+		FROM spots
+		WHERE event_id = ? AND status = ?
+		ORDER BY name
+	`
+	rows, err := r.db.Query(query, eventID, domain.SpotStatusAvailable)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	spots := []domain.Spot{}
+	for rows.Next() {
+		var spot domain.Spot
+		var attributes sql.NullString
+		err := rows.Scan(&spot.ID, &spot.EventID, &spot.Name, &spot.SpotStatus,
+			&spot.Section, &spot.Row, &spot.Number, &spot.X, &spot.Y, &spot.Accessible, &attributes)
+		if err != nil {
+			return nil, err
+		}
+		if err := scanSpotAttributes(&spot, attributes); err != nil {
+			return nil, err
+		}
+		spots = append(spots, spot)
+	}
+
+	return spots, rows.Err()
+}
+
+func (r *sqlEventRepository) HoldSpots(spotIDs []string, holder string, until time.Time) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	//travando os lugares sempre na mesma ordem evita deadlock entre reservas concorrentes
+	spotIDs = slices.Clone(spotIDs)
+	slices.Sort(spotIDs)
+	for _, spotID := range spotIDs {
+		result, err := tx.Exec(`UPDATE spots SET status = ?, held_by = ?, hold_expires_at = ? WHERE id = ? AND status = ?`,
+			domain.SpotStatusHeld, domain.NormalizeEmail(holder), until.UTC().Format(mysqlDateTimeLayout), spotID, domain.SpotStatusAvailable)
+		if err != nil {
+			return err
+		}
+		if err := requireAffected(result, domain.ErrSpotNotAvailable); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *sqlEventRepository) ReleaseExpiredHolds(now time.Time) ([]domain.Spot, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.Query(`SELECT id, event_id, name FROM spots WHERE status = ? AND hold_expires_at <= ? FOR UPDATE`,
+		domain.SpotStatusHeld, now.UTC().Format(mysqlDateTimeLayout))
+	if err != nil {
+		return nil, err
+	}
+	released := []domain.Spot{}
+	for rows.Next() {
+		spot := domain.Spot{SpotStatus: domain.SpotStatusAvailable}
+		if err := rows.Scan(&spot.ID, &spot.EventID, &spot.Name); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		released = append(released, spot)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, spot := range released {
+		_, err := tx.Exec(`UPDATE spots SET status = ?, held_by = NULL, hold_expires_at = NULL WHERE id = ?`, domain.SpotStatusAvailable, spot.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return released, tx.Commit()
+}
+
+func (r *sqlEventRepository) ChangeSpots(eventID string, change func(spots []domain.Spot) ([]domain.SpotChange, error)) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	//bloqueando o evento para que a mudança não concorra com vendas e reservas
+	var lockedID string
+	err = tx.QueryRow(`SELECT id FROM events WHERE id = ? FOR UPDATE`, eventID).Scan(&lockedID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrEventNotFound
+		}
+		return err
+	}
+
+	spots, err := findSpots(tx, `SELECT `+spotColumns+` FROM spots WHERE event_id = ? ORDER BY name FOR UPDATE`, eventID)
+	if err != nil {
+		return err
+	}
+	changes, err := change(spots)
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[string]domain.Spot, len(spots))
+	for _, spot := range spots {
+		byID[spot.ID] = spot
+	}
+	//nomes provisórios, que nunca começam por letra, deixam dois lugares trocarem de nome sem violar o índice único
+	for i, entry := range changes {
+		var err error
+		switch entry.Action {
+		case domain.SpotChangeDeleted:
+			_, err = tx.Exec(`DELETE FROM spots WHERE id = ?`, entry.SpotID)
+		case domain.SpotChangeRenamed:
+			_, err = tx.Exec(`UPDATE spots SET name = ? WHERE id = ?`, fmt.Sprintf("~%d", i), entry.SpotID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	updated := map[string]bool{}
+	for _, entry := range changes {
+		if entry.Action == domain.SpotChangeDeleted || updated[entry.SpotID] {
+			continue
+		}
+		spot := byID[entry.SpotID]
+		_, err := tx.Exec(`UPDATE spots SET name = ?, status = ?, blocked_reason = ? WHERE id = ?`,
+			spot.Name, spot.SpotStatus, sql.NullString{String: spot.BlockedReason, Valid: spot.BlockedReason != ""}, spot.ID)
+		if err != nil {
+			return err
+		}
+		updated[spot.ID] = true
+	}
+
+	for _, entry := range changes {
+		_, err := tx.Exec(`INSERT INTO spot_changes (id, event_id, spot_id, spot_name, action, previous_name, reason, actor, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			entry.ID, entry.EventID, entry.SpotID, entry.SpotName, entry.Action, entry.PreviousName, entry.Reason, entry.Actor,
+			entry.CreatedAt.UTC().Format(mysqlDateTimeMicroLayout))
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *sqlEventRepository) FindSpotChangesByEventID(eventID string) ([]domain.SpotChange, error) {
+	query := `
+		SELECT id, event_id, spot_id, spot_name, action, previous_name, reason, actor, created_at
+		FROM spot_changes
+		WHERE event_id = ?
+		ORDER BY created_at, id
+	`
+	rows, err := r.db.Query(query, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := []domain.SpotChange{}
+	for rows.Next() {
+		var change domain.SpotChange
+		var createdAt string
+		err := rows.Scan(&change.ID, &change.EventID, &change.SpotID, &change.SpotName, &change.Action,
+			&change.PreviousName, &change.Reason, &change.Actor, &createdAt)
+		if err != nil {
+			return nil, err
+		}
+		if change.CreatedAt, err = parseMySQLDateTime(createdAt); err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, rows.Err()
+}
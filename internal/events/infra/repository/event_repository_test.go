@@ -0,0 +1,288 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"go-backend-api/internal/events/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// createTestEvent stores a new event with the named spots
+func createTestEvent(t *testing.T, repo domain.EventRepository, names ...string) *domain.Event {
+	t.Helper()
+	event, err := domain.CreatedNewEvent("Event Test", "Location Test", "Organization Test", domain.RatingFree, time.Now().Add(24*time.Hour), "image_url", 100, 50.00, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.CreateEvent(event); err != nil {
+		t.Fatal(err)
+	}
+	if len(names) > 0 {
+		if err := domain.NewSpotService().GenerateNamedSpots(event, names); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.CreateSpots(event.ID, event.Spots); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return event
+}
+
+func spotNames(spots []domain.Spot) []string {
+	names := []string{}
+	for _, spot := range spots {
+		names = append(names, spot.Name)
+	}
+	return names
+}
+
+// testEventRepository runs the same checks against every EventRepository
+func testEventRepository(t *testing.T, repo domain.EventRepository) {
+	t.Run("spots without ticket", func(t *testing.T) {
+		event := createTestEvent(t, repo, "A1", "A2")
+
+		spots, err := repo.FindSpotsEventID(event.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"A1", "A2"}, spotNames(spots))
+		for _, spot := range spots {
+			assert.Equal(t, domain.SpotStatusAvailable, spot.SpotStatus)
+			assert.Empty(t, spot.TicketID)
+			assert.Equal(t, []string{}, spot.Attributes)
+		}
+
+		stored, err := repo.GetEventByID(event.ID)
+		assert.Nil(t, err)
+		assert.Len(t, stored.Spots, 2)
+	})
+
+	t.Run("spot by name", func(t *testing.T) {
+		event := createTestEvent(t, repo, "A1", "A2")
+
+		spot, err := repo.FindSpotByName(event.ID, "A2")
+		assert.Nil(t, err)
+		if assert.NotNil(t, spot) {
+			assert.Equal(t, event.Spots[1].ID, spot.ID)
+			assert.Equal(t, event.ID, spot.EventID)
+			assert.Empty(t, spot.TicketID)
+		}
+
+		spot, err = repo.FindSpotByName(event.ID, "B1")
+		assert.Equal(t, domain.ErrorSpotNotFound, err)
+		assert.Nil(t, spot)
+	})
+
+	t.Run("create spot", func(t *testing.T) {
+		event := createTestEvent(t, repo)
+		spot, err := domain.CreatedNewSpot(*event, "C3")
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Nil(t, repo.CreateSpot(spot))
+
+		stored, err := repo.FindSpotByName(event.ID, "C3")
+		assert.Nil(t, err)
+		if assert.NotNil(t, stored) {
+			assert.Equal(t, spot.ID, stored.ID)
+			assert.Equal(t, domain.SpotStatusAvailable, stored.SpotStatus)
+		}
+	})
+
+	t.Run("reserve spot", func(t *testing.T) {
+		event := createTestEvent(t, repo, "A1", "A2")
+
+		assert.Nil(t, repo.ReserveSpot(event.Spots[0].ID, "ticket-1"))
+		spots, err := repo.FindSpotsEventID(event.ID)
+		assert.Nil(t, err)
+		if assert.Len(t, spots, 2) {
+			assert.Equal(t, domain.SpotStatusReserved, spots[0].SpotStatus)
+			assert.Equal(t, "ticket-1", spots[0].TicketID)
+			assert.Equal(t, domain.SpotStatusAvailable, spots[1].SpotStatus)
+			assert.Empty(t, spots[1].TicketID)
+		}
+
+		assert.Equal(t, domain.ErrorSpotNotFound, repo.ReserveSpot("missing-spot", "ticket-2"))
+	})
+
+	t.Run("duplicate spot names", func(t *testing.T) {
+		event := createTestEvent(t, repo, "A1", "A2")
+
+		duplicated, err := domain.CreatedNewSpot(*event, "A2")
+		if err != nil {
+			t.Fatal(err)
+		}
+		extra, err := domain.CreatedNewSpot(*event, "A3")
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = repo.CreateSpots(event.ID, []domain.Spot{*extra, *duplicated})
+		assert.ErrorIs(t, err, domain.ErrSpotNameAlreadyExists)
+
+		//nothing of the failed batch is stored
+		spots, err := repo.FindSpotsEventID(event.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"A1", "A2"}, spotNames(spots))
+
+		assert.Equal(t, domain.ErrEventNotFound, repo.CreateSpots("missing-event", []domain.Spot{*extra}))
+	})
+
+	t.Run("spots over capacity", func(t *testing.T) {
+		event, err := domain.CreatedNewEvent("Event Test", "Location Test", "Organization Test", domain.RatingFree, time.Now().Add(24*time.Hour), "image_url", 2, 50.00, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.CreateEvent(event); err != nil {
+			t.Fatal(err)
+		}
+		spots := make([]domain.Spot, 3)
+		for i, name := range []string{"A1", "A2", "A3"} {
+			spot, err := domain.CreatedNewSpot(*event, name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			spots[i] = *spot
+		}
+
+		//each request fits the capacity read before it, the stored spots decide
+		assert.Nil(t, repo.CreateSpots(event.ID, spots[:2]))
+		assert.ErrorIs(t, repo.CreateSpots(event.ID, spots[2:]), domain.ErrEventCapacityExceeded)
+
+		stored, err := repo.FindSpotsEventID(event.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"A1", "A2"}, spotNames(stored))
+	})
+
+	t.Run("change spots", func(t *testing.T) {
+		event := createTestEvent(t, repo, "A1", "A2", "A3")
+		now := time.Now()
+
+		err := repo.ChangeSpots(event.ID, func(spots []domain.Spot) ([]domain.SpotChange, error) {
+			return domain.UpdateSpots(spots, []domain.SpotUpdate{
+				{SpotID: event.Spots[0].ID, Name: "A2", Status: domain.SpotStatusBlocked, Reason: "Imprensa"},
+				{SpotID: event.Spots[1].ID, Name: "A1"},
+			}, "organizer@example.com", now)
+		})
+		assert.Nil(t, err)
+		err = repo.ChangeSpots(event.ID, func(spots []domain.Spot) ([]domain.SpotChange, error) {
+			return domain.DeleteSpots(spots, []string{event.Spots[2].ID}, "organizer@example.com", now)
+		})
+		assert.Nil(t, err)
+
+		spots, err := repo.FindSpotsEventID(event.ID)
+		assert.Nil(t, err)
+		if assert.Len(t, spots, 2) {
+			assert.Equal(t, event.Spots[1].ID, spots[0].ID)
+			assert.Equal(t, "A1", spots[0].Name)
+			assert.Equal(t, event.Spots[0].ID, spots[1].ID)
+			assert.Equal(t, domain.SpotStatusBlocked, spots[1].SpotStatus)
+			assert.Equal(t, "Imprensa", spots[1].BlockedReason)
+		}
+
+		//the changes of the same instant have no order among them
+		changes, err := repo.FindSpotChangesByEventID(event.ID)
+		assert.Nil(t, err)
+		assert.Len(t, changes, 4)
+		for _, change := range changes {
+			assert.Equal(t, "organizer@example.com", change.Actor)
+			switch change.SpotID {
+			case event.Spots[1].ID:
+				assert.Equal(t, domain.SpotChangeRenamed, change.Action)
+				assert.Equal(t, "A2", change.PreviousName)
+				assert.Equal(t, "A1", change.SpotName)
+			case event.Spots[2].ID:
+				assert.Equal(t, domain.SpotChangeDeleted, change.Action)
+				assert.Equal(t, "A3", change.SpotName)
+			}
+		}
+
+		//a refused change stores nothing
+		err = repo.ChangeSpots(event.ID, func(spots []domain.Spot) ([]domain.SpotChange, error) {
+			return domain.UpdateSpots(spots, []domain.SpotUpdate{{SpotID: event.Spots[1].ID, Status: domain.SpotStatusBlocked}}, "organizer@example.com", now)
+		})
+		assert.ErrorIs(t, err, domain.ErrSpotBlockReasonRequired)
+		changes, err = repo.FindSpotChangesByEventID(event.ID)
+		assert.Nil(t, err)
+		assert.Len(t, changes, 4)
+	})
+
+	t.Run("sell tickets", func(t *testing.T) {
+		event := createTestEvent(t, repo, "A1", "A2")
+		order, err := domain.CreatedNewOrder(event.ID, "Buyer@Example.com", "card-hash", "10.0.0.1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		ticket, err := domain.CreatedNewTicket(event, &event.Spots[0], domain.TicketKindHalf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = repo.SellTickets(order, []domain.Ticket{*ticket}, nil, func(sales domain.TicketSales) error {
+			assert.Equal(t, 0, sales.Total)
+			return nil
+		})
+		assert.Nil(t, err)
+
+		sales, err := repo.CountTicketSales(event.ID, " BUYER@example.com")
+		assert.Nil(t, err)
+		assert.Equal(t, 1, sales.Total)
+		assert.Equal(t, 1, sales.ByBuyer)
+		assert.Equal(t, 1, sales.ByKind[domain.TicketKindHalf])
+		sales, err = repo.CountTicketSales(event.ID, "other@example.com")
+		assert.Nil(t, err)
+		assert.Equal(t, 0, sales.ByBuyer)
+
+		emails, err := repo.FindBuyerEmailsByCardHash("card-hash", order.CreatedAt.Add(-time.Minute))
+		assert.Nil(t, err)
+		assert.Contains(t, emails, "buyer@example.com")
+
+		//the spot sold cannot be sold again
+		again, err := domain.CreatedNewOrder(event.ID, "other@example.com", "other-hash", "10.0.0.2")
+		if err != nil {
+			t.Fatal(err)
+		}
+		ticket, err = domain.CreatedNewTicket(event, &event.Spots[0], domain.TicketKindFull)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = repo.SellTickets(again, []domain.Ticket{*ticket}, nil, func(domain.TicketSales) error { return nil })
+		assert.ErrorIs(t, err, domain.ErrSpotNotAvailable)
+
+		spots, err := repo.FindAvailableSpotsByEventID(event.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"A2"}, spotNames(spots))
+	})
+
+	t.Run("events nearby", func(t *testing.T) {
+		center := domain.GeoPoint{Latitude: -23.5505, Longitude: -46.6333}
+		event, err := domain.CreatedNewEvent("Near Event", "Location Test", "Organization Test", domain.RatingFree, time.Now().Add(24*time.Hour), "image_url", 100, 50.00, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		event.Coordinates = &domain.GeoPoint{Latitude: -23.5614, Longitude: -46.6559}
+		if err := repo.CreateEvent(event); err != nil {
+			t.Fatal(err)
+		}
+
+		nearby, err := repo.FindEventsNearby(center, 10, 5)
+		assert.Nil(t, err)
+		if assert.Len(t, nearby, 1) {
+			assert.Equal(t, event.ID, nearby[0].Event.ID)
+			assert.InDelta(t, center.DistanceKm(*event.Coordinates), nearby[0].DistanceKm, 0.01)
+		}
+
+		nearby, err = repo.FindEventsNearby(center, 1, 5)
+		assert.Nil(t, err)
+		assert.Empty(t, nearby)
+	})
+}
+
+// TestMysqlEventRepository runs against a schema created in the server of
+// EVENTS_TEST_MYSQL_DSN
+func TestMysqlEventRepository(t *testing.T) {
+	repo, err := NewMysqlEventRepository(openTestMySQL(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testEventRepository(t, repo)
+}
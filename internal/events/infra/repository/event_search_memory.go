@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"cmp"
+	"go-backend-api/internal/events/domain"
+	"slices"
+)
+
+// EventLister is the source of the events searched by the in-memory index
+type EventLister interface {
+	ListEvents() ([]domain.Event, error)
+}
+
+// memoryEventSearchIndex scores every event on each search. It is used when
+// the database has no FULLTEXT index for events.
+type memoryEventSearchIndex struct {
+	events EventLister
+}
+
+func NewMemoryEventSearchIndex(events EventLister) domain.EventSearchIndex {
+	return &memoryEventSearchIndex{events: events}
+}
+
+func (i *memoryEventSearchIndex) SearchEvents(terms []string, limit int) ([]domain.Event, error) {
+	events, err := i.events.ListEvents()
+	if err != nil {
+		return nil, err
+	}
+
+	type scoredEvent struct {
+		event domain.Event
+		score int
+	}
+	results := []scoredEvent{}
+	for _, event := range events {
+		if score := domain.ScoreEventSearch(event, terms); score > 0 {
+			results = append(results, scoredEvent{event: event, score: score})
+		}
+	}
+
+	slices.SortFunc(results, func(a, b scoredEvent) int {
+		if c := cmp.Compare(b.score, a.score); c != 0 {
+			return c
+		}
+		if c := a.event.Date.Compare(b.event.Date); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.event.ID, b.event.ID)
+	})
+
+	found := make([]domain.Event, 0, min(limit, len(results)))
+	for _, result := range results[:min(limit, len(results))] {
+		found = append(found, result.event)
+	}
+	return found, nil
+}
@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"go-backend-api/internal/events/domain"
+	"strings"
+)
+
+var ErrFullTextIndexMissing = errors.New("events table has no FULLTEXT search indexes")
+
+// eventSearchIndexes are the FULLTEXT indexes the search needs: one on all
+// the search columns to filter and one on each column to weigh the relevance
+var eventSearchIndexes = []string{
+	"ft_events_search", "ft_events_search_name", "ft_events_search_location", "ft_events_search_organization",
+}
+
+// mysqlEventSearchIndex searches the search_* columns of the events, which
+// hold the normalized words of the name, location and organization.
+type mysqlEventSearchIndex struct {
+	db     *sql.DB
+	events *sqlEventRepository
+}
+
+// NewMysqlEventSearchIndex fails with ErrFullTextIndexMissing when the
+// database was created without the FULLTEXT indexes
+func NewMysqlEventSearchIndex(db *sql.DB) (domain.EventSearchIndex, error) {
+	query := `
+		SELECT COUNT(DISTINCT index_name) FROM information_schema.statistics
+		WHERE table_schema = DATABASE() AND table_name = 'events' AND index_type = 'FULLTEXT'
+		AND index_name IN (?, ?, ?, ?)
+	`
+	args := make([]any, len(eventSearchIndexes))
+	for i, name := range eventSearchIndexes {
+		args[i] = name
+	}
+	var indexes int
+	if err := db.QueryRow(query, args...).Scan(&indexes); err != nil {
+		return nil, err
+	}
+	if indexes < len(eventSearchIndexes) {
+		return nil, ErrFullTextIndexMissing
+	}
+
+	index := &mysqlEventSearchIndex{db: db, events: &sqlEventRepository{db: mysqlDB{db}}}
+	if err := index.reindexEvents(); err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+// eventSearchArgs returns the values of the search_name, search_location and
+// search_organization columns
+func eventSearchArgs(event *domain.Event) []any {
+	return []any{
+		strings.Join(domain.SearchTokens(event.Name), " "),
+		strings.Join(domain.SearchTokens(event.Location), " "),
+		strings.Join(domain.SearchTokens(event.Organization), " "),
+	}
+}
+
+func (i *mysqlEventSearchIndex) SearchEvents(terms []string, limit int) ([]domain.Event, error) {
+	//todos os termos são obrigatórios no filtro; a relevância soma os pesos de cada campo
+	required := make([]string, len(terms))
+	optional := make([]string, len(terms))
+	for j, term := range terms {
+		required[j] = "+" + term + "*"
+		optional[j] = term + "*"
+	}
+	match := strings.Join(optional, " ")
+
+	query := `
+		SELECT id,
+			MATCH(search_name) AGAINST(? IN BOOLEAN MODE) * ? +
+			MATCH(search_location) AGAINST(? IN BOOLEAN MODE) * ? +
+			MATCH(search_organization) AGAINST(? IN BOOLEAN MODE) * ? AS score
+		FROM events
+		WHERE MATCH(search_name, search_location, search_organization) AGAINST(? IN BOOLEAN MODE)
+			AND archived_at IS NULL
+		ORDER BY score DESC, date, id
+		LIMIT ?
+	`
+	rows, err := i.db.Query(query,
+		match, domain.SearchWeightName,
+		match, domain.SearchWeightLocation,
+		match, domain.SearchWeightOrganization,
+		strings.Join(required, " "), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []any{}
+	for rows.Next() {
+		var id string
+		var score float64
+		if err := rows.Scan(&id, &score); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []domain.Event{}, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", ")
+	events, err := i.events.listEvents(`WHERE e.id IN (`+placeholders+`)`, ids...)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]domain.Event, len(events))
+	for _, event := range events {
+		byID[event.ID] = event
+	}
+
+	found := make([]domain.Event, 0, len(ids))
+	for _, id := range ids {
+		if event, ok := byID[id.(string)]; ok {
+			found = append(found, event)
+		}
+	}
+	return found, nil
+}
+
+// reindexEvents fills the search columns of the events created before the
+// search existed
+func (i *mysqlEventSearchIndex) reindexEvents() error {
+	rows, err := i.db.Query(`SELECT id, name, location, organization FROM events WHERE search_name IS NULL`)
+	if err != nil {
+		return err
+	}
+	events := []domain.Event{}
+	for rows.Next() {
+		var event domain.Event
+		if err := rows.Scan(&event.ID, &event.Name, &event.Location, &event.Organization); err != nil {
+			rows.Close()
+			return err
+		}
+		events = append(events, event)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		query := `UPDATE events SET search_name = ?, search_location = ?, search_organization = ? WHERE id = ?`
+		if _, err := i.db.Exec(query, append(eventSearchArgs(&event), event.ID)...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
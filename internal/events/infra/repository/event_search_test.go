@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"go-backend-api/internal/events/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type eventList []domain.Event
+
+func (l eventList) ListEvents() ([]domain.Event, error) {
+	return l, nil
+}
+
+func searchTestEvents() []domain.Event {
+	date := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	newEvent := func(id, name, location, organization string, days int) domain.Event {
+		return domain.Event{
+			ID: id, Name: name, Location: location, Organization: organization,
+			Rating: domain.RatingFree, Date: date.AddDate(0, 0, days), ImageURL: "image_url",
+			Capacity: 100, Price: 50, PartnerID: 1, Timezone: domain.DefaultEventTimezone,
+		}
+	}
+	return []domain.Event{
+		newEvent("search-test-1", "Show do Rappa", "São Paulo, SP", "Rock Produções", 10),
+		newEvent("search-test-2", "Festival de Inverno", "Uberlândia, MG", "Cultura Minas", 20),
+		newEvent("search-test-3", "Paulo Gustavo Stand-up", "Rio de Janeiro", "Comédia Ltda", 5),
+		newEvent("search-test-4", "Orquestra Sinfônica", "Teatro Municipal", "Fundação Paulo Freire", 15),
+	}
+}
+
+func searchEventIDs(t *testing.T, index domain.EventSearchIndex, query string, limit int) []string {
+	terms, err := domain.SearchTerms(query)
+	assert.Nil(t, err)
+	events, err := index.SearchEvents(terms, limit)
+	assert.Nil(t, err)
+	ids := []string{}
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+	return ids
+}
+
+// testEventSearchIndex runs the same checks against every search index
+func testEventSearchIndex(t *testing.T, index domain.EventSearchIndex) {
+	t.Run("accent insensitive", func(t *testing.T) {
+		assert.Equal(t, []string{"search-test-1"}, searchEventIDs(t, index, "sao paulo", 20))
+		assert.Equal(t, []string{"search-test-2"}, searchEventIDs(t, index, "uberlandia", 20))
+		assert.Equal(t, []string{"search-test-2"}, searchEventIDs(t, index, "UBERLÂNDIA", 20))
+		assert.Equal(t, []string{"search-test-4"}, searchEventIDs(t, index, "fundacao", 20))
+	})
+
+	t.Run("every term must match", func(t *testing.T) {
+		assert.Equal(t, []string{"search-test-3"}, searchEventIDs(t, index, "paulo janeiro", 20))
+		assert.Empty(t, searchEventIDs(t, index, "paulo inverno", 20))
+	})
+
+	t.Run("prefixes", func(t *testing.T) {
+		assert.Equal(t, []string{"search-test-4"}, searchEventIDs(t, index, "orques", 20))
+	})
+
+	t.Run("ranked by field", func(t *testing.T) {
+		assert.Equal(t, []string{"search-test-3", "search-test-1", "search-test-4"}, searchEventIDs(t, index, "paulo", 20))
+		assert.Equal(t, []string{"search-test-3", "search-test-1"}, searchEventIDs(t, index, "paulo", 2))
+	})
+}
+
+func TestMemoryEventSearchIndex(t *testing.T) {
+	testEventSearchIndex(t, NewMemoryEventSearchIndex(eventList(searchTestEvents())))
+}
+
+// TestMysqlEventSearchIndex runs against a schema created in the server of
+// EVENTS_TEST_MYSQL_DSN
+func TestMysqlEventSearchIndex(t *testing.T) {
+	db := openTestMySQL(t)
+
+	events := searchTestEvents()
+	for i := range events {
+		if err := insertEvent(db, &events[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	index, err := NewMysqlEventSearchIndex(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testEventSearchIndex(t, index)
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"go-backend-api/internal/events/domain"
+)
+
+type sqlEventSeriesRepository struct {
+	db sqlDB
+}
+
+func NewMysqlEventSeriesRepository(db *sql.DB) (domain.EventSeriesRepository, error) {
+	return &sqlEventSeriesRepository{db: mysqlDB{db}}, nil
+}
+
+const eventSeriesColumns = `id, name, location, organization, rating, image_url, capacity, price, partner_id,
+	max_tickets_per_buyer, venue_id, timezone, start, recurrence, number_of_spots, layout, ticket_categories,
+	latitude, longitude`
+
+func scanEventSeries(row rowScanner) (*domain.EventSeries, error) {
+	var series domain.EventSeries
+	var venueID sql.NullString
+	var start, layout, categories string
+	var latitude, longitude sql.NullFloat64
+
+	err := row.Scan(&series.ID, &series.Name, &series.Location, &series.Organization, &series.Rating,
+		&series.ImageURL, &series.Capacity, &series.Price, &series.PartnerID, &series.MaxTicketsPerBuyer,
+		&venueID, &series.Timezone, &start, &series.Recurrence, &series.NumberOfSpots, &layout, &categories,
+		&latitude, &longitude)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrEventSeriesNotFound
+		}
+		return nil, err
+	}
+
+	series.VenueID = venueID.String
+	series.Coordinates = scanGeoPoint(latitude, longitude)
+	if series.Start, err = parseMySQLDateTime(start); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(layout), &series.Layout); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(categories), &series.TicketCategories); err != nil {
+		return nil, err
+	}
+	return &series, nil
+}
+
+// eventSeriesArgs returns the values stored for a series, in
+// eventSeriesColumns order without the id.
+func eventSeriesArgs(series *domain.EventSeries) ([]any, error) {
+	layout, err := json.Marshal(series.Layout)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := json.Marshal(nonNilSlice(series.TicketCategories))
+	if err != nil {
+		return nil, err
+	}
+	args := []any{
+		series.Name, series.Location, series.Organization, series.Rating, series.ImageURL,
+		series.Capacity, series.Price, series.PartnerID, series.MaxTicketsPerBuyer,
+		sql.NullString{String: series.VenueID, Valid: series.VenueID != ""}, series.Timezone,
+		formatMySQLDateTime(series.Start), series.Recurrence, series.NumberOfSpots,
+		string(layout), string(categories),
+	}
+	return append(args, geoPointArgs(series.Coordinates)...), nil
+}
+
+func (r *sqlEventSeriesRepository) CreateEventSeries(series *domain.EventSeries, occurrences []domain.Event) error {
+	args, err := eventSeriesArgs(series)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `INSERT INTO event_series (` + eventSeriesColumns + `) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	if _, err := tx.Exec(query, append([]any{series.ID}, args...)...); err != nil {
+		return err
+	}
+
+	for i := range occurrences {
+		event := &occurrences[i]
+		if err := insertEvent(tx, event); err != nil {
+			return err
+		}
+		if err := insertSpots(tx, event.Spots); err != nil {
+			return err
+		}
+		for j := range event.TicketCategories {
+			if err := insertTicketCategory(tx, &event.TicketCategories[j]); err != nil {
+				return err
+			}
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *sqlEventSeriesRepository) GetEventSeriesByID(seriesID string) (*domain.EventSeries, error) {
+	row := r.db.QueryRow(`SELECT `+eventSeriesColumns+` FROM event_series WHERE id = ?`, seriesID)
+	return scanEventSeries(row)
+}
+
+func (r *sqlEventSeriesRepository) UpdateEventSeries(series *domain.EventSeries, occurrences []domain.Event) error {
+	args, err := eventSeriesArgs(series)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `UPDATE event_series SET name = ?, location = ?, organization = ?, rating = ?, image_url = ?,
+		capacity = ?, price = ?, partner_id = ?, max_tickets_per_buyer = ?, venue_id = ?, timezone = ?,
+		start = ?, recurrence = ?, number_of_spots = ?, layout = ?, ticket_categories = ?,
+		latitude = ?, longitude = ? WHERE id = ?`
+	result, err := tx.Exec(query, append(args, series.ID)...)
+	if err != nil {
+		return err
+	}
+	if err := requireAffected(result, domain.ErrEventSeriesNotFound); err != nil {
+		return err
+	}
+
+	for i := range occurrences {
+		if err := updateSeriesOccurrence(tx, &occurrences[i]); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *sqlEventSeriesRepository) UpdateSeriesOccurrence(event *domain.Event) error {
+	return updateSeriesOccurrence(r.db, event)
+}
+
+func updateSeriesOccurrence(db execer, event *domain.Event) error {
+	overrides, err := json.Marshal(nonNilSlice(event.SeriesOverrides))
+	if err != nil {
+		return err
+	}
+	query := `UPDATE events SET name = ?, location = ?, organization = ?, rating = ?, image_url = ?,
+		capacity = ?, price = ?, max_tickets_per_buyer = ?, series_overrides = ?,
+		search_name = ?, search_location = ?, search_organization = ? WHERE id = ? AND series_id = ?`
+	args := []any{event.Name, event.Location, event.Organization, event.Rating, event.ImageURL,
+		event.Capacity, event.Price, event.MaxTicketsPerBuyer, string(overrides)}
+	args = append(args, eventSearchArgs(event)...)
+	_, err = db.Exec(query, append(args, event.ID, event.SeriesID)...)
+	return err
+}
@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha1"
+	"database/sql"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"go-backend-api/internal/events/domain"
+	"log"
+	"time"
+)
+
+type sqlJobRepository struct {
+	db sqlDB
+}
+
+func NewMysqlJobRepository(db *sql.DB) (domain.JobRepository, error) {
+	return &sqlJobRepository{db: mysqlDB{db}}, nil
+}
+
+const jobColumns = `name, handler, schedule, status, next_run_at, last_run_at, created_at`
+
+func scanJob(row rowScanner) (*domain.Job, error) {
+	var job domain.Job
+	var nextRunAt, createdAt string
+	var lastRunAt sql.NullString
+
+	err := row.Scan(&job.Name, &job.Handler, &job.Schedule, &job.Status, &nextRunAt, &lastRunAt, &createdAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrJobNotFound
+		}
+		return nil, err
+	}
+
+	if job.NextRunAt, err = parseMySQLDateTime(nextRunAt); err != nil {
+		return nil, err
+	}
+	if lastRunAt.Valid {
+		at, err := parseMySQLDateTime(lastRunAt.String)
+		if err != nil {
+			return nil, err
+		}
+		job.LastRunAt = &at
+	}
+	job.CreatedAt, err = parseMySQLDateTime(createdAt)
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (r *sqlJobRepository) findJobs(query string, args ...any) ([]domain.Job, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	jobs := []domain.Job{}
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, *job)
+	}
+	return jobs, rows.Err()
+}
+
+func (r *sqlJobRepository) ListJobs() ([]domain.Job, error) {
+	return r.findJobs(`SELECT ` + jobColumns + ` FROM scheduled_jobs ORDER BY name`)
+}
+
+func (r *sqlJobRepository) GetJobByName(name string) (*domain.Job, error) {
+	row := r.db.QueryRow(`SELECT `+jobColumns+` FROM scheduled_jobs WHERE name = ?`, name)
+	return scanJob(row)
+}
+
+func (r *sqlJobRepository) SaveJob(job *domain.Job) error {
+	query := r.db.upsertQuery(`scheduled_jobs`, `name`,
+		[]string{`name`, `handler`, `schedule`, `status`, `next_run_at`, `last_run_at`, `created_at`},
+		[]string{`handler`, `schedule`, `status`, `next_run_at`, `last_run_at`})
+	_, err := r.db.Exec(query, job.Name, job.Handler, job.Schedule, job.Status,
+		job.NextRunAt.UTC().Format(mysqlDateTimeMicroLayout), nullMicroDateTime(job.LastRunAt),
+		formatMySQLDateTime(job.CreatedAt))
+	return err
+}
+
+func (r *sqlJobRepository) FindDueJobs(now time.Time) ([]domain.Job, error) {
+	return r.findJobs(`SELECT `+jobColumns+` FROM scheduled_jobs WHERE status = ? AND next_run_at <= ? ORDER BY next_run_at, name`,
+		domain.JobStatusActive, now.UTC().Format(mysqlDateTimeMicroLayout))
+}
+
+const jobRunColumns = `id, job_name, runner, status, output, error, started_at, finished_at`
+
+func (r *sqlJobRepository) CreateJobRun(run *domain.JobRun) error {
+	query := `INSERT INTO job_runs (` + jobRunColumns + `) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+	_, err := r.db.Exec(query, run.ID, run.JobName, run.Runner, run.Status, run.Output, run.Error,
+		run.StartedAt.UTC().Format(mysqlDateTimeMicroLayout), nullMicroDateTime(run.FinishedAt))
+	return err
+}
+
+func (r *sqlJobRepository) UpdateJobRun(run *domain.JobRun) error {
+	query := `UPDATE job_runs SET status = ?, output = ?, error = ?, finished_at = ? WHERE id = ?`
+	_, err := r.db.Exec(query, run.Status, run.Output, run.Error, nullMicroDateTime(run.FinishedAt), run.ID)
+	return err
+}
+
+func (r *sqlJobRepository) FindJobRunsByJobName(name string, limit int) ([]domain.JobRun, error) {
+	query := `SELECT ` + jobRunColumns + ` FROM job_runs WHERE job_name = ? ORDER BY started_at DESC, id LIMIT ?`
+	rows, err := r.db.Query(query, name, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	runs := []domain.JobRun{}
+	for rows.Next() {
+		var run domain.JobRun
+		var startedAt string
+		var finishedAt sql.NullString
+		err := rows.Scan(&run.ID, &run.JobName, &run.Runner, &run.Status, &run.Output, &run.Error, &startedAt, &finishedAt)
+		if err != nil {
+			return nil, err
+		}
+		if run.StartedAt, err = parseMySQLDateTime(startedAt); err != nil {
+			return nil, err
+		}
+		if finishedAt.Valid {
+			at, err := parseMySQLDateTime(finishedAt.String)
+			if err != nil {
+				return nil, err
+			}
+			run.FinishedAt = &at
+		}
+		runs = append(runs, run)
+	}
+	return runs, rows.Err()
+}
+
+// nullMicroDateTime formats an optional instant for a DATETIME(6) column
+func nullMicroDateTime(t *time.Time) sql.NullString {
+	if t == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: t.UTC().Format(mysqlDateTimeMicroLayout), Valid: true}
+}
+
+// sqlJobLocker elects the instance that runs each job with the advisory
+// locks of the database. A lock belongs to the connection that took it, so
+// the connection is kept out of the pool until the lock is released; if the
+// instance dies, the database releases the lock with the connection.
+type sqlJobLocker struct {
+	db     *sql.DB
+	lock   string
+	unlock string
+	key    func(name string) any
+}
+
+// NewMysqlJobLocker takes the locks with GET_LOCK
+func NewMysqlJobLocker(db *sql.DB) domain.JobLocker {
+	return &sqlJobLocker{
+		db:     db,
+		lock:   `SELECT GET_LOCK(?, 0)`,
+		unlock: `SELECT RELEASE_LOCK(?)`,
+		key:    func(name string) any { return jobLockName(name) },
+	}
+}
+
+// NewPostgresJobLocker takes the session advisory locks of PostgreSQL,
+// whose keys are numbers
+func NewPostgresJobLocker(db *sql.DB) domain.JobLocker {
+	return &sqlJobLocker{
+		db:     db,
+		lock:   `SELECT pg_try_advisory_lock($1)`,
+		unlock: `SELECT pg_advisory_unlock($1)`,
+		key:    func(name string) any { return jobLockKey(name) },
+	}
+}
+
+func (l *sqlJobLocker) TryLockJob(name string) (func(), bool, error) {
+	ctx := context.Background()
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	key := l.key(name)
+	//o MySQL devolve 1 ou 0 e o PostgreSQL um booleano
+	var acquired sql.NullBool
+	if err := conn.QueryRowContext(ctx, l.lock, key).Scan(&acquired); err != nil {
+		conn.Close()
+		return nil, false, err
+	}
+	if !acquired.Bool {
+		conn.Close()
+		return nil, false, nil
+	}
+
+	unlock := func() {
+		defer conn.Close()
+		if _, err := conn.ExecContext(ctx, l.unlock, key); err != nil {
+			log.Printf("Erro ao liberar o lock do job %s: %v\n", name, err)
+		}
+	}
+	return unlock, true, nil
+}
+
+// jobLockName namespaces the lock of the job. MySQL limits lock names to
+// 64 characters, so a hash is used for long job names.
+func jobLockName(name string) string {
+	lockName := "events-job:" + name
+	if len(lockName) <= 64 {
+		return lockName
+	}
+	sum := sha1.Sum([]byte(name))
+	return "events-job:" + hex.EncodeToString(sum[:])
+}
+
+// jobLockKey is the PostgreSQL advisory lock key of the job: the first
+// eight bytes of the hash of its namespaced name
+func jobLockKey(name string) int64 {
+	sum := sha1.Sum([]byte("events-job:" + name))
+	return int64(binary.BigEndian.Uint64(sum[:8]))
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// mysqlDSN completes the DSN with the options the repositories rely on:
+// DATETIME columns read as UTC instants and UPDATE statements counting the
+// rows they match, so requireAffected does not mistake an update that
+// changed nothing for a missing row.
+func mysqlDSN(dsn string) (string, error) {
+	config, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return "", err
+	}
+	config.ParseTime = true
+	config.Loc = time.UTC
+	config.ClientFoundRows = true
+	return config.FormatDSN(), nil
+}
+
+// OpenMySQL opens the connection pool of the database. Like sql.Open, it
+// does not connect; use PingDatabase to wait for the server.
+func OpenMySQL(config DatabaseConfig) (*sql.DB, error) {
+	dsn, err := mysqlDSN(config.DSN)
+	if err != nil {
+		return nil, err
+	}
+	return openDatabase("mysql", dsn, config)
+}
+
+// mysqlDB runs the queries as they are written
+type mysqlDB struct {
+	*sql.DB
+}
+
+func (db mysqlDB) Begin() (sqlTx, error) {
+	return db.DB.Begin()
+}
+
+func (db mysqlDB) upsertQuery(table, key string, columns, update []string) string {
+	set := make([]string, len(update))
+	for i, column := range update {
+		set[i] = column + ` = VALUES(` + column + `)`
+	}
+	return insertQuery(table, columns) + ` ON DUPLICATE KEY UPDATE ` + strings.Join(set, ", ")
+}
+
+func (db mysqlDB) insertIgnoreQuery(table string, columns []string) string {
+	return strings.Replace(insertQuery(table, columns), `INSERT`, `INSERT IGNORE`, 1)
+}
+
+// mysqlDuplicateEntry is the MySQL error ER_DUP_ENTRY
+const mysqlDuplicateEntry = 1062
+
+func (db mysqlDB) isUniqueViolation(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlDuplicateEntry
+}
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+)
+
+// openTestMySQL creates a disposable schema with the tables of
+// mysql-init/init.sql, without its seed data, in the server of
+// EVENTS_TEST_MYSQL_DSN and drops it when the test ends. The user of the DSN
+// must be allowed to create databases; its database is ignored.
+func openTestMySQL(t *testing.T) *sql.DB {
+	t.Helper()
+	dsn := os.Getenv("EVENTS_TEST_MYSQL_DSN")
+	if dsn == "" {
+		t.Skip("EVENTS_TEST_MYSQL_DSN not set")
+	}
+	config, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	suffix := make([]byte, 4)
+	rand.Read(suffix)
+	schema := "events_test_" + hex.EncodeToString(suffix)
+	config.DBName = ""
+	server, err := sql.Open("mysql", config.FormatDSN())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { server.Close() })
+	if _, err := server.Exec("CREATE DATABASE " + schema); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if _, err := server.Exec("DROP DATABASE " + schema); err != nil {
+			t.Errorf("dropping %s: %v", schema, err)
+		}
+	})
+
+	config.DBName = schema
+	db, err := OpenMySQL(DatabaseConfig{DSN: config.FormatDSN()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	script, err := os.ReadFile("../../../../mysql-init/init.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, statement := range strings.Split(string(script), ";\n") {
+		statement = strings.TrimSpace(statement)
+		//só as tabelas: o script também recria o banco test_db e insere dados de exemplo
+		if !strings.HasPrefix(statement, "CREATE TABLE") {
+			continue
+		}
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatalf("%v\n%s", err, statement)
+		}
+	}
+	return db
+}
+
+func TestMysqlDSN(t *testing.T) {
+	dsn, err := mysqlDSN("test_user:test_password@tcp(golang-mysql:3306)/test_db?timeout=5s")
+	assert.Nil(t, err)
+	config, err := mysql.ParseDSN(dsn)
+	assert.Nil(t, err)
+	assert.Equal(t, "test_db", config.DBName)
+	assert.Equal(t, "golang-mysql:3306", config.Addr)
+	assert.Equal(t, 5*time.Second, config.Timeout)
+	assert.True(t, config.ParseTime)
+	assert.True(t, config.ClientFoundRows)
+	assert.Equal(t, time.UTC, config.Loc)
+
+	_, err = mysqlDSN("test_user@golang-mysql/test_db")
+	assert.NotNil(t, err)
+}
+
+func TestOpenMySQL_Pool(t *testing.T) {
+	db, err := OpenMySQL(DatabaseConfig{DSN: "test_user:test_password@tcp(127.0.0.1:1)/test_db", MaxOpenConns: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	assert.Equal(t, 7, db.Stats().MaxOpenConnections)
+
+	//nothing listens on the port, so every attempt fails
+	err = PingDatabase(context.Background(), db, 2, time.Millisecond)
+	assert.NotNil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.NotNil(t, PingDatabase(ctx, db, 3, time.Hour))
+}
+
+// runMigration runs the statements of a script of mysql-init/migrations in
+// one connection, so the prepared statements outlive each Exec
+func runMigration(t *testing.T, conn *sql.Conn, name string) {
+	t.Helper()
+	script, err := os.ReadFile("../../../../mysql-init/migrations/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, statement := range strings.Split(string(script), ";\n") {
+		if strings.TrimSpace(statement) == "" {
+			continue
+		}
+		if _, err := conn.ExecContext(context.Background(), statement); err != nil {
+			t.Fatalf("%s: %v\n%s", name, err, statement)
+		}
+	}
+}
+
+// TestMysqlFixEventDates follows the repair procedure of
+// 009_event_date_repairs.sql on an event with a garbled date
+func TestMysqlFixEventDates(t *testing.T) {
+	db := openTestMySQL(t)
+	repo, err := NewMysqlEventRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := createTestEvent(t, repo)
+	if _, err := db.Exec("UPDATE events SET date = '1999-07-15 15:04:05' WHERE id = ?", event.ID); err != nil {
+		t.Fatal(err)
+	}
+	conn, err := db.Conn(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	//o schema do init.sql já tem a coluna timezone e os scripts podem rodar de novo
+	for i := 0; i < 2; i++ {
+		runMigration(t, conn, "008_event_timezone.sql")
+		runMigration(t, conn, "009_event_date_repairs.sql")
+	}
+	var stored string
+	err = conn.QueryRowContext(context.Background(), "SELECT stored_date FROM event_date_repairs WHERE event_id = ?", event.ID).Scan(&stored)
+	assert.Nil(t, err)
+	assert.Equal(t, "1999-07-15 15:04:05", stored)
+
+	corrected := time.Date(2024, 7, 15, 18, 0, 0, 0, time.UTC)
+	if _, err := conn.ExecContext(context.Background(), "UPDATE event_date_repairs SET corrected_date = ? WHERE event_id = ?", formatMySQLDateTime(corrected), event.ID); err != nil {
+		t.Fatal(err)
+	}
+	runMigration(t, conn, "010_fix_event_dates.sql")
+
+	fixed, err := repo.GetEventByID(event.ID)
+	assert.Nil(t, err)
+	if assert.NotNil(t, fixed) {
+		assert.True(t, corrected.Equal(fixed.Date), fixed.Date)
+	}
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"database/sql"
+	"go-backend-api/internal/events/domain"
+	"time"
+)
+
+type sqlNotificationRepository struct {
+	db sqlDB
+}
+
+func NewMysqlNotificationRepository(db *sql.DB) (domain.NotificationRepository, error) {
+	return &sqlNotificationRepository{db: mysqlDB{db}}, nil
+}
+
+const notificationColumns = `id, kind, locale, recipient, dedup_key, data, status, attempts, next_attempt_at, last_error, created_at, sent_at`
+
+func scanNotification(row rowScanner) (*domain.Notification, error) {
+	var notification domain.Notification
+	var data, nextAttemptAt, createdAt string
+	var sentAt sql.NullString
+
+	err := row.Scan(&notification.ID, &notification.Kind, &notification.Locale, &notification.Recipient,
+		&notification.DedupKey, &data, &notification.Status, &notification.Attempts, &nextAttemptAt,
+		&notification.LastError, &createdAt, &sentAt)
+	if err != nil {
+		return nil, err
+	}
+
+	notification.Data = []byte(data)
+	notification.NextAttemptAt, err = parseMySQLDateTime(nextAttemptAt)
+	if err != nil {
+		return nil, err
+	}
+	notification.CreatedAt, err = parseMySQLDateTime(createdAt)
+	if err != nil {
+		return nil, err
+	}
+	if sentAt.Valid {
+		notification.SentAt, err = parseMySQLDateTime(sentAt.String)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &notification, nil
+}
+
+// EnqueueNotification relies on the unique dedup_key: a notification
+// already queued for the same cause is kept and the new one is dropped
+func (r *sqlNotificationRepository) EnqueueNotification(notification *domain.Notification) error {
+	query := r.db.insertIgnoreQuery(`notifications`, []string{`id`, `kind`, `locale`, `recipient`, `dedup_key`,
+		`data`, `status`, `attempts`, `next_attempt_at`, `last_error`, `created_at`})
+	_, err := r.db.Exec(query, notification.ID, notification.Kind, notification.Locale, notification.Recipient,
+		notification.DedupKey, string(notification.Data), notification.Status, notification.Attempts,
+		notification.NextAttemptAt.UTC().Format(mysqlDateTimeMicroLayout), notification.LastError,
+		formatMySQLDateTime(notification.CreatedAt))
+	return err
+}
+
+func (r *sqlNotificationRepository) FindDueNotifications(now time.Time, limit int) ([]domain.Notification, error) {
+	query := `SELECT ` + notificationColumns + ` FROM notifications
+		WHERE status = ? AND next_attempt_at <= ? ORDER BY next_attempt_at, id LIMIT ?`
+	rows, err := r.db.Query(query, domain.NotificationStatusPending, now.UTC().Format(mysqlDateTimeMicroLayout), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	notifications := []domain.Notification{}
+	for rows.Next() {
+		notification, err := scanNotification(rows)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, *notification)
+	}
+	return notifications, rows.Err()
+}
+
+// ClaimNotification only moves the next attempt if it is still the one the
+// dispatcher read, so two dispatchers never send the same email at once
+func (r *sqlNotificationRepository) ClaimNotification(notification *domain.Notification, until time.Time) error {
+	query := `UPDATE notifications SET next_attempt_at = ? WHERE id = ? AND status = ? AND next_attempt_at = ?`
+	result, err := r.db.Exec(query, until.UTC().Format(mysqlDateTimeMicroLayout), notification.ID,
+		domain.NotificationStatusPending, notification.NextAttemptAt.UTC().Format(mysqlDateTimeMicroLayout))
+	if err != nil {
+		return err
+	}
+	if err := requireAffected(result, domain.ErrNotificationConflict); err != nil {
+		return err
+	}
+	notification.NextAttemptAt = until.UTC()
+	return nil
+}
+
+func (r *sqlNotificationRepository) UpdateNotification(notification *domain.Notification) error {
+	var sentAt sql.NullString
+	if !notification.SentAt.IsZero() {
+		sentAt = sql.NullString{String: formatMySQLDateTime(notification.SentAt), Valid: true}
+	}
+	query := `UPDATE notifications SET status = ?, attempts = ?, next_attempt_at = ?, last_error = ?, sent_at = ? WHERE id = ?`
+	_, err := r.db.Exec(query, notification.Status, notification.Attempts,
+		notification.NextAttemptAt.UTC().Format(mysqlDateTimeMicroLayout), notification.LastError, sentAt, notification.ID)
+	return err
+}
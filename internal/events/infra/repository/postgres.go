@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+// OpenPostgres opens the connection pool of a PostgreSQL database. Like
+// sql.Open, it does not connect; use PingDatabase to wait for the server.
+func OpenPostgres(config DatabaseConfig) (*sql.DB, error) {
+	return openDatabase("postgres", config.DSN, config)
+}
+
+// rebindPostgres numbers the ? placeholders of the query as $1, $2...,
+// leaving the quoted literals alone
+func rebindPostgres(query string) string {
+	var b strings.Builder
+	b.Grow(len(query) + 16)
+	placeholder := 0
+	quoted := false
+	for _, r := range query {
+		switch {
+		case r == '\'':
+			quoted = !quoted
+		case r == '?' && !quoted:
+			placeholder++
+			b.WriteString("$" + strconv.Itoa(placeholder))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// postgresDB runs the queries of the repositories, written with ?
+// placeholders, with the numbered placeholders of PostgreSQL
+type postgresDB struct {
+	db *sql.DB
+}
+
+func (db postgresDB) Exec(query string, args ...any) (sql.Result, error) {
+	return db.db.Exec(rebindPostgres(query), args...)
+}
+
+func (db postgresDB) Query(query string, args ...any) (*sql.Rows, error) {
+	return db.db.Query(rebindPostgres(query), args...)
+}
+
+func (db postgresDB) QueryRow(query string, args ...any) *sql.Row {
+	return db.db.QueryRow(rebindPostgres(query), args...)
+}
+
+func (db postgresDB) Begin() (sqlTx, error) {
+	tx, err := db.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	return postgresTx{tx: tx}, nil
+}
+
+func (db postgresDB) upsertQuery(table, key string, columns, update []string) string {
+	set := make([]string, len(update))
+	for i, column := range update {
+		set[i] = column + ` = EXCLUDED.` + column
+	}
+	return insertQuery(table, columns) + ` ON CONFLICT (` + key + `) DO UPDATE SET ` + strings.Join(set, ", ")
+}
+
+func (db postgresDB) insertIgnoreQuery(table string, columns []string) string {
+	return insertQuery(table, columns) + ` ON CONFLICT DO NOTHING`
+}
+
+// postgresUniqueViolation is the SQLSTATE unique_violation
+const postgresUniqueViolation = "23505"
+
+func (db postgresDB) isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == postgresUniqueViolation
+}
+
+// postgresTx is a transaction of postgresDB
+type postgresTx struct {
+	tx *sql.Tx
+}
+
+func (tx postgresTx) Exec(query string, args ...any) (sql.Result, error) {
+	return tx.tx.Exec(rebindPostgres(query), args...)
+}
+
+func (tx postgresTx) Query(query string, args ...any) (*sql.Rows, error) {
+	return tx.tx.Query(rebindPostgres(query), args...)
+}
+
+func (tx postgresTx) QueryRow(query string, args ...any) *sql.Row {
+	return tx.tx.QueryRow(rebindPostgres(query), args...)
+}
+
+func (tx postgresTx) Commit() error {
+	return tx.tx.Commit()
+}
+
+func (tx postgresTx) Rollback() error {
+	return tx.tx.Rollback()
+}
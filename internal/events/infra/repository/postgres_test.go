@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// openTestPostgres creates a disposable schema with the tables of
+// postgres-init/migrations in the database of EVENTS_TEST_POSTGRES_DSN and
+// drops it when the test ends. The user of the DSN must be allowed to create
+// schemas.
+func openTestPostgres(t *testing.T) *sql.DB {
+	t.Helper()
+	dsn := os.Getenv("EVENTS_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("EVENTS_TEST_POSTGRES_DSN not set")
+	}
+
+	suffix := make([]byte, 4)
+	rand.Read(suffix)
+	schema := "events_test_" + hex.EncodeToString(suffix)
+	server, err := OpenPostgres(DatabaseConfig{DSN: dsn})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { server.Close() })
+	if _, err := server.Exec("CREATE SCHEMA " + schema); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if _, err := server.Exec("DROP SCHEMA " + schema + " CASCADE"); err != nil {
+			t.Errorf("dropping %s: %v", schema, err)
+		}
+	})
+
+	//o driver repassa as opções desconhecidas do DSN como parâmetros da sessão
+	if strings.Contains(dsn, "://") {
+		u, err := url.Parse(dsn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		query := u.Query()
+		query.Set("search_path", schema)
+		u.RawQuery = query.Encode()
+		dsn = u.String()
+	} else {
+		dsn += " search_path=" + schema
+	}
+	db, err := OpenPostgres(DatabaseConfig{DSN: dsn})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	migrations, err := filepath.Glob("../../../../postgres-init/migrations/*.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, migration := range migrations {
+		script, err := os.ReadFile(migration)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := db.Exec(string(script)); err != nil {
+			t.Fatalf("%s: %v", migration, err)
+		}
+	}
+	return db
+}
+
+func TestRebindPostgres(t *testing.T) {
+	assert.Equal(t, `SELECT id FROM spots WHERE event_id = $1 AND status IN ($2, $3)`,
+		rebindPostgres(`SELECT id FROM spots WHERE event_id = ? AND status IN (?, ?)`))
+	assert.Equal(t, `UPDATE events SET name = 'who?' WHERE id = $1`,
+		rebindPostgres(`UPDATE events SET name = 'who?' WHERE id = ?`))
+	assert.Equal(t, `SELECT 1`, rebindPostgres(`SELECT 1`))
+}
+
+// TestPostgresEventRepository runs against a schema created in the database
+// of EVENTS_TEST_POSTGRES_DSN
+func TestPostgresEventRepository(t *testing.T) {
+	repo, err := NewPostgresEventRepository(openTestPostgres(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testEventRepository(t, repo)
+}
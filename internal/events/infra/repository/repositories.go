@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"database/sql"
+	"go-backend-api/internal/events/domain"
+)
+
+// Repositories are the repositories of the application kept in the same
+// database
+type Repositories struct {
+	Events          domain.EventRepository
+	Coupons         domain.CouponRepository
+	Waitlist        domain.WaitlistRepository
+	Venues          domain.VenueRepository
+	Series          domain.EventSeriesRepository
+	Tickets         domain.TicketRepository
+	TicketTemplates domain.TicketTemplateRepository
+	Notifications   domain.NotificationRepository
+	Jobs            domain.JobRepository
+	JobLocker       domain.JobLocker
+}
+
+// NewMysqlRepositories keeps every repository in the tables of
+// mysql-init/init.sql
+func NewMysqlRepositories(db *sql.DB) *Repositories {
+	return newSQLRepositories(mysqlDB{db}, NewMysqlJobLocker(db))
+}
+
+// NewPostgresRepositories keeps every repository in the tables of
+// postgres-init/migrations
+func NewPostgresRepositories(db *sql.DB) *Repositories {
+	return newSQLRepositories(postgresDB{db: db}, NewPostgresJobLocker(db))
+}
+
+func newSQLRepositories(db sqlDB, locker domain.JobLocker) *Repositories {
+	return &Repositories{
+		Events:          &sqlEventRepository{db: db},
+		Coupons:         &sqlCouponRepository{db: db},
+		Waitlist:        &sqlWaitlistRepository{db: db},
+		Venues:          &sqlVenueRepository{db: db},
+		Series:          &sqlEventSeriesRepository{db: db},
+		Tickets:         &sqlTicketRepository{db: db},
+		TicketTemplates: &sqlTicketTemplateRepository{db: db},
+		Notifications:   &sqlNotificationRepository{db: db},
+		Jobs:            &sqlJobRepository{db: db},
+		JobLocker:       locker,
+	}
+}
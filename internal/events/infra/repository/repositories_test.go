@@ -0,0 +1,386 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"go-backend-api/internal/events/domain"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+// sellTestTicket sells the spot of the event to email in a new order
+func sellTestTicket(t *testing.T, repo domain.EventRepository, event *domain.Event, spot *domain.Spot, email string) *domain.Ticket {
+	t.Helper()
+	order, err := domain.CreatedNewOrder(event.ID, email, "card-"+email, "10.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ticket, err := domain.CreatedNewTicket(event, spot, domain.TicketKindFull)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ticket.OrderID = order.ID
+	ticket.HolderEmail = order.Email
+	err = repo.SellTickets(order, []domain.Ticket{*ticket}, nil, func(domain.TicketSales) error { return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ticket
+}
+
+// testRepositories runs the same checks against the repositories of every
+// database, so a backend keeps the behavior of the others
+func testRepositories(t *testing.T, repos *Repositories) {
+	t.Run("coupon redemption", func(t *testing.T) {
+		testCouponRedemption(t, repos.Events, repos.Coupons)
+	})
+
+	t.Run("venues", func(t *testing.T) {
+		layout := domain.SeatLayout{Sections: []domain.SeatSection{
+			{Name: "Plateia", Rows: []domain.SeatRow{{Label: "A", Seats: []domain.Seat{{Number: 1}, {Number: 2}}}}},
+		}}
+		venue, err := domain.CreatedNewVenue("Teatro", "Rua A, 1", "São Paulo", -23.5505, -46.6333, "America/Sao_Paulo", 100, layout)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Nil(t, repos.Venues.CreateVenue(venue))
+
+		venue.Capacity = 120
+		assert.Nil(t, repos.Venues.UpdateVenue(venue))
+		stored, err := repos.Venues.GetVenueByID(venue.ID)
+		assert.Nil(t, err)
+		if assert.NotNil(t, stored) {
+			assert.Equal(t, 120, stored.Capacity)
+			assert.Equal(t, 2, stored.Layout.SeatCount())
+		}
+		venues, err := repos.Venues.ListVenues()
+		assert.Nil(t, err)
+		assert.Len(t, venues, 1)
+
+		//um local com eventos não pode ser apagado
+		event, err := domain.CreatedNewEvent("Venue Event", "Teatro", "Organization Test", domain.RatingFree, time.Now().Add(24*time.Hour), "image_url", 100, 50.00, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		event.VenueID = venue.ID
+		if err := repos.Events.CreateEvent(event); err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, domain.ErrVenueInUse, repos.Venues.DeleteVenue(venue.ID))
+		events, err := repos.Events.ListEventsByVenueID(venue.ID)
+		assert.Nil(t, err)
+		assert.Len(t, events, 1)
+
+		empty, err := domain.CreatedNewVenue("Galpão", "Rua B, 2", "São Paulo", -23.5, -46.6, "America/Sao_Paulo", 50, domain.SeatLayout{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Nil(t, repos.Venues.CreateVenue(empty))
+		assert.Nil(t, repos.Venues.DeleteVenue(empty.ID))
+		_, err = repos.Venues.GetVenueByID(empty.ID)
+		assert.Equal(t, domain.ErrVenueNotFound, err)
+		assert.Equal(t, domain.ErrVenueNotFound, repos.Venues.DeleteVenue(empty.ID))
+	})
+
+	t.Run("event series", func(t *testing.T) {
+		start := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+		series, err := domain.CreatedNewEventSeries("Show", "Theater", "Organization Test", domain.RatingFree, start,
+			"America/Sao_Paulo", "FREQ=DAILY;COUNT=2", "image_url", 100, 50.00, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dates, err := series.Occurrences()
+		if err != nil {
+			t.Fatal(err)
+		}
+		occurrences := []domain.Event{}
+		for _, date := range dates {
+			occurrence, err := series.NewOccurrence(date)
+			if err != nil {
+				t.Fatal(err)
+			}
+			occurrences = append(occurrences, *occurrence)
+		}
+		assert.Nil(t, repos.Series.CreateEventSeries(series, occurrences))
+
+		stored, err := repos.Series.GetEventSeriesByID(series.ID)
+		assert.Nil(t, err)
+		if assert.NotNil(t, stored) {
+			assert.Equal(t, series.Recurrence, stored.Recurrence)
+			assert.True(t, series.Start.Equal(stored.Start))
+		}
+		events, err := repos.Events.ListEventsBySeriesID(series.ID)
+		assert.Nil(t, err)
+		assert.Len(t, events, 2)
+
+		//a mudança da série chega às ocorrências
+		series.Name = "Show Renamed"
+		for i := range occurrences {
+			if err := series.ApplyTo(&occurrences[i]); err != nil {
+				t.Fatal(err)
+			}
+		}
+		assert.Nil(t, repos.Series.UpdateEventSeries(series, occurrences))
+		event, err := repos.Events.GetEventByID(occurrences[0].ID)
+		assert.Nil(t, err)
+		if assert.NotNil(t, event) {
+			assert.Equal(t, "Show Renamed", event.Name)
+		}
+
+		missing := *series
+		missing.ID = "missing-series"
+		assert.Equal(t, domain.ErrEventSeriesNotFound, repos.Series.UpdateEventSeries(&missing, nil))
+	})
+
+	t.Run("ticket transfer and check-in", func(t *testing.T) {
+		event := createTestEvent(t, repos.Events, "A1")
+		ticket := sellTestTicket(t, repos.Events, event, &event.Spots[0], "holder@example.com")
+
+		stored, err := repos.Tickets.GetTicketByID(ticket.ID)
+		assert.Nil(t, err)
+		if assert.NotNil(t, stored) {
+			assert.Equal(t, "holder@example.com", stored.HolderEmail)
+			assert.Equal(t, "A1", stored.Spot.Name)
+		}
+		tickets, err := repos.Tickets.FindTicketsByOrderID(ticket.OrderID)
+		assert.Nil(t, err)
+		assert.Len(t, tickets, 1)
+
+		now := time.Now()
+		transfer, err := domain.CreatedNewTicketTransfer(*event, *stored, "holder@example.com", "friend@example.com", now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Nil(t, repos.Tickets.CreateTicketTransfer(transfer))
+		again, err := domain.CreatedNewTicketTransfer(*event, *stored, "holder@example.com", "other@example.com", now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, domain.ErrTicketTransferPending, repos.Tickets.CreateTicketTransfer(again))
+
+		attendee, err := domain.CreatedNewAttendee("Friend", "123", time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := transfer.Accept(*event, stored, "friend@example.com", transfer.AcceptanceCode, *attendee, now); err != nil {
+			t.Fatal(err)
+		}
+		assert.Nil(t, repos.Tickets.AcceptTicketTransfer(transfer, stored))
+		transfers, err := repos.Tickets.FindTicketTransfersByTicketID(ticket.ID)
+		assert.Nil(t, err)
+		if assert.Len(t, transfers, 1) {
+			assert.Equal(t, domain.TicketTransferStatusAccepted, transfers[0].Status)
+		}
+
+		//a primeira leitura vale e a seguinte é repetida
+		scan, err := domain.CreatedNewTicketScan("Portão 1", "device-1", domain.ScanSourceOnline, now, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		scan.TicketID = ticket.ID
+		scan.EventID = event.ID
+		scan.Resolve(nil)
+		if err := stored.CheckIn(scan.Gate, scan.ScannedAt); err != nil {
+			t.Fatal(err)
+		}
+		assert.Nil(t, repos.Tickets.CheckInTicket(stored, scan))
+
+		later := *stored
+		checkedInAt := scan.ScannedAt.Add(time.Minute)
+		later.CheckedInAt = &checkedInAt
+		duplicate, err := domain.CreatedNewTicketScan("Portão 2", "device-2", domain.ScanSourceOnline, now, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.ErrorIs(t, repos.Tickets.CheckInTicket(&later, duplicate), domain.ErrTicketCheckedIn)
+		duplicate.Resolve(domain.ErrTicketCheckedIn)
+		assert.Nil(t, repos.Tickets.RecordTicketScan(duplicate))
+
+		tickets, err = repos.Tickets.FindTicketsByEventID(event.ID)
+		assert.Nil(t, err)
+		if assert.Len(t, tickets, 1) && assert.NotNil(t, tickets[0].CheckedInAt) {
+			assert.Equal(t, "friend@example.com", tickets[0].HolderEmail)
+			assert.Equal(t, "Portão 1", tickets[0].CheckedInGate)
+			assert.True(t, scan.ScannedAt.Equal(*tickets[0].CheckedInAt))
+		}
+	})
+
+	t.Run("waitlist", func(t *testing.T) {
+		event := createTestEvent(t, repos.Events, "A1")
+		entry, err := domain.CreatedNewWaitlistEntry(*event, "waiting@example.com", 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Nil(t, repos.Waitlist.CreateWaitlistEntry(entry))
+		duplicate, err := domain.CreatedNewWaitlistEntry(*event, "waiting@example.com", 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, domain.ErrWaitlistAlreadyJoined, repos.Waitlist.CreateWaitlistEntry(duplicate))
+		missing := *duplicate
+		missing.EventID = "missing-event"
+		assert.Equal(t, domain.ErrEventNotFound, repos.Waitlist.CreateWaitlistEntry(&missing))
+
+		until := time.Now().Add(-time.Minute)
+		if err := entry.Offer([]string{event.Spots[0].ID}, until); err != nil {
+			t.Fatal(err)
+		}
+		assert.Nil(t, repos.Waitlist.UpdateWaitlistEntry(entry))
+		expired, err := repos.Waitlist.FindExpiredWaitlistOffers(time.Now())
+		assert.Nil(t, err)
+		if assert.Len(t, expired, 1) {
+			assert.Equal(t, entry.ID, expired[0].ID)
+			assert.Equal(t, []string{event.Spots[0].ID}, expired[0].OfferedSpotIDs)
+		}
+		entries, err := repos.Waitlist.FindWaitlistEntriesByEventID(event.ID)
+		assert.Nil(t, err)
+		assert.Len(t, entries, 1)
+	})
+
+	t.Run("notifications", func(t *testing.T) {
+		now := time.Now()
+		notification, err := domain.CreatedNewNotification(domain.NotificationReminder, "pt-BR", "buyer@example.com",
+			"reminder:event-1:buyer@example.com", map[string]string{"event": "Event Test"}, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Nil(t, repos.Notifications.EnqueueNotification(notification))
+		//a mesma chave não enfileira de novo
+		again, err := domain.CreatedNewNotification(domain.NotificationReminder, "pt-BR", "buyer@example.com",
+			"reminder:event-1:buyer@example.com", map[string]string{"event": "Event Test"}, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Nil(t, repos.Notifications.EnqueueNotification(again))
+
+		due, err := repos.Notifications.FindDueNotifications(now.Add(time.Second), 10)
+		assert.Nil(t, err)
+		if !assert.Len(t, due, 1) {
+			return
+		}
+		assert.Equal(t, notification.ID, due[0].ID)
+
+		stale := due[0]
+		assert.Nil(t, repos.Notifications.ClaimNotification(&due[0], now.Add(time.Minute)))
+		assert.Equal(t, domain.ErrNotificationConflict, repos.Notifications.ClaimNotification(&stale, now.Add(time.Minute)))
+
+		due[0].MarkSent(now)
+		assert.Nil(t, repos.Notifications.UpdateNotification(&due[0]))
+		pending, err := repos.Notifications.FindDueNotifications(now.Add(time.Hour), 10)
+		assert.Nil(t, err)
+		assert.Empty(t, pending)
+	})
+
+	t.Run("jobs", func(t *testing.T) {
+		now := time.Now()
+		job, err := domain.CreatedNewJob("release-holds", "@every 1m", now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Nil(t, repos.Jobs.SaveJob(job))
+		if err := job.Reschedule("@every 5m", now); err != nil {
+			t.Fatal(err)
+		}
+		assert.Nil(t, repos.Jobs.SaveJob(job))
+		stored, err := repos.Jobs.GetJobByName(job.Name)
+		assert.Nil(t, err)
+		if assert.NotNil(t, stored) {
+			assert.Equal(t, "@every 5m", stored.Schedule)
+		}
+		_, err = repos.Jobs.GetJobByName("missing-job")
+		assert.Equal(t, domain.ErrJobNotFound, err)
+		due, err := repos.Jobs.FindDueJobs(now.Add(10 * time.Minute))
+		assert.Nil(t, err)
+		assert.Len(t, due, 1)
+
+		run := domain.CreatedNewJobRun(*job, "instance-1", now)
+		assert.Nil(t, repos.Jobs.CreateJobRun(run))
+		run.Finish("ok", nil, now.Add(time.Second))
+		assert.Nil(t, repos.Jobs.UpdateJobRun(run))
+		runs, err := repos.Jobs.FindJobRunsByJobName(job.Name, 10)
+		assert.Nil(t, err)
+		if assert.Len(t, runs, 1) {
+			assert.Equal(t, domain.JobRunStatusSucceeded, runs[0].Status)
+			assert.NotNil(t, runs[0].FinishedAt)
+		}
+
+		//só uma instância roda o job de cada vez
+		unlock, acquired, err := repos.JobLocker.TryLockJob(job.Name)
+		assert.Nil(t, err)
+		assert.True(t, acquired)
+		_, acquired, err = repos.JobLocker.TryLockJob(job.Name)
+		assert.Nil(t, err)
+		assert.False(t, acquired)
+		if unlock != nil {
+			unlock()
+		}
+		unlock, acquired, err = repos.JobLocker.TryLockJob(job.Name)
+		assert.Nil(t, err)
+		assert.True(t, acquired)
+		if unlock != nil {
+			unlock()
+		}
+	})
+
+	t.Run("ticket templates", func(t *testing.T) {
+		_, err := repos.TicketTemplates.GetTicketTemplate("Organization Test")
+		assert.Equal(t, domain.ErrTicketTemplateNotFound, err)
+
+		template, err := domain.CreatedNewTicketTemplate("Organization Test", domain.TicketTemplateLayoutPage, "#112233", "Header", "Footer", true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Nil(t, repos.TicketTemplates.SaveTicketTemplate(template))
+		template.Layout = domain.TicketTemplateLayoutCompact
+		template.ShowPrice = false
+		assert.Nil(t, repos.TicketTemplates.SaveTicketTemplate(template))
+
+		stored, err := repos.TicketTemplates.GetTicketTemplate("Organization Test")
+		assert.Nil(t, err)
+		assert.Equal(t, template, stored)
+	})
+}
+
+func TestUpsertQuery(t *testing.T) {
+	columns := []string{`organization`, `layout`, `show_price`}
+	update := []string{`layout`, `show_price`}
+	assert.Equal(t, `INSERT INTO ticket_templates (organization, layout, show_price) VALUES (?, ?, ?)`+
+		` ON DUPLICATE KEY UPDATE layout = VALUES(layout), show_price = VALUES(show_price)`,
+		mysqlDB{}.upsertQuery(`ticket_templates`, `organization`, columns, update))
+	assert.Equal(t, `INSERT INTO ticket_templates (organization, layout, show_price) VALUES (?, ?, ?)`+
+		` ON CONFLICT (organization) DO UPDATE SET layout = EXCLUDED.layout, show_price = EXCLUDED.show_price`,
+		postgresDB{}.upsertQuery(`ticket_templates`, `organization`, columns, update))
+
+	assert.Equal(t, `INSERT IGNORE INTO notifications (id, dedup_key) VALUES (?, ?)`,
+		mysqlDB{}.insertIgnoreQuery(`notifications`, []string{`id`, `dedup_key`}))
+	assert.Equal(t, `INSERT INTO notifications (id, dedup_key) VALUES (?, ?) ON CONFLICT DO NOTHING`,
+		postgresDB{}.insertIgnoreQuery(`notifications`, []string{`id`, `dedup_key`}))
+}
+
+func TestIsUniqueViolation(t *testing.T) {
+	duplicated := fmt.Errorf("inserting: %w", &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"})
+	assert.True(t, mysqlDB{}.isUniqueViolation(duplicated))
+	assert.False(t, mysqlDB{}.isUniqueViolation(&mysql.MySQLError{Number: 1452}))
+	assert.False(t, mysqlDB{}.isUniqueViolation(nil))
+
+	assert.True(t, postgresDB{}.isUniqueViolation(&pq.Error{Code: "23505"}))
+	assert.False(t, postgresDB{}.isUniqueViolation(&pq.Error{Code: "23503"}))
+	assert.False(t, postgresDB{}.isUniqueViolation(errors.New("connection refused")))
+}
+
+// TestMysqlRepositories runs against a schema created in the server of
+// EVENTS_TEST_MYSQL_DSN
+func TestMysqlRepositories(t *testing.T) {
+	testRepositories(t, NewMysqlRepositories(openTestMySQL(t)))
+}
+
+// TestPostgresRepositories runs against a schema created in the database
+// of EVENTS_TEST_POSTGRES_DSN
+func TestPostgresRepositories(t *testing.T) {
+	testRepositories(t, NewPostgresRepositories(openTestPostgres(t)))
+}
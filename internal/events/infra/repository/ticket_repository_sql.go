@@ -0,0 +1,261 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"go-backend-api/internal/events/domain"
+)
+
+type sqlTicketRepository struct {
+	db sqlDB
+}
+
+func NewMysqlTicketRepository(db *sql.DB) (domain.TicketRepository, error) {
+	return &sqlTicketRepository{db: mysqlDB{db}}, nil
+}
+
+const ticketColumns = `t.id, t.event_id, t.order_id, t.ticket_kind, t.price, t.coupon_id, t.discount,
+	t.attendee_name, t.attendee_document, t.holder_email, t.credential_version, t.checked_in_at, t.checked_in_gate,
+	s.id, s.event_id, s.name`
+
+func scanTicket(row rowScanner) (*domain.Ticket, error) {
+	var ticket domain.Ticket
+	var spot domain.Spot
+	var orderID, couponID, checkedInAt sql.NullString
+	err := row.Scan(&ticket.ID, &ticket.EventID, &orderID, &ticket.TicketKind,
+		&ticket.Price, &couponID, &ticket.Discount, &ticket.AttendeeName, &ticket.AttendeeDocument,
+		&ticket.HolderEmail, &ticket.CredentialVersion, &checkedInAt, &ticket.CheckedInGate,
+		&spot.ID, &spot.EventID, &spot.Name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrTicketNotFound
+		}
+		return nil, err
+	}
+	ticket.OrderID = orderID.String
+	ticket.CouponID = couponID.String
+	ticket.Spot = &spot
+	if checkedInAt.Valid {
+		at, err := parseMySQLDateTime(checkedInAt.String)
+		if err != nil {
+			return nil, err
+		}
+		ticket.CheckedInAt = &at
+	}
+	return &ticket, nil
+}
+
+func (r *sqlTicketRepository) GetTicketByID(ticketID string) (*domain.Ticket, error) {
+	query := `SELECT ` + ticketColumns + ` FROM tickets t JOIN spots s ON s.id = t.spot_id WHERE t.id = ?`
+	return scanTicket(r.db.QueryRow(query, ticketID))
+}
+
+func (r *sqlTicketRepository) FindTicketsByEventID(eventID string) ([]domain.Ticket, error) {
+	return r.findTickets(`t.event_id = ?`, eventID)
+}
+
+func (r *sqlTicketRepository) FindTicketsByOrderID(orderID string) ([]domain.Ticket, error) {
+	return r.findTickets(`t.order_id = ?`, orderID)
+}
+
+// findTickets returns the tickets matching filter ordered by spot name
+func (r *sqlTicketRepository) findTickets(filter string, args ...any) ([]domain.Ticket, error) {
+	query := `SELECT ` + ticketColumns + ` FROM tickets t JOIN spots s ON s.id = t.spot_id WHERE ` + filter + ` ORDER BY s.name, t.id`
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tickets := []domain.Ticket{}
+	for rows.Next() {
+		ticket, err := scanTicket(rows)
+		if err != nil {
+			return nil, err
+		}
+		tickets = append(tickets, *ticket)
+	}
+
+	return tickets, rows.Err()
+}
+
+func (r *sqlTicketRepository) CheckInTicket(ticket *domain.Ticket, scan *domain.TicketScan) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	//a leitura mais antiga vence, inclusive as sincronizadas depois pelos leitores offline
+	checkedInAt := ticket.CheckedInAt.UTC().Format(mysqlDateTimeMicroLayout)
+	result, err := tx.Exec(`UPDATE tickets SET checked_in_at = ?, checked_in_gate = ?
+		WHERE id = ? AND credential_version = ? AND (checked_in_at IS NULL OR checked_in_at > ?)`,
+		checkedInAt, ticket.CheckedInGate, ticket.ID, ticket.CredentialVersion, checkedInAt)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		current, err := scanTicket(tx.QueryRow(`SELECT `+ticketColumns+` FROM tickets t JOIN spots s ON s.id = t.spot_id WHERE t.id = ?`, ticket.ID))
+		if err != nil {
+			return err
+		}
+		if current.CredentialVersion != ticket.CredentialVersion {
+			return domain.ErrCredentialRevoked
+		}
+		if err := current.CheckIn(ticket.CheckedInGate, *ticket.CheckedInAt); err != nil {
+			return err
+		}
+		return domain.ErrTicketCheckedIn
+	}
+
+	if err := insertTicketScan(tx, scan); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *sqlTicketRepository) RecordTicketScan(scan *domain.TicketScan) error {
+	return insertTicketScan(r.db, scan)
+}
+
+func insertTicketScan(db execer, scan *domain.TicketScan) error {
+	_, err := db.Exec(`INSERT INTO ticket_scans (id, ticket_id, event_id, gate, device_id, source, result, reason, scanned_at, synced_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		scan.ID, sql.NullString{String: scan.TicketID, Valid: scan.TicketID != ""}, sql.NullString{String: scan.EventID, Valid: scan.EventID != ""},
+		scan.Gate, scan.DeviceID, scan.Source, scan.Result, scan.Reason,
+		scan.ScannedAt.UTC().Format(mysqlDateTimeMicroLayout), formatMySQLDateTime(scan.SyncedAt))
+	return err
+}
+
+const ticketTransferColumns = `id, ticket_id, event_id, from_email, to_email, status, acceptance_code,
+	credential_version, created_at, expires_at, resolved_at`
+
+func scanTicketTransfer(row rowScanner) (*domain.TicketTransfer, error) {
+	var transfer domain.TicketTransfer
+	var createdAt, expiresAt string
+	var resolvedAt sql.NullString
+
+	err := row.Scan(&transfer.ID, &transfer.TicketID, &transfer.EventID, &transfer.FromEmail, &transfer.ToEmail,
+		&transfer.Status, &transfer.AcceptanceCode, &transfer.CredentialVersion, &createdAt, &expiresAt, &resolvedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrTicketTransferNotFound
+		}
+		return nil, err
+	}
+
+	if transfer.CreatedAt, err = parseMySQLDateTime(createdAt); err != nil {
+		return nil, err
+	}
+	if transfer.ExpiresAt, err = parseMySQLDateTime(expiresAt); err != nil {
+		return nil, err
+	}
+	if resolvedAt.Valid {
+		if transfer.ResolvedAt, err = parseMySQLDateTime(resolvedAt.String); err != nil {
+			return nil, err
+		}
+	}
+	return &transfer, nil
+}
+
+func (r *sqlTicketRepository) CreateTicketTransfer(transfer *domain.TicketTransfer) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	//bloqueando o ingresso para que só exista uma transferência pendente por vez
+	var holderEmail string
+	err = tx.QueryRow(`SELECT holder_email FROM tickets WHERE id = ? FOR UPDATE`, transfer.TicketID).Scan(&holderEmail)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrTicketNotFound
+		}
+		return err
+	}
+	if holderEmail != transfer.FromEmail {
+		return domain.ErrTicketNotHeldBy
+	}
+	var pending int
+	err = tx.QueryRow(`SELECT COUNT(*) FROM ticket_transfers WHERE ticket_id = ? AND status = ? AND expires_at > ?`,
+		transfer.TicketID, domain.TicketTransferStatusPending, formatMySQLDateTime(transfer.CreatedAt)).Scan(&pending)
+	if err != nil {
+		return err
+	}
+	if pending > 0 {
+		return domain.ErrTicketTransferPending
+	}
+
+	query := `INSERT INTO ticket_transfers (` + ticketTransferColumns + `) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	_, err = tx.Exec(query, transfer.ID, transfer.TicketID, transfer.EventID, transfer.FromEmail, transfer.ToEmail,
+		transfer.Status, transfer.AcceptanceCode, transfer.CredentialVersion,
+		transfer.CreatedAt.UTC().Format(mysqlDateTimeMicroLayout), formatMySQLDateTime(transfer.ExpiresAt),
+		nullDateTime(transfer.ResolvedAt))
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *sqlTicketRepository) FindTicketTransfersByTicketID(ticketID string) ([]domain.TicketTransfer, error) {
+	rows, err := r.db.Query(`SELECT `+ticketTransferColumns+` FROM ticket_transfers WHERE ticket_id = ? ORDER BY created_at, id`, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	transfers := []domain.TicketTransfer{}
+	for rows.Next() {
+		transfer, err := scanTicketTransfer(rows)
+		if err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, *transfer)
+	}
+
+	return transfers, rows.Err()
+}
+
+func (r *sqlTicketRepository) UpdateTicketTransfer(transfer *domain.TicketTransfer) error {
+	return updateTicketTransfer(r.db, transfer)
+}
+
+// updateTicketTransfer stores the resolution of a transfer that is still
+// pending in the database
+func updateTicketTransfer(db execer, transfer *domain.TicketTransfer) error {
+	result, err := db.Exec(`UPDATE ticket_transfers SET status = ?, credential_version = ?, resolved_at = ? WHERE id = ? AND status = ?`,
+		transfer.Status, transfer.CredentialVersion, nullDateTime(transfer.ResolvedAt), transfer.ID, domain.TicketTransferStatusPending)
+	if err != nil {
+		return err
+	}
+	return requireAffected(result, domain.ErrTicketTransferNotPending)
+}
+
+func (r *sqlTicketRepository) AcceptTicketTransfer(transfer *domain.TicketTransfer, ticket *domain.Ticket) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := updateTicketTransfer(tx, transfer); err != nil {
+		return err
+	}
+	result, err := tx.Exec(`UPDATE tickets SET holder_email = ?, attendee_name = ?, attendee_document = ?, credential_version = ?
+		WHERE id = ? AND holder_email = ? AND checked_in_at IS NULL`,
+		ticket.HolderEmail, ticket.AttendeeName, ticket.AttendeeDocument, ticket.CredentialVersion, ticket.ID, transfer.FromEmail)
+	if err != nil {
+		return err
+	}
+	if err := requireAffected(result, domain.ErrTicketNotHeldBy); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
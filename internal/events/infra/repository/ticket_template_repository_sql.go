@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"go-backend-api/internal/events/domain"
+)
+
+type sqlTicketTemplateRepository struct {
+	db sqlDB
+}
+
+func NewMysqlTicketTemplateRepository(db *sql.DB) (domain.TicketTemplateRepository, error) {
+	return &sqlTicketTemplateRepository{db: mysqlDB{db}}, nil
+}
+
+func (r *sqlTicketTemplateRepository) GetTicketTemplate(organization string) (*domain.TicketTemplate, error) {
+	query := `SELECT organization, layout, accent_color, header_text, footer_text, show_price FROM ticket_templates WHERE organization = ?`
+	var template domain.TicketTemplate
+	err := r.db.QueryRow(query, organization).Scan(&template.Organization, &template.Layout, &template.AccentColor,
+		&template.HeaderText, &template.FooterText, &template.ShowPrice)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrTicketTemplateNotFound
+		}
+		return nil, err
+	}
+	return &template, nil
+}
+
+func (r *sqlTicketTemplateRepository) SaveTicketTemplate(template *domain.TicketTemplate) error {
+	query := r.db.upsertQuery(`ticket_templates`, `organization`,
+		[]string{`organization`, `layout`, `accent_color`, `header_text`, `footer_text`, `show_price`},
+		[]string{`layout`, `accent_color`, `header_text`, `footer_text`, `show_price`})
+	_, err := r.db.Exec(query, template.Organization, template.Layout, template.AccentColor,
+		template.HeaderText, template.FooterText, template.ShowPrice)
+	return err
+}
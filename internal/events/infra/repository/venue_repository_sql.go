@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"go-backend-api/internal/events/domain"
+)
+
+type sqlVenueRepository struct {
+	db sqlDB
+}
+
+func NewMysqlVenueRepository(db *sql.DB) (domain.VenueRepository, error) {
+	return &sqlVenueRepository{db: mysqlDB{db}}, nil
+}
+
+const venueColumns = `id, name, address, city, latitude, longitude, timezone, capacity, layout`
+
+func scanVenue(row rowScanner) (*domain.Venue, error) {
+	var venue domain.Venue
+	var layout string
+
+	err := row.Scan(&venue.ID, &venue.Name, &venue.Address, &venue.City,
+		&venue.Latitude, &venue.Longitude, &venue.Timezone, &venue.Capacity, &layout)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrVenueNotFound
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(layout), &venue.Layout); err != nil {
+		return nil, err
+	}
+	return &venue, nil
+}
+
+// venueArgs returns the values stored for a venue, in venueColumns order
+// without the id.
+func venueArgs(venue *domain.Venue) ([]any, error) {
+	layout, err := json.Marshal(venue.Layout)
+	if err != nil {
+		return nil, err
+	}
+	return []any{
+		venue.Name, venue.Address, venue.City, venue.Latitude, venue.Longitude,
+		venue.Timezone, venue.Capacity, string(layout),
+	}, nil
+}
+
+func (r *sqlVenueRepository) ListVenues() ([]domain.Venue, error) {
+	rows, err := r.db.Query(`SELECT ` + venueColumns + ` FROM venues ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	venues := []domain.Venue{}
+	for rows.Next() {
+		venue, err := scanVenue(rows)
+		if err != nil {
+			return nil, err
+		}
+		venues = append(venues, *venue)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return venues, nil
+}
+
+func (r *sqlVenueRepository) GetVenueByID(venueID string) (*domain.Venue, error) {
+	row := r.db.QueryRow(`SELECT `+venueColumns+` FROM venues WHERE id = ?`, venueID)
+	return scanVenue(row)
+}
+
+func (r *sqlVenueRepository) CreateVenue(venue *domain.Venue) error {
+	args, err := venueArgs(venue)
+	if err != nil {
+		return err
+	}
+	query := `INSERT INTO venues (` + venueColumns + `) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	_, err = r.db.Exec(query, append([]any{venue.ID}, args...)...)
+	return err
+}
+
+func (r *sqlVenueRepository) UpdateVenue(venue *domain.Venue) error {
+	args, err := venueArgs(venue)
+	if err != nil {
+		return err
+	}
+	query := `UPDATE venues SET name = ?, address = ?, city = ?, latitude = ?, longitude = ?,
+		timezone = ?, capacity = ?, layout = ? WHERE id = ?`
+	_, err = r.db.Exec(query, append(args, venue.ID)...)
+	return err
+}
+
+func (r *sqlVenueRepository) DeleteVenue(venueID string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var events int
+	if err := tx.QueryRow(`SELECT COUNT(*) FROM events WHERE venue_id = ?`, venueID).Scan(&events); err != nil {
+		return err
+	}
+	if events > 0 {
+		return domain.ErrVenueInUse
+	}
+
+	result, err := tx.Exec(`DELETE FROM venues WHERE id = ?`, venueID)
+	if err != nil {
+		return err
+	}
+	if err := requireAffected(result, domain.ErrVenueNotFound); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"go-backend-api/internal/events/domain"
+	"time"
+)
+
+// waitlist entries keep microseconds so entries created in the same second
+// are still served in order
+const mysqlDateTimeMicroLayout = "2006-01-02 15:04:05.999999"
+
+type sqlWaitlistRepository struct {
+	db sqlDB
+}
+
+func NewMysqlWaitlistRepository(db *sql.DB) (domain.WaitlistRepository, error) {
+	return &sqlWaitlistRepository{db: mysqlDB{db}}, nil
+}
+
+const waitlistColumns = `id, event_id, email, quantity, status, offered_spot_ids, offer_expires_at, created_at`
+
+func scanWaitlistEntry(row rowScanner) (*domain.WaitlistEntry, error) {
+	var entry domain.WaitlistEntry
+	var offeredSpotIDs, createdAt string
+	var offerExpiresAt sql.NullString
+
+	err := row.Scan(&entry.ID, &entry.EventID, &entry.Email, &entry.Quantity, &entry.Status,
+		&offeredSpotIDs, &offerExpiresAt, &createdAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrWaitlistEntryNotFound
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(offeredSpotIDs), &entry.OfferedSpotIDs); err != nil {
+		return nil, err
+	}
+	if offerExpiresAt.Valid {
+		entry.OfferExpiresAt, err = parseMySQLDateTime(offerExpiresAt.String)
+		if err != nil {
+			return nil, err
+		}
+	}
+	entry.CreatedAt, err = parseMySQLDateTime(createdAt)
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (r *sqlWaitlistRepository) findWaitlistEntries(query string, args ...any) ([]domain.WaitlistEntry, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []domain.WaitlistEntry{}
+	for rows.Next() {
+		entry, err := scanWaitlistEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, *entry)
+	}
+
+	return entries, rows.Err()
+}
+
+func (r *sqlWaitlistRepository) CreateWaitlistEntry(entry *domain.WaitlistEntry) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	//um email só pode ter uma entrada ativa por evento; o bloqueio do evento
+	//serializa as entradas, já que o PostgreSQL não bloqueia uma contagem
+	var lockedID string
+	err = tx.QueryRow(`SELECT id FROM events WHERE id = ? FOR UPDATE`, entry.EventID).Scan(&lockedID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrEventNotFound
+		}
+		return err
+	}
+	var active int
+	err = tx.QueryRow(`SELECT COUNT(*) FROM waitlist_entries WHERE event_id = ? AND email = ? AND status IN (?, ?)`,
+		entry.EventID, entry.Email, domain.WaitlistStatusWaiting, domain.WaitlistStatusOffered).Scan(&active)
+	if err != nil {
+		return err
+	}
+	if active > 0 {
+		return domain.ErrWaitlistAlreadyJoined
+	}
+
+	offeredSpotIDs, err := json.Marshal(nonNilSlice(entry.OfferedSpotIDs))
+	if err != nil {
+		return err
+	}
+	query := `INSERT INTO waitlist_entries (` + waitlistColumns + `) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+	_, err = tx.Exec(query, entry.ID, entry.EventID, entry.Email, entry.Quantity, entry.Status,
+		string(offeredSpotIDs), nullDateTime(entry.OfferExpiresAt), entry.CreatedAt.UTC().Format(mysqlDateTimeMicroLayout))
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *sqlWaitlistRepository) GetWaitlistEntryByID(entryID string) (*domain.WaitlistEntry, error) {
+	row := r.db.QueryRow(`SELECT `+waitlistColumns+` FROM waitlist_entries WHERE id = ?`, entryID)
+	return scanWaitlistEntry(row)
+}
+
+func (r *sqlWaitlistRepository) FindWaitlistEntriesByEventID(eventID string) ([]domain.WaitlistEntry, error) {
+	return r.findWaitlistEntries(`SELECT `+waitlistColumns+` FROM waitlist_entries WHERE event_id = ? ORDER BY created_at, id`, eventID)
+}
+
+func (r *sqlWaitlistRepository) FindExpiredWaitlistOffers(now time.Time) ([]domain.WaitlistEntry, error) {
+	return r.findWaitlistEntries(`SELECT `+waitlistColumns+` FROM waitlist_entries WHERE status = ? AND offer_expires_at <= ?`,
+		domain.WaitlistStatusOffered, now.UTC().Format(mysqlDateTimeLayout))
+}
+
+func (r *sqlWaitlistRepository) UpdateWaitlistEntry(entry *domain.WaitlistEntry) error {
+	offeredSpotIDs, err := json.Marshal(nonNilSlice(entry.OfferedSpotIDs))
+	if err != nil {
+		return err
+	}
+	_, err = r.db.Exec(`UPDATE waitlist_entries SET quantity = ?, status = ?, offered_spot_ids = ?, offer_expires_at = ? WHERE id = ?`,
+		entry.Quantity, entry.Status, string(offeredSpotIDs), nullDateTime(entry.OfferExpiresAt), entry.ID)
+	return err
+}
+
+func nullDateTime(t time.Time) sql.NullString {
+	if t.IsZero() {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: t.UTC().Format(mysqlDateTimeLayout), Valid: true}
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrEmailAddressInvalid = errors.New("email address is invalid")
+
+// EmailMessage is a rendered email ready to be delivered
+type EmailMessage struct {
+	To      string
+	Subject string
+	HTML    string
+}
+
+type EmailSender interface {
+	SendEmail(message EmailMessage) error
+}
+
+// SMTPEmailSender delivers the emails through an SMTP relay. Without auth
+// the relay must accept mail from the server; smtp.PlainAuth only sends the
+// password over TLS or to localhost.
+type SMTPEmailSender struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+func NewSMTPEmailSender(addr, from string, auth smtp.Auth) *SMTPEmailSender {
+	return &SMTPEmailSender{addr: addr, from: from, auth: auth}
+}
+
+func (s *SMTPEmailSender) SendEmail(message EmailMessage) error {
+	content, err := buildEmail(s.from, message, time.Now())
+	if err != nil {
+		return err
+	}
+	from, err := mail.ParseAddress(s.from)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrEmailAddressInvalid, s.from)
+	}
+	return smtp.SendMail(s.addr, s.auth, from.Address, []string{message.To}, content)
+}
+
+// LogEmailSender is the sender for development: it logs each email and,
+// when dir is set, writes it there as an .eml file to open in a mail client.
+type LogEmailSender struct {
+	from string
+	dir  string
+}
+
+func NewLogEmailSender(from, dir string) *LogEmailSender {
+	return &LogEmailSender{from: from, dir: dir}
+}
+
+func (s *LogEmailSender) SendEmail(message EmailMessage) error {
+	content, err := buildEmail(s.from, message, time.Now())
+	if err != nil {
+		return err
+	}
+	if s.dir == "" {
+		log.Printf("email to %s: %s", message.To, message.Subject)
+		return nil
+	}
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return err
+	}
+	path := filepath.Join(s.dir, fmt.Sprintf("%d-%s.eml", time.Now().UnixNano(), uuid.New().String()[:8]))
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		return err
+	}
+	log.Printf("email to %s: %s (%s)", message.To, message.Subject, path)
+	return nil
+}
+
+// buildEmail writes the message in the MIME format with an HTML body
+// encoded as quoted-printable, so the lines stay short for any relay
+func buildEmail(from string, message EmailMessage, now time.Time) ([]byte, error) {
+	sender, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrEmailAddressInvalid, from)
+	}
+	recipient, err := mail.ParseAddress(message.To)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrEmailAddressInvalid, message.To)
+	}
+	_, domain, _ := strings.Cut(sender.Address, "@")
+	//quebras de linha no assunto criariam novos cabeçalhos
+	subject := strings.Join(strings.Fields(message.Subject), " ")
+
+	var buffer bytes.Buffer
+	headers := [][2]string{
+		{"From", sender.String()},
+		{"To", recipient.String()},
+		{"Subject", mime.QEncoding.Encode("utf-8", subject)},
+		{"Date", now.Format(time.RFC1123Z)},
+		{"Message-ID", "<" + uuid.New().String() + "@" + domain + ">"},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "text/html; charset=UTF-8"},
+		{"Content-Transfer-Encoding", "quoted-printable"},
+	}
+	for _, header := range headers {
+		buffer.WriteString(header[0] + ": " + header[1] + "\r\n")
+	}
+	buffer.WriteString("\r\n")
+
+	body := quotedprintable.NewWriter(&buffer)
+	if _, err := body.Write([]byte(message.HTML)); err != nil {
+		return nil, err
+	}
+	if err := body.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
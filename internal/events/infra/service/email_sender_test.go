@@ -0,0 +1,173 @@
+package service
+
+import (
+	"bufio"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// smtpMessage is an email received by the SMTP stub
+type smtpMessage struct {
+	From string
+	To   []string
+	Data string
+}
+
+// startSMTPStub runs a minimal SMTP server in the test process. It accepts
+// every message, or rejects them with a 554 while reject is set, and sends
+// the received ones to the returned channel.
+func startSMTPStub(t *testing.T, reject bool) (string, <-chan smtpMessage) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	messages := make(chan smtpMessage, 10)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveSMTP(conn, reject, messages)
+		}
+	}()
+	return listener.Addr().String(), messages
+}
+
+func serveSMTP(conn net.Conn, reject bool, messages chan<- smtpMessage) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+
+	reply("220 localhost ESMTP stub")
+	var message smtpMessage
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.TrimSpace(line))
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			message = smtpMessage{From: strings.Trim(strings.TrimSpace(line)[len("MAIL FROM:"):], "<>")}
+			reply("250 OK")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			if reject {
+				reply("554 mailbox unavailable")
+				continue
+			}
+			message.To = append(message.To, strings.Trim(strings.TrimSpace(line)[len("RCPT TO:"):], "<>"))
+			reply("250 OK")
+		case command == "DATA":
+			reply("354 end data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(dataLine, "."))
+			}
+			message.Data = data.String()
+			messages <- message
+			reply("250 OK")
+		case command == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func TestSMTPEmailSender_SendEmail(t *testing.T) {
+	addr, messages := startSMTPStub(t, false)
+	sender := NewSMTPEmailSender(addr, "Events <no-reply@events.local>", nil)
+
+	err := sender.SendEmail(EmailMessage{
+		To:      "buyer@example.com",
+		Subject: "Pedido confirmado:\r\nBcc: intruder@example.com",
+		HTML:    "<p>Olá, seus ingressos estão prontos.</p>",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received := <-messages
+	assert.Equal(t, "no-reply@events.local", received.From)
+	assert.Equal(t, []string{"buyer@example.com"}, received.To)
+
+	parsed, err := mail.ReadMessage(strings.NewReader(received.Data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "<buyer@example.com>", parsed.Header.Get("To"))
+	assert.Empty(t, parsed.Header.Get("Bcc"))
+	assert.Equal(t, "text/html; charset=UTF-8", parsed.Header.Get("Content-Type"))
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "Pedido confirmado: Bcc: intruder@example.com", subject)
+
+	body, err := io.ReadAll(quotedprintable.NewReader(parsed.Body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "<p>Olá, seus ingressos estão prontos.</p>", strings.TrimSpace(string(body)))
+}
+
+func TestSMTPEmailSender_SendEmailRejected(t *testing.T) {
+	addr, _ := startSMTPStub(t, true)
+	sender := NewSMTPEmailSender(addr, "no-reply@events.local", nil)
+
+	err := sender.SendEmail(EmailMessage{To: "buyer@example.com", Subject: "Test", HTML: "<p>Test</p>"})
+	assert.ErrorContains(t, err, "554")
+}
+
+func TestSMTPEmailSender_SendEmailInvalidAddress(t *testing.T) {
+	sender := NewSMTPEmailSender("127.0.0.1:1", "no-reply@events.local", nil)
+
+	err := sender.SendEmail(EmailMessage{To: "not an email", Subject: "Test", HTML: "<p>Test</p>"})
+	assert.ErrorIs(t, err, ErrEmailAddressInvalid)
+}
+
+func TestLogEmailSender_SendEmailWritesEML(t *testing.T) {
+	dir := t.TempDir()
+	sender := NewLogEmailSender("no-reply@events.local", dir)
+
+	err := sender.SendEmail(EmailMessage{To: "buyer@example.com", Subject: "Test", HTML: "<p>Test</p>"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("expected 1 email file, got %d", len(files))
+	}
+	content, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, string(content), "Subject: Test")
+	assert.Contains(t, string(content), "<p>Test</p>")
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"html/template"
+	"strings"
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+var ErrEmailTemplateNotFound = errors.New("email template not found")
+
+//go:embed templates/email/*.html
+var emailTemplateFiles embed.FS
+
+// EmailRenderer writes the subject and HTML body of a notification from
+// its template
+type EmailRenderer interface {
+	RenderEmail(notification domain.Notification) (subject, body string, err error)
+}
+
+// HTMLEmailTemplates renders the emails with the templates embedded in the
+// binary. Each kind has one file per locale, named "<kind>.<locale>.html",
+// defining a "subject" and a "content" template; layout.html wraps the
+// content. Notifications in a locale without a file use the default locale.
+type HTMLEmailTemplates struct {
+	templates map[string]*template.Template
+}
+
+// NewHTMLEmailTemplates parses every template up front, so a broken one
+// stops the server instead of failing at delivery
+func NewHTMLEmailTemplates() (*HTMLEmailTemplates, error) {
+	t := &HTMLEmailTemplates{templates: map[string]*template.Template{}}
+	for _, locale := range domain.NotificationLocales {
+		for _, kind := range []domain.NotificationKind{
+			domain.NotificationOrderConfirmation,
+			domain.NotificationRefund,
+			domain.NotificationReminder,
+			domain.NotificationCancellation,
+		} {
+			name := emailTemplateName(kind, locale)
+			parsed, err := template.New(name).Funcs(emailTemplateFuncs(locale)).
+				ParseFS(emailTemplateFiles, "templates/email/layout.html", "templates/email/"+name)
+			if err != nil {
+				return nil, fmt.Errorf("parsing email template %s: %w", name, err)
+			}
+			t.templates[name] = parsed
+		}
+	}
+	return t, nil
+}
+
+func emailTemplateName(kind domain.NotificationKind, locale string) string {
+	return string(kind) + "." + locale + ".html"
+}
+
+func (t *HTMLEmailTemplates) RenderEmail(notification domain.Notification) (string, string, error) {
+	parsed, ok := t.templates[emailTemplateName(notification.Kind, notification.Locale)]
+	if !ok {
+		parsed, ok = t.templates[emailTemplateName(notification.Kind, domain.DefaultNotificationLocale)]
+	}
+	if !ok {
+		return "", "", fmt.Errorf("%w: %s", ErrEmailTemplateNotFound, notification.Kind)
+	}
+
+	var data map[string]any
+	if err := json.Unmarshal(notification.Data, &data); err != nil {
+		return "", "", err
+	}
+
+	var subject, body bytes.Buffer
+	if err := parsed.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return "", "", err
+	}
+	if err := parsed.ExecuteTemplate(&body, "layout", data); err != nil {
+		return "", "", err
+	}
+	//o assunto não é HTML: desfazendo o escape feito pelo html/template
+	return strings.TrimSpace(html.UnescapeString(subject.String())), body.String(), nil
+}
+
+// emailTemplateFuncs formats amounts and dates the way readers of locale
+// expect them
+func emailTemplateFuncs(locale string) template.FuncMap {
+	return template.FuncMap{
+		"money": func(amount float64) string {
+			if locale == "en" {
+				return strings.NewReplacer(".", ",", ",", ".").Replace(formatBRL(amount))
+			}
+			return formatBRL(amount)
+		},
+		// date formats an RFC 3339 date keeping its offset, which is the
+		// local time of the event
+		"date": func(value string) (string, error) {
+			date, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return "", err
+			}
+			if locale == "en" {
+				return date.Format("January 2, 2006 at 3:04 PM"), nil
+			}
+			return date.Format("02/01/2006 às 15:04"), nil
+		},
+	}
+}
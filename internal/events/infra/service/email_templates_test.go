@@ -0,0 +1,87 @@
+package service
+
+import (
+	"encoding/json"
+	"testing"
+
+	"go-backend-api/internal/events/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func orderConfirmationNotification(locale string) domain.Notification {
+	data, _ := json.Marshal(map[string]any{
+		"order_id":   "order-1",
+		"event_name": "Rock & Roll <Live>",
+		"event_date": "2030-01-15T20:30:00-03:00",
+		"timezone":   "America/Sao_Paulo",
+		"location":   "Allianz Parque",
+		"tickets": []map[string]any{
+			{"spot_name": "A1", "ticket_kind": "full", "attendee_name": "Maria", "price": 1234.5},
+		},
+		"total": 1234.5,
+	})
+	return domain.Notification{Kind: domain.NotificationOrderConfirmation, Locale: locale, Data: data}
+}
+
+func TestHTMLEmailTemplates_RenderEmail(t *testing.T) {
+	templates, err := NewHTMLEmailTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subject, body, err := templates.RenderEmail(orderConfirmationNotification("pt-BR"))
+	assert.Nil(t, err)
+	assert.Equal(t, "Pedido confirmado: Rock & Roll <Live>", subject)
+	assert.Contains(t, body, "Rock &amp; Roll &lt;Live&gt;")
+	assert.Contains(t, body, "15/01/2030 às 20:30")
+	assert.Contains(t, body, "R$ 1.234,50")
+	assert.Contains(t, body, "Maria")
+
+	subject, body, err = templates.RenderEmail(orderConfirmationNotification("en"))
+	assert.Nil(t, err)
+	assert.Equal(t, "Order confirmed: Rock & Roll <Live>", subject)
+	assert.Contains(t, body, "January 15, 2030 at 8:30 PM")
+	assert.Contains(t, body, "R$ 1,234.50")
+}
+
+func TestHTMLEmailTemplates_RenderEveryKind(t *testing.T) {
+	templates, err := NewHTMLEmailTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := json.Marshal(map[string]any{
+		"order_id":   "order-1",
+		"event_name": "Show",
+		"event_date": "2030-01-15T20:30:00-03:00",
+		"timezone":   "America/Sao_Paulo",
+		"location":   "Allianz Parque",
+		"tickets":    []map[string]any{{"spot_name": "A1", "ticket_kind": "full", "price": 100.0}},
+		"total":      100.0,
+		"amount":     100.0,
+	})
+
+	for _, locale := range domain.NotificationLocales {
+		for _, kind := range []domain.NotificationKind{
+			domain.NotificationOrderConfirmation,
+			domain.NotificationRefund,
+			domain.NotificationReminder,
+			domain.NotificationCancellation,
+		} {
+			subject, body, err := templates.RenderEmail(domain.Notification{Kind: kind, Locale: locale, Data: data})
+			assert.Nil(t, err, "%s %s", kind, locale)
+			assert.Contains(t, subject, "Show", "%s %s", kind, locale)
+			assert.Contains(t, body, "<!DOCTYPE html>", "%s %s", kind, locale)
+		}
+	}
+}
+
+func TestHTMLEmailTemplates_RenderUnknownKind(t *testing.T) {
+	templates, err := NewHTMLEmailTemplates()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = templates.RenderEmail(domain.Notification{Kind: "newsletter", Locale: "en", Data: []byte(`{}`)})
+	assert.ErrorIs(t, err, ErrEmailTemplateNotFound)
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"errors"
+	"go-backend-api/internal/events/domain"
+	"strings"
+)
+
+var ErrAddressNotFound = errors.New("address not found by the geocoder")
+
+// Geocoder finds the coordinates of a free text address
+type Geocoder interface {
+	Geocode(address string) (domain.GeoPoint, error)
+}
+
+// StaticGeocoder looks addresses up in a fixed table of places, ignoring
+// accents and case. An address not in the table is matched by its last
+// comma separated parts, so "Allianz Parque, São Paulo" finds "São Paulo".
+type StaticGeocoder struct {
+	places map[string]domain.GeoPoint
+}
+
+func NewStaticGeocoder(places map[string]domain.GeoPoint) *StaticGeocoder {
+	normalized := make(map[string]domain.GeoPoint, len(places))
+	for place, point := range places {
+		normalized[normalizeAddress(place)] = point
+	}
+	return &StaticGeocoder{places: normalized}
+}
+
+// DefaultGeocodingTable has the state capitals and the largest cities of
+// Brazil, enough to place the events until a geocoding provider is configured
+func DefaultGeocodingTable() map[string]domain.GeoPoint {
+	return map[string]domain.GeoPoint{
+		"São Paulo":      {Latitude: -23.5505, Longitude: -46.6333},
+		"Rio de Janeiro": {Latitude: -22.9068, Longitude: -43.1729},
+		"Belo Horizonte": {Latitude: -19.9167, Longitude: -43.9345},
+		"Uberlândia":     {Latitude: -18.9186, Longitude: -48.2772},
+		"Brasília":       {Latitude: -15.7939, Longitude: -47.8828},
+		"Curitiba":       {Latitude: -25.4284, Longitude: -49.2733},
+		"Porto Alegre":   {Latitude: -30.0346, Longitude: -51.2177},
+		"Florianópolis":  {Latitude: -27.5954, Longitude: -48.5480},
+		"Salvador":       {Latitude: -12.9777, Longitude: -38.5016},
+		"Recife":         {Latitude: -8.0476, Longitude: -34.8770},
+		"Fortaleza":      {Latitude: -3.7319, Longitude: -38.5267},
+		"Manaus":         {Latitude: -3.1190, Longitude: -60.0217},
+		"Belém":          {Latitude: -1.4558, Longitude: -48.4902},
+		"Goiânia":        {Latitude: -16.6869, Longitude: -49.2648},
+		"Campinas":       {Latitude: -22.9099, Longitude: -47.0626},
+	}
+}
+
+func (g *StaticGeocoder) Geocode(address string) (domain.GeoPoint, error) {
+	parts := strings.Split(address, ",")
+	for i := range parts {
+		if point, ok := g.places[normalizeAddress(strings.Join(parts[i:], ","))]; ok {
+			return point, nil
+		}
+	}
+	//endereços como "Centro, São Paulo, SP" terminam com a sigla do estado
+	for i := len(parts) - 1; i >= 0; i-- {
+		if point, ok := g.places[normalizeAddress(parts[i])]; ok {
+			return point, nil
+		}
+	}
+	return domain.GeoPoint{}, ErrAddressNotFound
+}
+
+func normalizeAddress(address string) string {
+	return strings.Join(strings.Fields(domain.NormalizeSearchText(address)), " ")
+}
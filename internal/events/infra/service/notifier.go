@@ -0,0 +1,54 @@
+package service
+
+import (
+	"log"
+	"time"
+)
+
+// WaitlistOffer tells a waitlisted buyer which spots are held for them
+type WaitlistOffer struct {
+	EntryID   string    `json:"entry_id"`
+	EventID   string    `json:"event_id"`
+	Email     string    `json:"email"`
+	Spots     []string  `json:"spots"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type WaitlistNotifier interface {
+	NotifyWaitlistOffer(offer WaitlistOffer) error
+}
+
+// TicketTransferOffer tells the recipient of a ticket transfer how to accept it
+type TicketTransferOffer struct {
+	TransferID     string    `json:"transfer_id"`
+	TicketID       string    `json:"ticket_id"`
+	EventID        string    `json:"event_id"`
+	FromEmail      string    `json:"from_email"`
+	Email          string    `json:"email"`
+	AcceptanceCode string    `json:"acceptance_code"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+type TicketTransferNotifier interface {
+	NotifyTicketTransfer(offer TicketTransferOffer) error
+}
+
+// LogNotifier writes the notifications to the application log. It is used
+// until a real delivery channel is configured.
+type LogNotifier struct{}
+
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+func (n *LogNotifier) NotifyWaitlistOffer(offer WaitlistOffer) error {
+	log.Printf("waitlist offer for %s: spots %v of event %s held until %s",
+		offer.Email, offer.Spots, offer.EventID, offer.ExpiresAt.Format(time.RFC3339))
+	return nil
+}
+
+func (n *LogNotifier) NotifyTicketTransfer(offer TicketTransferOffer) error {
+	log.Printf("ticket transfer for %s: ticket %s of event %s sent by %s, accept with code %s until %s",
+		offer.Email, offer.TicketID, offer.EventID, offer.FromEmail, offer.AcceptanceCode, offer.ExpiresAt.Format(time.RFC3339))
+	return nil
+}
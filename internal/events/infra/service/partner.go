@@ -1,5 +1,13 @@
 package service
 
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var ErrUnsupportedTicketKind = errors.New("ticket kind not supported by partner")
+
 type ReservationRequest struct {
 	EventID    string   `json:"event_id"`
 	Spots      []string `json:"spots"`
@@ -19,4 +27,15 @@ type ReservationResponse struct {
 
 type Partner interface {
 	MakeReservation(req *ReservationRequest) ([]ReservationResponse, error)
-}
\ No newline at end of file
+	// SupportedTicketKinds returns the ticket kinds the partner API accepts
+	SupportedTicketKinds() []string
+}
+
+// CheckTicketKind rejects ticket kinds outside the partner vocabulary instead
+// of letting the partner coerce them into something else.
+func CheckTicketKind(p Partner, kind string) error {
+	if !slices.Contains(p.SupportedTicketKinds(), kind) {
+		return fmt.Errorf("%w: %q", ErrUnsupportedTicketKind, kind)
+	}
+	return nil
+}
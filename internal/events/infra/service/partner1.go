@@ -7,6 +7,9 @@ import (
 	"net/http"
 )
 
+// Partner1TicketKinds is the ticket kind vocabulary of the Partner1 API
+var Partner1TicketKinds = []string{"full", "half"}
+
 type Partner1 struct {
 	BaseURL string
 }
@@ -26,7 +29,15 @@ type Partner1ReservationResponse struct {
 	EventID string `json:"event_id"`
 }
 
+func (p *Partner1) SupportedTicketKinds() []string {
+	return Partner1TicketKinds
+}
+
 func (p *Partner1) MakeReservation(req *ReservationRequest) ([]ReservationResponse, error) {
+	if err := CheckTicketKind(p, req.TicketKind); err != nil {
+		return nil, err
+	}
+
 	partnerReq := Partner1ReservationRequest{
 		Spots: req.Spots,
 		TicketKind: req.TicketKind,
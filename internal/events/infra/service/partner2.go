@@ -7,6 +7,9 @@ import (
 	"net/http"
 )
 
+// Partner2TicketKinds is the ticket kind vocabulary of the Partner2 API
+var Partner2TicketKinds = []string{"full", "half"}
+
 type Partner2 struct {
 	BaseURL string
 }
@@ -26,17 +29,18 @@ type Partner2ReservationResponse struct {
 	EventID string `json:"event_id"`
 }
 
+func (p *Partner2) SupportedTicketKinds() []string {
+	return Partner2TicketKinds
+}
+
 func (p *Partner2) MakeReservation(req *ReservationRequest) ([]ReservationResponse, error) {
-	TicketKind := req.TicketKind
-	if TicketKind == "full" {
-		TicketKind = "full"
-	} else {
-		TicketKind = "half"
+	if err := CheckTicketKind(p, req.TicketKind); err != nil {
+		return nil, err
 	}
 
 	partnerReq := Partner2ReservationRequest{
 		Spots: req.Spots,
-		TicketKind: TicketKind,
+		TicketKind: req.TicketKind,
 		Email: req.Email,
 	}
 
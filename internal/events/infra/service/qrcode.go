@@ -0,0 +1,32 @@
+package service
+
+import (
+	"errors"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+var ErrQRCodeSizeInvalid = errors.New("qr code size must be between 64 and 1024 pixels")
+
+const DefaultQRCodeSize = 320
+
+// QRCodeEncoder renders content as a PNG QR code of size by size pixels
+type QRCodeEncoder interface {
+	EncodePNG(content string, size int) ([]byte, error)
+}
+
+// PNGQRCodeEncoder renders QR codes with medium error correction, which
+// still scans from a cracked phone screen without making the code too dense
+// for the signed credentials.
+type PNGQRCodeEncoder struct{}
+
+func NewPNGQRCodeEncoder() *PNGQRCodeEncoder {
+	return &PNGQRCodeEncoder{}
+}
+
+func (e *PNGQRCodeEncoder) EncodePNG(content string, size int) ([]byte, error) {
+	if size < 64 || size > 1024 {
+		return nil, ErrQRCodeSizeInvalid
+	}
+	return qrcode.Encode(content, qrcode.Medium, size)
+}
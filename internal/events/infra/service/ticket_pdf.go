@@ -0,0 +1,155 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go-backend-api/internal/events/domain"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+var ErrNoTicketsToPrint = errors.New("there are no tickets to print")
+
+// PrintedTicket is a ticket with its event and the QR code of its credential
+type PrintedTicket struct {
+	Event     domain.Event
+	Ticket    domain.Ticket
+	QRCodePNG []byte
+}
+
+// TicketPDFRenderer prints tickets as a PDF document
+type TicketPDFRenderer interface {
+	RenderTickets(template domain.TicketTemplate, tickets []PrintedTicket) ([]byte, error)
+}
+
+// GofpdfTicketRenderer prints tickets on A4 pages with the core PDF fonts,
+// so no font files or external services are needed. The page layout prints
+// one ticket per page and the compact layout three.
+type GofpdfTicketRenderer struct{}
+
+func NewGofpdfTicketRenderer() *GofpdfTicketRenderer {
+	return &GofpdfTicketRenderer{}
+}
+
+const (
+	ticketPageMargin    = 15.0
+	ticketPageHeight    = 130.0
+	ticketCompactHeight = 85.0
+	ticketQRCodeSize    = 55.0
+)
+
+func (r *GofpdfTicketRenderer) RenderTickets(template domain.TicketTemplate, tickets []PrintedTicket) ([]byte, error) {
+	if len(tickets) == 0 {
+		return nil, ErrNoTicketsToPrint
+	}
+	accentR, accentG, accentB, err := template.AccentRGB()
+	if err != nil {
+		return nil, err
+	}
+
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(ticketPageMargin, ticketPageMargin, ticketPageMargin)
+	pdf.SetAutoPageBreak(false, ticketPageMargin)
+	pdf.SetTitle(tickets[0].Event.Name, true)
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	height, perPage := ticketPageHeight, 1
+	if template.Layout == domain.TicketTemplateLayoutCompact {
+		height, perPage = ticketCompactHeight, 3
+	}
+	pageWidth, _ := pdf.GetPageSize()
+	width := pageWidth - 2*ticketPageMargin
+
+	for i, printed := range tickets {
+		if i%perPage == 0 {
+			pdf.AddPage()
+		}
+		y := ticketPageMargin + float64(i%perPage)*(height+5)
+
+		//faixa com o cabeçalho na cor da organização
+		pdf.SetFillColor(accentR, accentG, accentB)
+		pdf.Rect(ticketPageMargin, y, width, 12, "F")
+		pdf.SetTextColor(255, 255, 255)
+		pdf.SetFont("Helvetica", "B", 12)
+		pdf.SetXY(ticketPageMargin+4, y)
+		pdf.CellFormat(width-8, 12, tr(template.HeaderText), "", 0, "L", false, 0, "")
+
+		pdf.SetDrawColor(accentR, accentG, accentB)
+		pdf.SetLineWidth(0.4)
+		pdf.Rect(ticketPageMargin, y, width, height, "D")
+
+		textWidth := width - ticketQRCodeSize - 14
+		pdf.SetTextColor(17, 24, 39)
+		pdf.SetFont("Helvetica", "B", 16)
+		pdf.SetXY(ticketPageMargin+4, y+16)
+		pdf.MultiCell(textWidth, 7, tr(printed.Event.Name), "", "L", false)
+
+		pdf.SetFont("Helvetica", "", 10)
+		for _, line := range ticketLines(template, printed) {
+			pdf.SetX(ticketPageMargin + 4)
+			pdf.SetFont("Helvetica", "B", 10)
+			pdf.CellFormat(28, 6, tr(line[0]), "", 0, "L", false, 0, "")
+			pdf.SetFont("Helvetica", "", 10)
+			pdf.MultiCell(textWidth-28, 6, tr(line[1]), "", "L", false)
+		}
+
+		qrName := "qr-" + printed.Ticket.ID
+		pdf.RegisterImageOptionsReader(qrName, gofpdf.ImageOptions{ImageType: "PNG"}, bytes.NewReader(printed.QRCodePNG))
+		pdf.ImageOptions(qrName, ticketPageMargin+width-ticketQRCodeSize-5, y+16, ticketQRCodeSize, ticketQRCodeSize, false,
+			gofpdf.ImageOptions{ImageType: "PNG"}, 0, "")
+
+		pdf.SetTextColor(107, 114, 128)
+		pdf.SetFont("Helvetica", "", 7)
+		pdf.SetXY(ticketPageMargin+width-ticketQRCodeSize-5, y+17+ticketQRCodeSize)
+		pdf.CellFormat(ticketQRCodeSize, 4, printed.Ticket.ID, "", 0, "C", false, 0, "")
+
+		if template.FooterText != "" {
+			pdf.SetFont("Helvetica", "I", 8)
+			pdf.SetXY(ticketPageMargin+4, y+height-12)
+			pdf.MultiCell(width-8, 4, tr(template.FooterText), "", "L", false)
+		}
+	}
+
+	var buffer bytes.Buffer
+	if err := pdf.Output(&buffer); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// ticketLines returns the labelled details printed on the ticket
+func ticketLines(template domain.TicketTemplate, printed PrintedTicket) [][2]string {
+	event, ticket := printed.Event, printed.Ticket
+	lines := [][2]string{
+		{"Data", event.LocalDate().Format("02/01/2006 15:04") + " (" + event.Timezone + ")"},
+		{"Local", event.Location},
+	}
+	if ticket.Spot != nil {
+		lines = append(lines, [2]string{"Lugar", ticket.Spot.Name})
+	}
+	lines = append(lines, [2]string{"Tipo", string(ticket.TicketKind)})
+	if template.ShowPrice {
+		lines = append(lines, [2]string{"Valor", formatBRL(ticket.Total())})
+	}
+	if ticket.AttendeeName != "" {
+		lines = append(lines, [2]string{"Participante", ticket.AttendeeName})
+	}
+	return lines
+}
+
+// formatBRL formats an amount in reais, as R$ 1.234,56
+func formatBRL(amount float64) string {
+	cents := int64(amount*100 + 0.5)
+	integer, fraction := fmt.Sprint(cents/100), cents%100
+
+	var grouped strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped.WriteByte('.')
+		}
+		grouped.WriteRune(digit)
+	}
+	return fmt.Sprintf("R$ %s,%02d", grouped.String(), fraction)
+}
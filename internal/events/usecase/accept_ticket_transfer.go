@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+type AcceptTicketTransferInputDto struct {
+	TicketID string      `json:"-"`
+	Email    string      `json:"email"`
+	Code     string      `json:"code"`
+	Attendee AttendeeDto `json:"attendee"`
+}
+
+type AcceptTicketTransferOutputDto struct {
+	Transfer TicketTransferDto `json:"transfer"`
+	Ticket   TicketDto         `json:"ticket"`
+}
+
+type AcceptTicketTransferUseCase struct {
+	repo       domain.EventRepository
+	ticketRepo domain.TicketRepository
+	keyRing    *domain.CredentialKeyRing
+}
+
+func NewAcceptTicketTransferUseCase(repo domain.EventRepository, ticketRepo domain.TicketRepository, keyRing *domain.CredentialKeyRing) *AcceptTicketTransferUseCase {
+	return &AcceptTicketTransferUseCase{
+		repo:       repo,
+		ticketRepo: ticketRepo,
+		keyRing:    keyRing,
+	}
+}
+
+func (uc *AcceptTicketTransferUseCase) Execute(input AcceptTicketTransferInputDto) (*AcceptTicketTransferOutputDto, error) {
+	now := time.Now()
+
+	transfer, err := findOpenTicketTransfer(uc.ticketRepo, input.TicketID, now)
+	if err != nil {
+		return nil, err
+	}
+	ticket, err := uc.ticketRepo.GetTicketByID(transfer.TicketID)
+	if err != nil {
+		return nil, err
+	}
+	event, err := uc.repo.GetEventByID(ticket.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	//o destinatário informa quem vai usar o ingresso, com a idade conferida como na compra
+	birthDate, err := domain.ParseBirthDate(input.Attendee.BirthDate)
+	if err != nil {
+		return nil, err
+	}
+	attendee, err := domain.CreatedNewAttendee(input.Attendee.Name, input.Attendee.Document, birthDate)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := transfer.Accept(*event, ticket, input.Email, input.Code, *attendee, now); err != nil {
+		return nil, err
+	}
+	if err := uc.ticketRepo.AcceptTicketTransfer(transfer, ticket); err != nil {
+		return nil, err
+	}
+
+	return &AcceptTicketTransferOutputDto{
+		Transfer: newTicketTransferDto(*transfer),
+		Ticket:   newIssuedTicketDto(uc.keyRing, *ticket, now),
+	}, nil
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+type EventStatusChangeDto struct {
+	EventID string `json:"event_id"`
+	From    string `json:"from"`
+	To      string `json:"to"`
+}
+
+type AdvanceEventLifecycleOutputDto struct {
+	Changes []EventStatusChangeDto `json:"changes"`
+}
+
+// AdvanceEventLifecycleUseCase publishes and opens the sales of the events
+// whose scheduled time arrived and finishes the events that have started.
+type AdvanceEventLifecycleUseCase struct {
+	repo domain.EventRepository
+}
+
+func NewAdvanceEventLifecycleUseCase(repo domain.EventRepository) *AdvanceEventLifecycleUseCase {
+	return &AdvanceEventLifecycleUseCase{repo: repo}
+}
+
+func (uc *AdvanceEventLifecycleUseCase) Execute() (*AdvanceEventLifecycleOutputDto, error) {
+	now := time.Now()
+
+	events, err := uc.repo.FindEventsDueForLifecycle(now)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &AdvanceEventLifecycleOutputDto{Changes: []EventStatusChangeDto{}}
+	for i := range events {
+		event := &events[i]
+		previous := event.Status
+		if !event.AdvanceLifecycle(now) {
+			continue
+		}
+		err := uc.repo.UpdateEventLifecycle(event, previous)
+		if errors.Is(err, domain.ErrEventStatusConflict) {
+			//o status mudou desde a busca; o evento é avaliado de novo na próxima execução
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		output.Changes = append(output.Changes, EventStatusChangeDto{
+			EventID: event.ID,
+			From:    string(previous),
+			To:      string(event.Status),
+		})
+	}
+
+	return output, nil
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+type ArchivePastEventsOutputDto struct {
+	EventIDs []string `json:"event_ids"`
+}
+
+// ArchivePastEventsUseCase takes the finished and cancelled events out of
+// the event listing domain.EventArchiveAfter after their date. Archived
+// events keep their tickets and are still found by ID.
+type ArchivePastEventsUseCase struct {
+	repo domain.EventRepository
+}
+
+func NewArchivePastEventsUseCase(repo domain.EventRepository) *ArchivePastEventsUseCase {
+	return &ArchivePastEventsUseCase{repo: repo}
+}
+
+func (uc *ArchivePastEventsUseCase) Execute() (*ArchivePastEventsOutputDto, error) {
+	now := time.Now()
+
+	events, err := uc.repo.FindEventsToArchive(now.Add(-domain.EventArchiveAfter))
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ArchivePastEventsOutputDto{EventIDs: []string{}}
+	for i := range events {
+		event := &events[i]
+		if !event.Archive(now) {
+			continue
+		}
+		if err := uc.repo.ArchiveEvent(event); err != nil {
+			return nil, err
+		}
+		output.EventIDs = append(output.EventIDs, event.ID)
+	}
+
+	return output, nil
+}
@@ -1,6 +1,10 @@
 package usecase
 
 import (
+	"errors"
+	"log"
+	"time"
+
 	"go-backend-api/internal/events/domain"
 	"go-backend-api/internal/events/infra/service"
 )
@@ -11,9 +15,28 @@ type BuyTicketsInputDto struct {
 	TicketKind string `json:"ticket_kind"`
 	CardHash string `json:"card_hash"`
 	Email string `json:"email"`
+	CouponCode string `json:"coupon_code"`
+	EligibilityDocuments map[string]string `json:"eligibility_documents"`
+	Attendees []AttendeeDto `json:"attendees"`
+	BuyerBirthDate string `json:"buyer_birth_date"`
+	// Locale is the language of the confirmation email, such as "pt-BR" or "en"
+	Locale string `json:"locale"`
+	IPAddress string `json:"-"`
+}
+
+// AttendeeDto is the person who uses the ticket of the spot in the same
+// position of Spots. BirthDate, in the YYYY-MM-DD format, defaults to the
+// buyer birth date and is required when the event has an age rating.
+// Attendees are optional for events rated L, whose tickets are then used by
+// the buyer.
+type AttendeeDto struct {
+	Name string `json:"name"`
+	Document string `json:"document"`
+	BirthDate string `json:"birth_date"`
 }
 
 type BuyTicketsOutputDto struct {
+	OrderID string `json:"order_id"`
 	Tickets []TicketDto `json:"tickets"`
 }
 
@@ -23,17 +46,48 @@ type TicketDto struct {
 	EventID string `json:"event_id"`
 	TicketKind string `json:"ticket_kind"`
 	Price float64 `json:"price"`
+	Discount float64 `json:"discount"`
+	AttendeeName string `json:"attendee_name"`
+	AttendeeDocument string `json:"attendee_document"`
+	HolderEmail string `json:"holder_email"`
+	CredentialVersion int `json:"credential_version"`
+	Credential string `json:"credential,omitempty"`
+}
+
+func newTicketDto(ticket domain.Ticket) TicketDto {
+	return TicketDto{
+		ID: ticket.ID,
+		SpotID: ticket.Spot.ID,
+		EventID: ticket.EventID,
+		TicketKind: string(ticket.TicketKind),
+		Price: ticket.Price,
+		Discount: ticket.Discount,
+		AttendeeName: ticket.AttendeeName,
+		AttendeeDocument: ticket.AttendeeDocument,
+		HolderEmail: ticket.HolderEmail,
+		CredentialVersion: ticket.CredentialVersion,
+	}
 }
 
 type BuyTicketsUseCase struct {
 	repo domain.EventRepository
+	couponRepo domain.CouponRepository
+	waitlistRepo domain.WaitlistRepository
+	notificationRepo domain.NotificationRepository
 	partnerFactory service.PartnerFactory
+	velocityPolicy domain.VelocityPolicy
+	keyRing *domain.CredentialKeyRing
 }
 
-func NewBuyTicketsUseCase(repo domain.EventRepository, partnerFactory service.PartnerFactory) *BuyTicketsUseCase {
+func NewBuyTicketsUseCase(repo domain.EventRepository, couponRepo domain.CouponRepository, waitlistRepo domain.WaitlistRepository, notificationRepo domain.NotificationRepository, partnerFactory service.PartnerFactory, velocityPolicy domain.VelocityPolicy, keyRing *domain.CredentialKeyRing) *BuyTicketsUseCase {
 	return &BuyTicketsUseCase{
 		repo: repo, 
+		couponRepo: couponRepo,
+		waitlistRepo: waitlistRepo,
+		notificationRepo: notificationRepo,
 		partnerFactory: partnerFactory,
+		velocityPolicy: velocityPolicy,
+		keyRing: keyRing,
 	}
 }
 
@@ -42,12 +96,73 @@ func (uc *BuyTicketsUseCase) Execute(input BuyTicketsInputDto) (*BuyTicketsOutpu
 	if err != nil {
 		return nil, err
 	}
+	if err := event.CheckOnSale(time.Now()); err != nil {
+		return nil, err
+	}
+
+	//criando o pedido, que normaliza e valida o email do comprador
+	order, err := domain.CreatedNewOrder(event.ID, input.Email, input.CardHash, input.IPAddress)
+	if err != nil {
+		return nil, err
+	}
+	input.Email = order.Email
+
+	if err := uc.checkVelocity(order); err != nil {
+		return nil, err
+	}
+
+	//validando a categoria do ingresso e os requisitos do comprador
+	category, err := event.TicketCategory(domain.TicketKind(input.TicketKind))
+	if err != nil {
+		return nil, err
+	}
+	if err := category.CheckEligibility(input.EligibilityDocuments); err != nil {
+		return nil, err
+	}
+
+	//cada ingresso tem um participante com idade suficiente para a classificação do evento
+	attendees, err := uc.attendeesBySpot(input, event)
+	if err != nil {
+		return nil, err
+	}
+
+	//verificando capacidade e cota antes de reservar no parceiro
+	sales, err := uc.repo.CountTicketSales(event.ID, order.Email)
+	if err != nil {
+		return nil, err
+	}
+	if err := event.CheckTicketSale(category.Kind, len(input.Spots), sales); err != nil {
+		return nil, err
+	}
+
+	//lugares reservados pela fila de espera só podem ser comprados por quem recebeu a oferta
+	spots := make(map[string]*domain.Spot, len(input.Spots))
+	for _, name := range input.Spots {
+		spot, err := uc.repo.FindSpotByName(event.ID, name)
+		if err != nil {
+			return nil, err
+		}
+		if err := spot.CheckPurchasableBy(order.Email, time.Now()); err != nil {
+			return nil, err
+		}
+		spots[spot.Name] = spot
+	}
+
+	//o cupom é conferido antes da reserva; o uso é registrado junto com a venda
+	var coupon *domain.Coupon
+	var redemption *domain.CouponRedemption
+	if input.CouponCode != "" {
+		coupon, redemption, err = uc.prepareCouponRedemption(input, event)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	//criar a solicitação de reserva
 	reserver := &service.ReservationRequest{
 		EventID: input.EventID,
 		Spots: input.Spots,
-		TicketKind: input.TicketKind,
+		TicketKind: category.PartnerKind(),
 		CardHash: input.CardHash,
 		Email: input.Email,
 		
@@ -68,39 +183,166 @@ func (uc *BuyTicketsUseCase) Execute(input BuyTicketsInputDto) (*BuyTicketsOutpu
 	//salvando os tickets no banco de dados
 	tickets := make([]domain.Ticket, len(reservationResponse))
 	for i, reservation := range reservationResponse {
-		spot, err := uc.repo.FindSpotByName(event.ID, reservation.Spot)
-		if err != nil {
-			return nil, err
+		spot, ok := spots[reservation.Spot]
+		if !ok {
+			spot, err = uc.repo.FindSpotByName(event.ID, reservation.Spot)
+			if err != nil {
+				return nil, err
+			}
 		}
 
-		ticket, err := domain.CreatedNewTicket(event, spot, domain.TicketStatus(input.TicketKind))
+		ticket, err := domain.CreatedNewTicket(event, spot, category.Kind)
 		if err != nil {
 			return nil, err
 		}
+		ticket.OrderID = order.ID
+		ticket.HolderEmail = order.Email
+		ticket.AssignAttendee(attendees[spot.Name])
+		if coupon != nil {
+			ticket.ApplyCoupon(coupon)
+		}
 
-		err = uc.repo.CreateTicket(ticket)
+		spot.ReserveSpot(ticket.ID)
+		tickets[i] = *ticket
+	}
+
+	//a capacidade, a cota e os limites do cupom são conferidos de novo dentro da transação
+	err = uc.repo.SellTickets(order, tickets, redemption, func(sales domain.TicketSales) error {
+		return event.CheckTicketSale(category.Kind, len(tickets), sales)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	//a venda já foi concluída, uma falha aqui não deve ser devolvida ao comprador
+	if err := uc.fulfillWaitlistOffer(event.ID, order.Email); err != nil {
+		log.Printf("Erro ao encerrar a oferta da fila de espera de %s: %v\n", order.Email, err)
+	}
+	if err := updateSoldOut(uc.repo, event.ID); err != nil {
+		log.Printf("Erro ao atualizar o status de esgotado do evento %s: %v\n", event.ID, err)
+	}
+	if err := uc.queueOrderConfirmation(order, event, tickets, input.Locale); err != nil {
+		log.Printf("Erro ao agendar o email de confirmação do pedido %s: %v\n", order.ID, err)
+	}
+
+	//cada ingresso sai com a credencial assinada que é apresentada na entrada
+	now := time.Now()
+	ticketDto := make([]TicketDto, len(tickets))
+	for i, ticket := range tickets {
+		ticketDto[i] = newIssuedTicketDto(uc.keyRing, ticket, now)
+	}
+	
+	return &BuyTicketsOutputDto{OrderID: order.ID, Tickets: ticketDto}, nil
+}
+
+//attendeesBySpot valida os participantes e os associa ao nome do lugar de cada um.
+//Sem classificação etária os participantes são opcionais e os ingressos ficam
+//com o comprador, que já é o titular
+func (uc *BuyTicketsUseCase) attendeesBySpot(input BuyTicketsInputDto, event *domain.Event) (map[string]domain.Attendee, error) {
+	buyerBirthDate, err := domain.ParseBirthDate(input.BuyerBirthDate)
+	if err != nil {
+		return nil, err
+	}
+	if len(input.Attendees) == 0 && event.Rating.MinimumAge() == 0 {
+		return map[string]domain.Attendee{}, nil
+	}
+	if len(input.Attendees) != len(input.Spots) {
+		return nil, domain.ErrAttendeesMismatch
+	}
+
+	attendees := make(map[string]domain.Attendee, len(input.Spots))
+	for i, dto := range input.Attendees {
+		birthDate, err := domain.ParseBirthDate(dto.BirthDate)
 		if err != nil {
 			return nil, err
 		}
-
-		spot.ReserveSpot(ticket.ID)
-		err = uc.repo.ReserveSpot(spot.ID, ticket.ID)
+		if birthDate.IsZero() {
+			birthDate = buyerBirthDate
+		}
+		attendee, err := domain.CreatedNewAttendee(dto.Name, dto.Document, birthDate)
 		if err != nil {
 			return nil, err
 		}
+		if err := event.CheckAttendeeAge(*attendee); err != nil {
+			return nil, err
+		}
+		attendees[input.Spots[i]] = *attendee
+	}
+	return attendees, nil
+}
 
-		tickets[i] = *ticket
+//checkVelocity recusa cartões ou IPs usados por muitos emails diferentes
+func (uc *BuyTicketsUseCase) checkVelocity(order *domain.Order) error {
+	since := time.Now().Add(-uc.velocityPolicy.Window)
+	emailsByCard, err := uc.repo.FindBuyerEmailsByCardHash(order.CardHash, since)
+	if err != nil {
+		return err
 	}
+	emailsByIP, err := uc.repo.FindBuyerEmailsByIP(order.IPAddress, since)
+	if err != nil {
+		return err
+	}
+	return uc.velocityPolicy.Check(order.Email, emailsByCard, emailsByIP)
+}
 
-	ticketDto := make([]TicketDto, len(tickets))
-	for i, ticket := range tickets {
-		ticketDto[i] = TicketDto{
-			ID: ticket.ID,
-			SpotID: ticket.Spot.ID,
-			TicketKind: string(ticket.TicketKind),
-			Price: ticket.Price,
+//prepareCouponRedemption confere se o cupom vale para a compra e cria o resgate que a venda registra
+func (uc *BuyTicketsUseCase) prepareCouponRedemption(input BuyTicketsInputDto, event *domain.Event) (*domain.Coupon, *domain.CouponRedemption, error) {
+	coupon, err := uc.couponRepo.GetCouponByCode(input.CouponCode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	quantity := len(input.Spots)
+	if err := coupon.CheckApplicable(event.ID, domain.TicketKind(input.TicketKind), quantity, time.Now()); err != nil {
+		return nil, nil, err
+	}
+	return coupon, domain.CreatedNewCouponRedemption(coupon, input.Email, quantity), nil
+}
+
+//updateSoldOut marca o evento como esgotado quando não sobram ingressos e o devolve à venda
+//quando lugares são liberados ou criados. Roda depois de toda mudança na venda ou nos lugares
+func updateSoldOut(repo domain.EventRepository, eventID string) error {
+	event, err := repo.GetEventByID(eventID)
+	if err != nil {
+		return err
+	}
+	sales, err := repo.CountTicketSales(event.ID, "")
+	if err != nil {
+		return err
+	}
+	previous := event.Status
+	if !event.UpdateSoldOut(sales) {
+		return nil
+	}
+	err = repo.UpdateEventLifecycle(event, previous)
+	if errors.Is(err, domain.ErrEventStatusConflict) {
+		//outra requisição já mudou o status
+		return nil
+	}
+	return err
+}
+
+//fulfillWaitlistOffer encerra a oferta da fila de espera do comprador, se houver
+func (uc *BuyTicketsUseCase) fulfillWaitlistOffer(eventID, email string) error {
+	entries, err := uc.waitlistRepo.FindWaitlistEntriesByEventID(eventID)
+	if err != nil {
+		return err
+	}
+	for i := range entries {
+		if entries[i].Email != email || entries[i].Status != domain.WaitlistStatusOffered {
+			continue
+		}
+		if err := entries[i].Fulfill(); err != nil {
+			return err
 		}
+		return uc.waitlistRepo.UpdateWaitlistEntry(&entries[i])
 	}
-	
-	return &BuyTicketsOutputDto{Tickets: ticketDto}, nil
-}
\ No newline at end of file
+	return nil
+}
+
+//queueOrderConfirmation agenda o email com os ingressos do pedido para o comprador
+func (uc *BuyTicketsUseCase) queueOrderConfirmation(order *domain.Order, event *domain.Event, tickets []domain.Ticket, locale string) error {
+	data := newEventEmailData(*event, tickets)
+	data.OrderID = order.ID
+	return queueNotification(uc.notificationRepo, domain.NotificationOrderConfirmation, locale, order.Email, order.ID, data)
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+type CancelTicketTransferInputDto struct {
+	TicketID string `json:"-"`
+	Email    string `json:"email"`
+}
+
+type CancelTicketTransferUseCase struct {
+	ticketRepo domain.TicketRepository
+}
+
+func NewCancelTicketTransferUseCase(ticketRepo domain.TicketRepository) *CancelTicketTransferUseCase {
+	return &CancelTicketTransferUseCase{ticketRepo: ticketRepo}
+}
+
+func (uc *CancelTicketTransferUseCase) Execute(input CancelTicketTransferInputDto) (*TicketTransferDto, error) {
+	now := time.Now()
+
+	transfer, err := findOpenTicketTransfer(uc.ticketRepo, input.TicketID, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := transfer.Cancel(input.Email, now); err != nil {
+		return nil, err
+	}
+	if err := uc.ticketRepo.UpdateTicketTransfer(transfer); err != nil {
+		return nil, err
+	}
+
+	output := newTicketTransferDto(*transfer)
+	return &output, nil
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"log"
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+type ChangeEventStatusInputDto struct {
+	EventID string `json:"-"`
+	Status  string `json:"status"`
+}
+
+type ChangeEventStatusUseCase struct {
+	repo             domain.EventRepository
+	ticketRepo       domain.TicketRepository
+	notificationRepo domain.NotificationRepository
+}
+
+func NewChangeEventStatusUseCase(repo domain.EventRepository, ticketRepo domain.TicketRepository, notificationRepo domain.NotificationRepository) *ChangeEventStatusUseCase {
+	return &ChangeEventStatusUseCase{
+		repo:             repo,
+		ticketRepo:       ticketRepo,
+		notificationRepo: notificationRepo,
+	}
+}
+
+func (uc *ChangeEventStatusUseCase) Execute(input ChangeEventStatusInputDto) (*EventDto, error) {
+	event, err := uc.repo.GetEventByID(input.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := event.Status
+	if err := event.TransitionTo(domain.EventStatus(input.Status), time.Now()); err != nil {
+		return nil, err
+	}
+	//um evento esgotado só volta à venda se ainda houver ingressos
+	if previous == domain.EventStatusSoldOut && event.Status == domain.EventStatusOnSale {
+		sales, err := uc.repo.CountTicketSales(event.ID, "")
+		if err != nil {
+			return nil, err
+		}
+		if event.IsSoldOut(sales) {
+			return nil, domain.ErrEventStillSoldOut
+		}
+	}
+	//a troca só é gravada se ninguém mudou o status desde a leitura
+	if err := uc.repo.UpdateEventLifecycle(event, previous); err != nil {
+		return nil, err
+	}
+
+	//o cancelamento já foi gravado, uma falha aqui não deve ser devolvida ao organizador
+	if event.Status == domain.EventStatusCancelled {
+		if err := uc.queueCancellations(event); err != nil {
+			log.Printf("Erro ao agendar os emails de cancelamento do evento %s: %v\n", event.ID, err)
+		}
+	}
+
+	output := newEventDto(*event)
+	return &output, nil
+}
+
+//queueCancellations avisa cada portador de ingresso sobre o cancelamento do evento
+func (uc *ChangeEventStatusUseCase) queueCancellations(event *domain.Event) error {
+	tickets, err := uc.ticketRepo.FindTicketsByEventID(event.ID)
+	if err != nil {
+		return err
+	}
+	holders, byHolder := ticketsByHolder(tickets)
+	for _, holder := range holders {
+		data := newEventEmailData(*event, byHolder[holder])
+		err := queueNotification(uc.notificationRepo, domain.NotificationCancellation, domain.DefaultNotificationLocale, holder, event.ID+":"+holder, data)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+type CheckInInputDto struct {
+	Credential string `json:"credential"`
+	Gate       string `json:"gate"`
+	EventID    string `json:"event_id"`
+	DeviceID   string `json:"device_id"`
+}
+
+type CheckInScanDto struct {
+	CheckedInAt time.Time `json:"checked_in_at"`
+	Gate        string    `json:"gate"`
+}
+
+type CheckInOutputDto struct {
+	Result       string          `json:"result"`
+	TicketID     string          `json:"ticket_id"`
+	EventID      string          `json:"event_id"`
+	SpotName     string          `json:"spot_name"`
+	AttendeeName string          `json:"attendee_name"`
+	CheckedInAt  time.Time       `json:"checked_in_at"`
+	Gate         string          `json:"gate"`
+	Replaced     *CheckInScanDto `json:"replaced,omitempty"`
+}
+
+type CheckInTicketUseCase struct {
+	scanner ticketScanner
+}
+
+func NewCheckInTicketUseCase(repo domain.EventRepository, ticketRepo domain.TicketRepository, keyRing *domain.CredentialKeyRing) *CheckInTicketUseCase {
+	return &CheckInTicketUseCase{
+		scanner: ticketScanner{repo: repo, ticketRepo: ticketRepo, keyRing: keyRing},
+	}
+}
+
+func (uc *CheckInTicketUseCase) Execute(input CheckInInputDto) (*CheckInOutputDto, error) {
+	now := time.Now()
+	scan, err := domain.CreatedNewTicketScan(input.Gate, input.DeviceID, domain.ScanSourceOnline, now, now)
+	if err != nil {
+		return nil, err
+	}
+	return uc.scanner.checkIn(scan, input.Credential, input.EventID)
+}
+
+// ticketScanner checks tickets in from their credentials, for the scans
+// made online and the ones synced by offline scanners
+type ticketScanner struct {
+	repo       domain.EventRepository
+	ticketRepo domain.TicketRepository
+	keyRing    *domain.CredentialKeyRing
+}
+
+// checkIn verifies the credential and marks its ticket as used. Every scan
+// is recorded, including the rejected ones.
+func (s ticketScanner) checkIn(scan *domain.TicketScan, credential, eventID string) (*CheckInOutputDto, error) {
+	scan.EventID = eventID
+	output, err := s.checkInTicket(scan, credential, eventID)
+	if err != nil {
+		scan.Resolve(err)
+		if recordErr := s.ticketRepo.RecordTicketScan(scan); recordErr != nil {
+			log.Printf("Erro ao registrar a leitura %s do ingresso %s: %v\n", scan.ID, scan.TicketID, recordErr)
+		}
+		return nil, err
+	}
+	return output, nil
+}
+
+func (s ticketScanner) checkInTicket(scan *domain.TicketScan, token, eventID string) (*CheckInOutputDto, error) {
+	credential, err := s.keyRing.Verify(token)
+	if err != nil {
+		return nil, err
+	}
+	scan.TicketID = credential.TicketID
+	scan.EventID = credential.EventID
+	if eventID != "" && credential.EventID != eventID {
+		return nil, domain.ErrCheckInEventMismatch
+	}
+
+	ticket, err := s.ticketRepo.GetTicketByID(credential.TicketID)
+	if err != nil {
+		return nil, err
+	}
+	if err := credential.CheckTicket(*ticket); err != nil {
+		return nil, err
+	}
+	event, err := s.repo.GetEventByID(ticket.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if err := event.CheckCheckInOpen(); err != nil {
+		return nil, err
+	}
+
+	//uma leitura offline anterior substitui a entrada já registrada
+	var replaced *CheckInScanDto
+	if ticket.CheckedInAt != nil {
+		replaced = &CheckInScanDto{CheckedInAt: *ticket.CheckedInAt, Gate: ticket.CheckedInGate}
+	}
+	if err := ticket.CheckIn(scan.Gate, scan.ScannedAt); err != nil {
+		return nil, err
+	}
+	scan.Resolve(nil)
+	if err := s.ticketRepo.CheckInTicket(ticket, scan); err != nil {
+		return nil, err
+	}
+
+	return &CheckInOutputDto{
+		Result:       string(domain.ScanResultAccepted),
+		TicketID:     ticket.ID,
+		EventID:      ticket.EventID,
+		SpotName:     ticket.Spot.Name,
+		AttendeeName: ticket.AttendeeName,
+		CheckedInAt:  *ticket.CheckedInAt,
+		Gate:         ticket.CheckedInGate,
+		Replaced:     replaced,
+	}, nil
+}
+
+// isSameCheckIn reports whether err rejects the scan that made the
+// check-in, as when a scanner syncs the same scans again
+func isSameCheckIn(err error, scan *domain.TicketScan) bool {
+	var duplicate *domain.DuplicateCheckInError
+	return errors.As(err, &duplicate) && duplicate.CheckedInAt.Equal(scan.ScannedAt) && duplicate.Gate == scan.Gate
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+type CreateCouponInputDto struct {
+	Code            string    `json:"code"`
+	DiscountType    string    `json:"discount_type"`
+	Value           float64   `json:"value"`
+	ValidFrom       time.Time `json:"valid_from"`
+	ValidUntil      time.Time `json:"valid_until"`
+	MaxUses         int       `json:"max_uses"`
+	MaxUsesPerEmail int       `json:"max_uses_per_email"`
+	EventIDs        []string  `json:"event_ids"`
+	TicketKinds     []string  `json:"ticket_kinds"`
+}
+
+type CreateCouponUseCase struct {
+	repo domain.CouponRepository
+}
+
+func NewCreateCouponUseCase(repo domain.CouponRepository) *CreateCouponUseCase {
+	return &CreateCouponUseCase{repo: repo}
+}
+
+func (uc *CreateCouponUseCase) Execute(input CreateCouponInputDto) (*CouponDto, error) {
+	coupon, err := domain.CreatedNewCoupon(
+		input.Code,
+		domain.DiscountType(input.DiscountType),
+		input.Value,
+		input.ValidFrom,
+		input.ValidUntil,
+		input.MaxUses,
+		input.MaxUsesPerEmail,
+		input.EventIDs,
+		toTicketKinds(input.TicketKinds),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.CreateCoupon(coupon); err != nil {
+		return nil, err
+	}
+
+	output := newCouponDto(coupon)
+	return &output, nil
+}
+
+func toTicketKinds(kinds []string) []domain.TicketKind {
+	ticketKinds := make([]domain.TicketKind, len(kinds))
+	for i, kind := range kinds {
+		ticketKinds[i] = domain.TicketKind(kind)
+	}
+	return ticketKinds
+}
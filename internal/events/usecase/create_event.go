@@ -1,9 +1,12 @@
 package usecase
 
 import (
+	"errors"
+	"log"
 	"time"
 
 	"go-backend-api/internal/events/domain"
+	"go-backend-api/internal/events/infra/service"
 )
 
 type CreateEventInputDto struct {
@@ -12,10 +15,18 @@ type CreateEventInputDto struct {
 	Organization string    `json:"organization"`
 	Rating       string    `json:"rating"`
 	Date         time.Time `json:"date"`
+	Timezone     string    `json:"timezone"`
 	Capacity     int       `json:"capacity"`
 	ImageURL     string  `json:"image_url"`
 	Price        float64   `json:"price"`
 	PartnerID    int       `json:"partner_id"`
+	MaxTicketsPerBuyer int `json:"max_tickets_per_buyer"`
+	VenueID      string    `json:"venue_id"`
+	Latitude     *float64  `json:"latitude"`
+	Longitude    *float64  `json:"longitude"`
+	PublishAt    *time.Time `json:"publish_at"`
+	OnSaleAt     *time.Time `json:"on_sale_at"`
+	TransferDeadlineHours int `json:"transfer_deadline_hours"`
 }
 
 type CreateEventOutputDto struct {
@@ -24,22 +35,86 @@ type CreateEventOutputDto struct {
 	Location     string    `json:"location"`
 	Organization string    `json:"organization"`
 	Rating       string    `json:"rating"`
-	Date         time.Time `json:"date"`
+	Date         string    `json:"date"`
+	Timezone     string    `json:"timezone"`
 	Capacity     int       `json:"capacity"`
 	ImageURL     string  `json:"image_url"`
 	Price        float64   `json:"price"`
 	PartnerID    int       `json:"partner_id"`
+	MaxTicketsPerBuyer int `json:"max_tickets_per_buyer"`
+	VenueID      string    `json:"venue_id"`
+	Coordinates  *domain.GeoPoint `json:"coordinates"`
+	Status       string    `json:"status"`
+	PublishAt    string    `json:"publish_at,omitempty"`
+	OnSaleAt     string    `json:"on_sale_at,omitempty"`
+	TransferDeadlineHours int `json:"transfer_deadline_hours"`
 }
 
 type CreateEventUseCase struct {
 	repo domain.EventRepository
+	venueRepo domain.VenueRepository
+	geocoder service.Geocoder
 }
 
-func NewCreateEventUseCase(repo domain.EventRepository) *CreateEventUseCase {
-	return &CreateEventUseCase{repo: repo}
+func NewCreateEventUseCase(repo domain.EventRepository, venueRepo domain.VenueRepository, geocoder service.Geocoder) *CreateEventUseCase {
+	return &CreateEventUseCase{
+		repo: repo,
+		venueRepo: venueRepo,
+		geocoder: geocoder,
+	}
+}
+
+// locateEvent returns the coordinates given for an event or, when not
+// given, the coordinates of its venue or of its geocoded location. Events
+// the geocoder cannot place are created without coordinates.
+func locateEvent(geocoder service.Geocoder, venue *domain.Venue, location string, latitude, longitude *float64) (*domain.GeoPoint, error) {
+	if latitude != nil || longitude != nil {
+		if latitude == nil || longitude == nil {
+			return nil, domain.ErrGeoPointInvalid
+		}
+		point := domain.GeoPoint{Latitude: *latitude, Longitude: *longitude}
+		if err := point.Validate(); err != nil {
+			return nil, err
+		}
+		return &point, nil
+	}
+	if venue != nil {
+		return &domain.GeoPoint{Latitude: venue.Latitude, Longitude: venue.Longitude}, nil
+	}
+
+	point, err := geocoder.Geocode(location)
+	if err != nil {
+		if !errors.Is(err, service.ErrAddressNotFound) {
+			log.Printf("geocoding %q: %v", location, err)
+		}
+		return nil, nil
+	}
+	return &point, nil
 }
 
 func (uc *CreateEventUseCase) Execute(input CreateEventInputDto) (*CreateEventOutputDto, error) {
+	//eventos em um local herdam o endereço, a capacidade, o fuso horário e as coordenadas quando não informados
+	var venue *domain.Venue
+	if input.VenueID != "" {
+		var err error
+		venue, err = uc.venueRepo.GetVenueByID(input.VenueID)
+		if err != nil {
+			return &CreateEventOutputDto{}, err
+		}
+		if input.Location == "" {
+			input.Location = venue.FullAddress()
+		}
+		if input.Capacity == 0 {
+			input.Capacity = venue.Capacity
+		}
+		if input.Timezone == "" {
+			input.Timezone = venue.Timezone
+		}
+		if err := venue.CheckEventCapacity(input.Capacity); err != nil {
+			return &CreateEventOutputDto{}, err
+		}
+	}
+
 	event, err := domain.CreatedNewEvent(
 		input.Name, 
 		input.Location, 
@@ -54,6 +129,25 @@ func (uc *CreateEventUseCase) Execute(input CreateEventInputDto) (*CreateEventOu
 	if err != nil {
 		return &CreateEventOutputDto{}, err
 	}
+	event.MaxTicketsPerBuyer = input.MaxTicketsPerBuyer
+	event.TransferDeadlineHours = input.TransferDeadlineHours
+	event.VenueID = input.VenueID
+	event.Coordinates, err = locateEvent(uc.geocoder, venue, input.Location, input.Latitude, input.Longitude)
+	if err != nil {
+		return &CreateEventOutputDto{}, err
+	}
+	if input.Timezone != "" {
+		event.Timezone = input.Timezone
+	}
+	if err := event.Validade(); err != nil {
+		return &CreateEventOutputDto{}, err
+	}
+
+	//o evento nasce como rascunho e segue o agendamento, que pode já estar vencido
+	if err := event.Schedule(input.PublishAt, input.OnSaleAt); err != nil {
+		return &CreateEventOutputDto{}, err
+	}
+	event.AdvanceLifecycle(time.Now())
 
 	err = uc.repo.CreateEvent(event)
 	if err != nil {
@@ -66,10 +160,18 @@ func (uc *CreateEventUseCase) Execute(input CreateEventInputDto) (*CreateEventOu
 		Location:     event.Location,
 		Organization: event.Organization,
 		Rating:       string(event.Rating),
-		Date:         event.Date,
+		Date:         event.LocalDate().Format(time.RFC3339),
+		Timezone:     event.Timezone,
 		Capacity:     event.Capacity,
 		ImageURL:     event.ImageURL,
 		Price:        event.Price,
 		PartnerID:    event.PartnerID,
+		MaxTicketsPerBuyer: event.MaxTicketsPerBuyer,
+		VenueID:      event.VenueID,
+		Coordinates:  event.Coordinates,
+		Status:       string(event.Status),
+		PublishAt:    eventLocalTime(*event, event.PublishAt),
+		OnSaleAt:     eventLocalTime(*event, event.OnSaleAt),
+		TransferDeadlineHours: event.TransferDeadlineHours,
 	}, nil
 }
\ No newline at end of file
@@ -0,0 +1,249 @@
+package usecase
+
+import (
+	"time"
+
+	"go-backend-api/internal/events/domain"
+	"go-backend-api/internal/events/infra/service"
+)
+
+// SeriesTicketCategoryDto is a ticket category created on every occurrence
+type SeriesTicketCategoryDto struct {
+	Kind              string   `json:"kind"`
+	Name              string   `json:"name"`
+	PriceMultiplier   float64  `json:"price_multiplier"`
+	Requirements      []string `json:"requirements"`
+	Quota             int      `json:"quota"`
+	QuotaPercent      float64  `json:"quota_percent"`
+	PartnerTicketKind string   `json:"partner_ticket_kind"`
+}
+
+// CreateEventSeriesInputDto creates one event for each date of Recurrence,
+// an RRULE like "FREQ=WEEKLY;BYDAY=FR,SA;COUNT=8", starting at Start. The
+// spots of each occurrence come from Layout, NumberOfSpots or the venue
+// layout, like in CreateSpotsInputDto. Status is the status every occurrence
+// starts in: draft, the default, published or on_sale.
+type CreateEventSeriesInputDto struct {
+	Name               string                    `json:"name"`
+	Location           string                    `json:"location"`
+	Organization       string                    `json:"organization"`
+	Rating             string                    `json:"rating"`
+	Start              time.Time                 `json:"start"`
+	Timezone           string                    `json:"timezone"`
+	Recurrence         string                    `json:"recurrence"`
+	Capacity           int                       `json:"capacity"`
+	ImageURL           string                    `json:"image_url"`
+	Price              float64                   `json:"price"`
+	PartnerID          int                       `json:"partner_id"`
+	MaxTicketsPerBuyer int                       `json:"max_tickets_per_buyer"`
+	VenueID            string                    `json:"venue_id"`
+	NumberOfSpots      int                       `json:"number_of_spots"`
+	Layout             *domain.SeatLayout        `json:"layout"`
+	TicketCategories   []SeriesTicketCategoryDto `json:"ticket_categories"`
+	Latitude           *float64                  `json:"latitude"`
+	Longitude          *float64                  `json:"longitude"`
+	Status             string                    `json:"status"`
+}
+
+type EventSeriesDto struct {
+	ID                 string                    `json:"id"`
+	Name               string                    `json:"name"`
+	Location           string                    `json:"location"`
+	Organization       string                    `json:"organization"`
+	Rating             string                    `json:"rating"`
+	Start              string                    `json:"start"`
+	Timezone           string                    `json:"timezone"`
+	Recurrence         string                    `json:"recurrence"`
+	Capacity           int                       `json:"capacity"`
+	ImageURL           string                    `json:"image_url"`
+	Price              float64                   `json:"price"`
+	PartnerID          int                       `json:"partner_id"`
+	MaxTicketsPerBuyer int                       `json:"max_tickets_per_buyer"`
+	VenueID            string                    `json:"venue_id"`
+	NumberOfSpots      int                       `json:"number_of_spots"`
+	Layout             domain.SeatLayout         `json:"layout"`
+	TicketCategories   []SeriesTicketCategoryDto `json:"ticket_categories"`
+	Coordinates        *domain.GeoPoint          `json:"coordinates"`
+	Occurrences        []EventDto                `json:"occurrences"`
+}
+
+func newEventSeriesDto(series *domain.EventSeries, occurrences []domain.Event) EventSeriesDto {
+	categories := make([]SeriesTicketCategoryDto, len(series.TicketCategories))
+	for i, category := range series.TicketCategories {
+		categories[i] = SeriesTicketCategoryDto{
+			Kind:              string(category.Kind),
+			Name:              category.Name,
+			PriceMultiplier:   category.PriceMultiplier,
+			Requirements:      category.Requirements,
+			Quota:             category.Quota,
+			QuotaPercent:      category.QuotaPercent,
+			PartnerTicketKind: category.PartnerTicketKind,
+		}
+		if categories[i].Requirements == nil {
+			categories[i].Requirements = []string{}
+		}
+	}
+	eventDto := make([]EventDto, len(occurrences))
+	for i, event := range occurrences {
+		eventDto[i] = newEventDto(event)
+	}
+
+	start := domain.Event{Date: series.Start, Timezone: series.Timezone}.LocalDate()
+	return EventSeriesDto{
+		ID:                 series.ID,
+		Name:               series.Name,
+		Location:           series.Location,
+		Organization:       series.Organization,
+		Rating:             string(series.Rating),
+		Start:              start.Format(time.RFC3339),
+		Timezone:           series.Timezone,
+		Recurrence:         series.Recurrence,
+		Capacity:           series.Capacity,
+		ImageURL:           series.ImageURL,
+		Price:              series.Price,
+		PartnerID:          series.PartnerID,
+		MaxTicketsPerBuyer: series.MaxTicketsPerBuyer,
+		VenueID:            series.VenueID,
+		NumberOfSpots:      series.NumberOfSpots,
+		Layout:             series.Layout,
+		TicketCategories:   categories,
+		Coordinates:        series.Coordinates,
+		Occurrences:        eventDto,
+	}
+}
+
+type CreateEventSeriesUseCase struct {
+	seriesRepo     domain.EventSeriesRepository
+	venueRepo      domain.VenueRepository
+	partnerFactory service.PartnerFactory
+	geocoder       service.Geocoder
+}
+
+func NewCreateEventSeriesUseCase(seriesRepo domain.EventSeriesRepository, venueRepo domain.VenueRepository, partnerFactory service.PartnerFactory, geocoder service.Geocoder) *CreateEventSeriesUseCase {
+	return &CreateEventSeriesUseCase{
+		seriesRepo:     seriesRepo,
+		venueRepo:      venueRepo,
+		partnerFactory: partnerFactory,
+		geocoder:       geocoder,
+	}
+}
+
+func (uc *CreateEventSeriesUseCase) Execute(input CreateEventSeriesInputDto) (*EventSeriesDto, error) {
+	//séries em um local herdam o endereço, a capacidade, o fuso horário, o layout e as coordenadas quando não informados
+	var layout domain.SeatLayout
+	if input.Layout != nil {
+		layout = *input.Layout
+	}
+	var venue *domain.Venue
+	if input.VenueID != "" {
+		var err error
+		venue, err = uc.venueRepo.GetVenueByID(input.VenueID)
+		if err != nil {
+			return nil, err
+		}
+		if input.Location == "" {
+			input.Location = venue.FullAddress()
+		}
+		if input.Capacity == 0 {
+			input.Capacity = venue.Capacity
+		}
+		if input.Timezone == "" {
+			input.Timezone = venue.Timezone
+		}
+		if input.Layout == nil && input.NumberOfSpots == 0 {
+			layout = venue.Layout
+		}
+		if err := venue.CheckEventCapacity(input.Capacity); err != nil {
+			return nil, err
+		}
+	}
+
+	series, err := domain.CreatedNewEventSeries(
+		input.Name,
+		input.Location,
+		input.Organization,
+		domain.Rating(input.Rating),
+		input.Start,
+		input.Timezone,
+		input.Recurrence,
+		input.ImageURL,
+		input.Capacity,
+		input.Price,
+		input.PartnerID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	series.MaxTicketsPerBuyer = input.MaxTicketsPerBuyer
+	series.VenueID = input.VenueID
+	series.NumberOfSpots = input.NumberOfSpots
+	series.Layout = layout
+	series.Coordinates, err = locateEvent(uc.geocoder, venue, input.Location, input.Latitude, input.Longitude)
+	if err != nil {
+		return nil, err
+	}
+	for _, category := range input.TicketCategories {
+		series.TicketCategories = append(series.TicketCategories, domain.TicketCategory{
+			Kind:              domain.TicketKind(category.Kind),
+			Name:              category.Name,
+			PriceMultiplier:   category.PriceMultiplier,
+			Requirements:      category.Requirements,
+			Quota:             category.Quota,
+			QuotaPercent:      category.QuotaPercent,
+			PartnerTicketKind: category.PartnerTicketKind,
+		})
+	}
+	if err := series.Validate(); err != nil {
+		return nil, err
+	}
+
+	dates, err := series.Occurrences()
+	if err != nil {
+		return nil, err
+	}
+
+	//cada ocorrência é um evento com os mesmos lugares e categorias de ingresso
+	spotService := domain.NewSpotService()
+	occurrences := make([]domain.Event, 0, len(dates))
+	now := time.Now()
+	for _, date := range dates {
+		event, err := series.NewOccurrence(date)
+		if err != nil {
+			return nil, err
+		}
+		if status := domain.EventStatus(input.Status); status != "" && status != event.Status {
+			if err := event.TransitionTo(status, now); err != nil {
+				return nil, err
+			}
+		}
+		if series.HasLayout() {
+			err = spotService.GenerateSpotsFromLayout(event, series.Layout)
+		} else if series.NumberOfSpots > 0 {
+			err = spotService.GenerateSpots(event, series.NumberOfSpots)
+		}
+		if err != nil {
+			return nil, err
+		}
+		occurrences = append(occurrences, *event)
+	}
+
+	//garantindo que o parceiro do evento entende as categorias
+	if len(occurrences[0].TicketCategories) > 0 {
+		partner, err := uc.partnerFactory.GetPartner(series.PartnerID)
+		if err != nil {
+			return nil, err
+		}
+		for _, category := range occurrences[0].TicketCategories {
+			if err := service.CheckTicketKind(partner, category.PartnerKind()); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := uc.seriesRepo.CreateEventSeries(series, occurrences); err != nil {
+		return nil, err
+	}
+
+	output := newEventSeriesDto(series, occurrences)
+	return &output, nil
+}
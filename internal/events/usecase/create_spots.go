@@ -1,14 +1,25 @@
 package usecase
 
 import (
+	"errors"
 	"go-backend-api/internal/events/domain"
-
-	"fmt"
+	"log"
 )
 
+var ErrCreateSpotsModeConflict = errors.New("give only one of number_of_spots, layout, names, ranges or template")
+
+// CreateSpotsInputDto creates NumberOfSpots spots in rows of ten or, when
+// Layout is given, one spot for each seat of the layout. Names, Ranges, such
+// as "A1-A20,B1-B20", and Template create the spots with the names they
+// list. When none is given the spots are generated from the layout of the
+// event venue.
 type CreateSpotsInputDto struct {
 	EventID string `json:"event_id"`
 	NumberOfSpots int `json:"number_of_spots"`
+	Layout *domain.SeatLayout `json:"layout"`
+	Names []string `json:"names"`
+	Ranges string `json:"ranges"`
+	Template *domain.SpotNameTemplate `json:"template"`
 }
 
 type CreateSpotsOutputDto struct {
@@ -17,47 +28,92 @@ type CreateSpotsOutputDto struct {
 
 type CreateSpotsUseCase struct {
 	repo domain.EventRepository
+	venueRepo domain.VenueRepository
 }
 
-func NewCreateSpotsUseCase(repo domain.EventRepository) *CreateSpotsUseCase {
-	return &CreateSpotsUseCase{repo: repo}
+func NewCreateSpotsUseCase(repo domain.EventRepository, venueRepo domain.VenueRepository) *CreateSpotsUseCase {
+	return &CreateSpotsUseCase{
+		repo: repo,
+		venueRepo: venueRepo,
+	}
 }
 
 func (uc *CreateSpotsUseCase) Execute(input CreateSpotsInputDto) (*CreateSpotsOutputDto, error) {
+	names, err := input.spotNames()
+	if err != nil {
+		return nil, err
+	}
+
 	event, err := uc.repo.GetEventByID(input.EventID)
 	if err != nil {
 		return nil, err
 	}
 
-	spots := make([]domain.Spot, input.NumberOfSpots)
-	for i := 0; i < input.NumberOfSpots; i++ {
-		spotName := generateSpotName(i)
-		spot, err := domain.CreatedNewSpot(*event, spotName)
+	//sem quantidade, layout nem nomes, o evento herda o layout do local
+	layout := input.Layout
+	if layout == nil && input.NumberOfSpots == 0 && names == nil && event.VenueID != "" {
+		venue, err := uc.venueRepo.GetVenueByID(event.VenueID)
 		if err != nil {
 			return nil, err
 		}
-		if err := uc.repo.CreateSpot(spot); err != nil {
-			return nil, err
+		if venue.HasLayout() {
+			layout = &venue.Layout
 		}
-		spots[i] = *spot
+	}
+
+	//gerando os lugares pelo serviço de domínio, que nomeia e valida todos da mesma forma
+	existing := len(event.Spots)
+	spotService := domain.NewSpotService()
+	switch {
+	case layout != nil:
+		err = spotService.GenerateSpotsFromLayout(event, *layout)
+	case names != nil:
+		err = spotService.GenerateNamedSpots(event, names)
+	default:
+		err = spotService.GenerateSpots(event, input.NumberOfSpots)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	spots := event.Spots[existing:]
+	if err := uc.repo.CreateSpots(event.ID, spots); err != nil {
+		return nil, err
+	}
+
+	//os lugares novos reabrem as vendas de um evento esgotado; os já criados não são desfeitos
+	if err := updateSoldOut(uc.repo, event.ID); err != nil {
+		log.Printf("Erro ao atualizar o status de esgotado do evento %s: %v\n", event.ID, err)
 	}
 
 	spotDto := make([]SpotDto, len(spots))
 	for i, spot := range spots {
-		spotDto[i] = SpotDto{
-			ID:         spot.ID,
-			EventID:    spot.EventID,
-			Name:       spot.Name,
-			SpotStatus: string(spot.SpotStatus),
-			TicketID:   spot.TicketID,
-		}
+		spotDto[i] = newSpotDto(spot)
 	}
 
 	return &CreateSpotsOutputDto{Spots: spotDto}, nil
 }
 
-func generateSpotName(index int) string {
-	letter := 'A' + rune(index/10)
-	number := index%10 + 1
-	return fmt.Sprintf("%c-%d", letter, number)
+// spotNames lists the names given by Names, Ranges or Template, or nil when
+// the spots are not created by name
+func (input CreateSpotsInputDto) spotNames() ([]string, error) {
+	modes := 0
+	for _, given := range []bool{input.NumberOfSpots != 0, input.Layout != nil, input.Names != nil, input.Ranges != "", input.Template != nil} {
+		if given {
+			modes++
+		}
+	}
+	if modes > 1 {
+		return nil, ErrCreateSpotsModeConflict
+	}
+
+	switch {
+	case input.Names != nil:
+		return input.Names, nil
+	case input.Ranges != "":
+		return domain.ParseSpotRanges(input.Ranges)
+	case input.Template != nil:
+		return input.Template.Names()
+	}
+	return nil, nil
 }
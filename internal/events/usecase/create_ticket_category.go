@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"go-backend-api/internal/events/domain"
+	"go-backend-api/internal/events/infra/service"
+)
+
+type CreateTicketCategoryInputDto struct {
+	EventID           string   `json:"event_id"`
+	Kind              string   `json:"kind"`
+	Name              string   `json:"name"`
+	PriceMultiplier   float64  `json:"price_multiplier"`
+	Requirements      []string `json:"requirements"`
+	Quota             int      `json:"quota"`
+	QuotaPercent      float64  `json:"quota_percent"`
+	PartnerTicketKind string   `json:"partner_ticket_kind"`
+}
+
+type CreateTicketCategoryUseCase struct {
+	repo           domain.EventRepository
+	partnerFactory service.PartnerFactory
+}
+
+func NewCreateTicketCategoryUseCase(repo domain.EventRepository, partnerFactory service.PartnerFactory) *CreateTicketCategoryUseCase {
+	return &CreateTicketCategoryUseCase{
+		repo:           repo,
+		partnerFactory: partnerFactory,
+	}
+}
+
+func (uc *CreateTicketCategoryUseCase) Execute(input CreateTicketCategoryInputDto) (*TicketCategoryDto, error) {
+	event, err := uc.repo.GetEventByID(input.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	category, err := event.AddTicketCategory(
+		domain.TicketKind(input.Kind),
+		input.Name,
+		input.PriceMultiplier,
+		input.Requirements,
+		input.Quota,
+		input.QuotaPercent,
+		input.PartnerTicketKind,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	//garantindo que o parceiro do evento entende a categoria
+	partner, err := uc.partnerFactory.GetPartner(event.PartnerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := service.CheckTicketKind(partner, category.PartnerKind()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.CreateTicketCategory(category); err != nil {
+		return nil, err
+	}
+
+	output := newTicketCategoryDto(*category)
+	return &output, nil
+}
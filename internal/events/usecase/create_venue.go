@@ -0,0 +1,45 @@
+package usecase
+
+import "go-backend-api/internal/events/domain"
+
+type CreateVenueInputDto struct {
+	Name      string            `json:"name"`
+	Address   string            `json:"address"`
+	City      string            `json:"city"`
+	Latitude  float64           `json:"latitude"`
+	Longitude float64           `json:"longitude"`
+	Timezone  string            `json:"timezone"`
+	Capacity  int               `json:"capacity"`
+	Layout    domain.SeatLayout `json:"layout"`
+}
+
+type CreateVenueUseCase struct {
+	repo domain.VenueRepository
+}
+
+func NewCreateVenueUseCase(repo domain.VenueRepository) *CreateVenueUseCase {
+	return &CreateVenueUseCase{repo: repo}
+}
+
+func (uc *CreateVenueUseCase) Execute(input CreateVenueInputDto) (*VenueDto, error) {
+	venue, err := domain.CreatedNewVenue(
+		input.Name,
+		input.Address,
+		input.City,
+		input.Latitude,
+		input.Longitude,
+		input.Timezone,
+		input.Capacity,
+		input.Layout,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.CreateVenue(venue); err != nil {
+		return nil, err
+	}
+
+	output := newVenueDto(venue)
+	return &output, nil
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+type DeclineTicketTransferInputDto struct {
+	TicketID string `json:"-"`
+	Email    string `json:"email"`
+}
+
+type DeclineTicketTransferUseCase struct {
+	ticketRepo domain.TicketRepository
+}
+
+func NewDeclineTicketTransferUseCase(ticketRepo domain.TicketRepository) *DeclineTicketTransferUseCase {
+	return &DeclineTicketTransferUseCase{ticketRepo: ticketRepo}
+}
+
+func (uc *DeclineTicketTransferUseCase) Execute(input DeclineTicketTransferInputDto) (*TicketTransferDto, error) {
+	now := time.Now()
+
+	transfer, err := findOpenTicketTransfer(uc.ticketRepo, input.TicketID, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := transfer.Decline(input.Email, now); err != nil {
+		return nil, err
+	}
+	if err := uc.ticketRepo.UpdateTicketTransfer(transfer); err != nil {
+		return nil, err
+	}
+
+	output := newTicketTransferDto(*transfer)
+	return &output, nil
+}
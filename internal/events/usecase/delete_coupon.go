@@ -0,0 +1,19 @@
+package usecase
+
+import "go-backend-api/internal/events/domain"
+
+type DeleteCouponInputDto struct {
+	ID string `json:"id"`
+}
+
+type DeleteCouponUseCase struct {
+	repo domain.CouponRepository
+}
+
+func NewDeleteCouponUseCase(repo domain.CouponRepository) *DeleteCouponUseCase {
+	return &DeleteCouponUseCase{repo: repo}
+}
+
+func (uc *DeleteCouponUseCase) Execute(input DeleteCouponInputDto) error {
+	return uc.repo.DeleteCoupon(input.ID)
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"log"
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+// DeleteSpotsInputDto removes the spots of the event that were not sold,
+// recording the removal in the audit trail with Actor
+type DeleteSpotsInputDto struct {
+	EventID string   `json:"-"`
+	Actor   string   `json:"actor"`
+	SpotIDs []string `json:"spot_ids"`
+}
+
+type DeleteSpotsOutputDto struct {
+	Changes []domain.SpotChange `json:"changes"`
+}
+
+type DeleteSpotsUseCase struct {
+	repo domain.EventRepository
+}
+
+func NewDeleteSpotsUseCase(repo domain.EventRepository) *DeleteSpotsUseCase {
+	return &DeleteSpotsUseCase{repo: repo}
+}
+
+func (uc *DeleteSpotsUseCase) Execute(input DeleteSpotsInputDto) (*DeleteSpotsOutputDto, error) {
+	output := &DeleteSpotsOutputDto{}
+	err := uc.repo.ChangeSpots(input.EventID, func(spots []domain.Spot) ([]domain.SpotChange, error) {
+		changes, err := domain.DeleteSpots(spots, input.SpotIDs, input.Actor, time.Now())
+		output.Changes = changes
+		return changes, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	//remover os últimos lugares à venda esgota o evento; a remoção já foi gravada
+	if err := updateSoldOut(uc.repo, input.EventID); err != nil {
+		log.Printf("Erro ao atualizar o status de esgotado do evento %s: %v\n", input.EventID, err)
+	}
+	return output, nil
+}
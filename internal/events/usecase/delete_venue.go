@@ -0,0 +1,19 @@
+package usecase
+
+import "go-backend-api/internal/events/domain"
+
+type DeleteVenueInputDto struct {
+	ID string `json:"id"`
+}
+
+type DeleteVenueUseCase struct {
+	repo domain.VenueRepository
+}
+
+func NewDeleteVenueUseCase(repo domain.VenueRepository) *DeleteVenueUseCase {
+	return &DeleteVenueUseCase{repo: repo}
+}
+
+func (uc *DeleteVenueUseCase) Execute(input DeleteVenueInputDto) error {
+	return uc.repo.DeleteVenue(input.ID)
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"go-backend-api/internal/events/domain"
+	"go-backend-api/internal/events/infra/service"
+)
+
+// notificationBatchSize is how many emails one execution delivers at most
+const notificationBatchSize = 50
+
+type DeliverNotificationsOutputDto struct {
+	Sent     int `json:"sent"`
+	Retrying int `json:"retrying"`
+	Failed   int `json:"failed"`
+}
+
+// DeliverNotificationsUseCase sends the emails of the outbox that are due.
+// It runs in the background, so a slow or unavailable relay never delays
+// the request that queued the email; a failed delivery is retried later
+// with backoff.
+type DeliverNotificationsUseCase struct {
+	notificationRepo domain.NotificationRepository
+	renderer         service.EmailRenderer
+	sender           service.EmailSender
+}
+
+func NewDeliverNotificationsUseCase(notificationRepo domain.NotificationRepository, renderer service.EmailRenderer, sender service.EmailSender) *DeliverNotificationsUseCase {
+	return &DeliverNotificationsUseCase{
+		notificationRepo: notificationRepo,
+		renderer:         renderer,
+		sender:           sender,
+	}
+}
+
+func (uc *DeliverNotificationsUseCase) Execute() (*DeliverNotificationsOutputDto, error) {
+	now := time.Now()
+
+	notifications, err := uc.notificationRepo.FindDueNotifications(now, notificationBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &DeliverNotificationsOutputDto{}
+	for i := range notifications {
+		notification := &notifications[i]
+		//reservando a notificação para que outra instância não envie o mesmo email
+		err := uc.notificationRepo.ClaimNotification(notification, now.Add(domain.NotificationLease))
+		if errors.Is(err, domain.ErrNotificationConflict) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err := uc.deliver(*notification); err != nil {
+			log.Printf("Erro ao enviar o email %s para %s: %v\n", notification.ID, notification.Recipient, err)
+			if notification.MarkFailed(err, time.Now()) {
+				output.Retrying++
+			} else {
+				output.Failed++
+			}
+		} else {
+			notification.MarkSent(time.Now())
+			output.Sent++
+		}
+		if err := uc.notificationRepo.UpdateNotification(notification); err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+func (uc *DeliverNotificationsUseCase) deliver(notification domain.Notification) error {
+	subject, body, err := uc.renderer.RenderEmail(notification)
+	if err != nil {
+		return err
+	}
+	return uc.sender.SendEmail(service.EmailMessage{
+		To:      notification.Recipient,
+		Subject: subject,
+		HTML:    body,
+	})
+}
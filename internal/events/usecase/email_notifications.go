@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+// ticketEmailData is a ticket as the email templates show it
+type ticketEmailData struct {
+	SpotName     string  `json:"spot_name"`
+	TicketKind   string  `json:"ticket_kind"`
+	AttendeeName string  `json:"attendee_name"`
+	Price        float64 `json:"price"`
+}
+
+// eventEmailData is the data the order confirmation, reminder and
+// cancellation templates share. EventDate keeps the offset of the event
+// timezone, so the email shows the local time of the event.
+type eventEmailData struct {
+	OrderID   string            `json:"order_id,omitempty"`
+	EventName string            `json:"event_name"`
+	EventDate string            `json:"event_date"`
+	Timezone  string            `json:"timezone"`
+	Location  string            `json:"location"`
+	Tickets   []ticketEmailData `json:"tickets"`
+	Total     float64           `json:"total"`
+}
+
+func newEventEmailData(event domain.Event, tickets []domain.Ticket) eventEmailData {
+	data := eventEmailData{
+		EventName: event.Name,
+		EventDate: event.LocalDate().Format(time.RFC3339),
+		Timezone:  event.Timezone,
+		Location:  event.Location,
+		Tickets:   make([]ticketEmailData, len(tickets)),
+	}
+	for i, ticket := range tickets {
+		data.Tickets[i] = ticketEmailData{
+			SpotName:     ticket.Spot.Name,
+			TicketKind:   string(ticket.TicketKind),
+			AttendeeName: ticket.AttendeeName,
+			Price:        ticket.Total(),
+		}
+		data.Total += ticket.Total()
+	}
+	return data
+}
+
+// ticketsByHolder groups the tickets by the email of their holder, keeping
+// the holders in the order they first appear
+func ticketsByHolder(tickets []domain.Ticket) ([]string, map[string][]domain.Ticket) {
+	holders := []string{}
+	byHolder := map[string][]domain.Ticket{}
+	for _, ticket := range tickets {
+		if ticket.HolderEmail == "" {
+			continue
+		}
+		if _, ok := byHolder[ticket.HolderEmail]; !ok {
+			holders = append(holders, ticket.HolderEmail)
+		}
+		byHolder[ticket.HolderEmail] = append(byHolder[ticket.HolderEmail], ticket)
+	}
+	return holders, byHolder
+}
+
+// queueNotification stores an email in the outbox, to be delivered by
+// DeliverNotificationsUseCase
+func queueNotification(repo domain.NotificationRepository, kind domain.NotificationKind, locale, recipient, dedupKey string, data any) error {
+	notification, err := domain.CreatedNewNotification(kind, locale, recipient, dedupKey, data, time.Now())
+	if err != nil {
+		return err
+	}
+	return repo.EnqueueNotification(notification)
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+type ExpireHoldsOutputDto struct {
+	ReleasedSpots int                `json:"released_spots"`
+	ExpiredOffers int                `json:"expired_offers"`
+	Offers        []WaitlistEntryDto `json:"offers"`
+}
+
+// ExpireHoldsUseCase releases the holds that expired, closes the waitlist
+// offers that were not taken and offers the released spots to the next
+// buyers in line.
+type ExpireHoldsUseCase struct {
+	repo                      domain.EventRepository
+	waitlistRepo              domain.WaitlistRepository
+	offerReleasedSpotsUseCase *OfferReleasedSpotsUseCase
+}
+
+func NewExpireHoldsUseCase(repo domain.EventRepository, waitlistRepo domain.WaitlistRepository, offerReleasedSpotsUseCase *OfferReleasedSpotsUseCase) *ExpireHoldsUseCase {
+	return &ExpireHoldsUseCase{
+		repo:                      repo,
+		waitlistRepo:              waitlistRepo,
+		offerReleasedSpotsUseCase: offerReleasedSpotsUseCase,
+	}
+}
+
+func (uc *ExpireHoldsUseCase) Execute() (*ExpireHoldsOutputDto, error) {
+	now := time.Now()
+
+	released, err := uc.repo.ReleaseExpiredHolds(now)
+	if err != nil {
+		return nil, err
+	}
+	eventIDs := []string{}
+	seen := map[string]bool{}
+	for _, spot := range released {
+		if !seen[spot.EventID] {
+			seen[spot.EventID] = true
+			eventIDs = append(eventIDs, spot.EventID)
+		}
+	}
+
+	//encerrando as ofertas que o comprador não aproveitou a tempo
+	expired, err := uc.waitlistRepo.FindExpiredWaitlistOffers(now)
+	if err != nil {
+		return nil, err
+	}
+	for i := range expired {
+		if err := expired[i].ExpireOffer(); err != nil {
+			return nil, err
+		}
+		if err := uc.waitlistRepo.UpdateWaitlistEntry(&expired[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	//oferecendo os lugares liberados para o próximo da fila de cada evento
+	output := &ExpireHoldsOutputDto{
+		ReleasedSpots: len(released),
+		ExpiredOffers: len(expired),
+		Offers:        []WaitlistEntryDto{},
+	}
+	for _, eventID := range eventIDs {
+		offered, err := uc.offerReleasedSpotsUseCase.Execute(OfferReleasedSpotsInputDto{EventID: eventID})
+		if err != nil {
+			return nil, err
+		}
+		output.Offers = append(output.Offers, offered.Offers...)
+	}
+
+	return output, nil
+}
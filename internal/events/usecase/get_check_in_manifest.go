@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+type GetCheckInManifestInputDto struct {
+	EventID string `json:"event_id"`
+}
+
+// GetCheckInManifestUseCase signs the list of tickets of an event for the
+// scanners that work offline
+type GetCheckInManifestUseCase struct {
+	repo       domain.EventRepository
+	ticketRepo domain.TicketRepository
+	keyRing    *domain.CredentialKeyRing
+}
+
+func NewGetCheckInManifestUseCase(repo domain.EventRepository, ticketRepo domain.TicketRepository, keyRing *domain.CredentialKeyRing) *GetCheckInManifestUseCase {
+	return &GetCheckInManifestUseCase{
+		repo:       repo,
+		ticketRepo: ticketRepo,
+		keyRing:    keyRing,
+	}
+}
+
+func (uc *GetCheckInManifestUseCase) Execute(input GetCheckInManifestInputDto) (*domain.SignedCheckInManifest, error) {
+	event, err := uc.repo.GetEventByID(input.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if err := event.CheckCheckInOpen(); err != nil {
+		return nil, err
+	}
+
+	tickets, err := uc.ticketRepo.FindTicketsByEventID(event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := domain.CreatedNewCheckInManifest(event.ID, tickets, time.Now())
+	return uc.keyRing.SignCheckInManifest(manifest)
+}
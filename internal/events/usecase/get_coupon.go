@@ -0,0 +1,25 @@
+package usecase
+
+import "go-backend-api/internal/events/domain"
+
+type GetCouponInputDto struct {
+	ID string `json:"id"`
+}
+
+type GetCouponUseCase struct {
+	repo domain.CouponRepository
+}
+
+func NewGetCouponUseCase(repo domain.CouponRepository) *GetCouponUseCase {
+	return &GetCouponUseCase{repo: repo}
+}
+
+func (uc *GetCouponUseCase) Execute(input GetCouponInputDto) (*CouponDto, error) {
+	coupon, err := uc.repo.GetCouponByID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := newCouponDto(coupon)
+	return &output, nil
+}
@@ -1,6 +1,8 @@
 package usecase
 
 import (
+	"time"
+
 	"go-backend-api/internal/events/domain"
 )
 
@@ -15,9 +17,19 @@ type GetEventOutputDto struct {
 	Organization string  `json:"organization"`
 	Rating       string  `json:"rating"`
 	Date         string  `json:"date"`
+	Timezone     string  `json:"timezone"`
 	Capacity     int     `json:"capacity"`
 	Price        float64 `json:"price"`
 	PartnerID    int     `json:"partner_id"`
+	MaxTicketsPerBuyer int `json:"max_tickets_per_buyer"`
+	VenueID      string  `json:"venue_id"`
+	SeriesID     string  `json:"series_id"`
+	SeriesOverrides []string `json:"series_overrides"`
+	Coordinates  *domain.GeoPoint `json:"coordinates"`
+	Status       string  `json:"status"`
+	PublishAt    string  `json:"publish_at,omitempty"`
+	OnSaleAt     string  `json:"on_sale_at,omitempty"`
+	TransferDeadlineHours int `json:"transfer_deadline_hours"`
 }
 
 type GetEventUseCase struct {
@@ -40,9 +52,19 @@ func (uc *GetEventUseCase) Execute(input GetEventInputDto) (*GetEventOutputDto,
 		Location:     event.Location,
 		Organization: event.Organization,
 		Rating:       string(event.Rating),
-		Date:         event.Date.Format("2006-01-02 15:04:05"),
+		Date:         event.LocalDate().Format(time.RFC3339),
+		Timezone:     event.Timezone,
 		Capacity:     event.Capacity,
 		Price:        event.Price,
 		PartnerID:    event.PartnerID,
+		MaxTicketsPerBuyer: event.MaxTicketsPerBuyer,
+		VenueID:      event.VenueID,
+		SeriesID:     event.SeriesID,
+		Coordinates:  event.Coordinates,
+		SeriesOverrides: seriesOverrides(*event),
+		Status:       string(event.Status),
+		PublishAt:    eventLocalTime(*event, event.PublishAt),
+		OnSaleAt:     eventLocalTime(*event, event.OnSaleAt),
+		TransferDeadlineHours: event.TransferDeadlineHours,
 	}, nil
 }
\ No newline at end of file
@@ -0,0 +1,34 @@
+package usecase
+
+import "go-backend-api/internal/events/domain"
+
+type GetEventSeriesInputDto struct {
+	ID string `json:"id"`
+}
+
+type GetEventSeriesUseCase struct {
+	repo       domain.EventRepository
+	seriesRepo domain.EventSeriesRepository
+}
+
+func NewGetEventSeriesUseCase(repo domain.EventRepository, seriesRepo domain.EventSeriesRepository) *GetEventSeriesUseCase {
+	return &GetEventSeriesUseCase{
+		repo:       repo,
+		seriesRepo: seriesRepo,
+	}
+}
+
+func (uc *GetEventSeriesUseCase) Execute(input GetEventSeriesInputDto) (*EventSeriesDto, error) {
+	series, err := uc.seriesRepo.GetEventSeriesByID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences, err := uc.repo.ListEventsBySeriesID(series.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := newEventSeriesDto(series, occurrences)
+	return &output, nil
+}
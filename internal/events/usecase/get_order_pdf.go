@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"go-backend-api/internal/events/domain"
+	"go-backend-api/internal/events/infra/service"
+)
+
+type GetOrderPDFInputDto struct {
+	OrderID string `json:"order_id"`
+	Email   string `json:"email"`
+}
+
+// GetOrderPDFUseCase prints the tickets of an order still held by the
+// email, leaving out the ones transferred to someone else
+type GetOrderPDFUseCase struct {
+	printer ticketPrinter
+}
+
+func NewGetOrderPDFUseCase(repo domain.EventRepository, ticketRepo domain.TicketRepository, templateRepo domain.TicketTemplateRepository, keyRing *domain.CredentialKeyRing, encoder service.QRCodeEncoder, renderer service.TicketPDFRenderer) *GetOrderPDFUseCase {
+	return &GetOrderPDFUseCase{
+		printer: ticketPrinter{repo: repo, ticketRepo: ticketRepo, templateRepo: templateRepo, keyRing: keyRing, encoder: encoder, renderer: renderer},
+	}
+}
+
+func (uc *GetOrderPDFUseCase) Execute(input GetOrderPDFInputDto) (*TicketPDFOutputDto, error) {
+	tickets, err := uc.printer.ticketRepo.FindTicketsByOrderID(input.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	if len(tickets) == 0 {
+		return nil, domain.ErrOrderNotFound
+	}
+
+	held := []domain.Ticket{}
+	for _, ticket := range tickets {
+		if ticket.IsHeldBy(input.Email) {
+			held = append(held, ticket)
+		}
+	}
+	if len(held) == 0 {
+		return nil, domain.ErrTicketNotHeldBy
+	}
+
+	pdf, err := uc.printer.print(held)
+	if err != nil {
+		return nil, err
+	}
+	return &TicketPDFOutputDto{FileName: "pedido-" + input.OrderID + ".pdf", PDF: pdf}, nil
+}
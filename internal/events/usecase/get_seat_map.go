@@ -0,0 +1,46 @@
+package usecase
+
+import "go-backend-api/internal/events/domain"
+
+type GetSeatMapInputDto struct {
+	EventID string `json:"event_id"`
+}
+
+type GetSeatMapOutputDto struct {
+	EventID   string                  `json:"event_id"`
+	Width     float64                 `json:"width"`
+	Height    float64                 `json:"height"`
+	Total     int                     `json:"total"`
+	Available int                     `json:"available"`
+	Sections  []domain.SeatMapSection `json:"sections"`
+}
+
+type GetSeatMapUseCase struct {
+	repo domain.EventRepository
+}
+
+func NewGetSeatMapUseCase(repo domain.EventRepository) *GetSeatMapUseCase {
+	return &GetSeatMapUseCase{repo: repo}
+}
+
+func (uc *GetSeatMapUseCase) Execute(input GetSeatMapInputDto) (*GetSeatMapOutputDto, error) {
+	event, err := uc.repo.GetEventByID(input.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	spots, err := uc.repo.FindSpotsEventID(event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	seatMap := domain.BuildSeatMap(spots)
+	return &GetSeatMapOutputDto{
+		EventID:   event.ID,
+		Width:     seatMap.Width,
+		Height:    seatMap.Height,
+		Total:     seatMap.Total,
+		Available: seatMap.Available,
+		Sections:  seatMap.Sections,
+	}, nil
+}
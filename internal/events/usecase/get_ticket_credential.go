@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"log"
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+type GetTicketCredentialInputDto struct {
+	TicketID string `json:"ticket_id"`
+	Email    string `json:"email"`
+}
+
+type GetTicketCredentialOutputDto struct {
+	TicketID          string `json:"ticket_id"`
+	CredentialVersion int    `json:"credential_version"`
+	Credential        string `json:"credential"`
+}
+
+type GetTicketCredentialUseCase struct {
+	ticketRepo domain.TicketRepository
+	keyRing    *domain.CredentialKeyRing
+}
+
+func NewGetTicketCredentialUseCase(ticketRepo domain.TicketRepository, keyRing *domain.CredentialKeyRing) *GetTicketCredentialUseCase {
+	return &GetTicketCredentialUseCase{
+		ticketRepo: ticketRepo,
+		keyRing:    keyRing,
+	}
+}
+
+func (uc *GetTicketCredentialUseCase) Execute(input GetTicketCredentialInputDto) (*GetTicketCredentialOutputDto, error) {
+	ticket, err := findHeldTicket(uc.ticketRepo, input.TicketID, input.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := uc.keyRing.Issue(*ticket, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetTicketCredentialOutputDto{
+		TicketID:          ticket.ID,
+		CredentialVersion: ticket.CredentialVersion,
+		Credential:        credential,
+	}, nil
+}
+
+// findHeldTicket returns the ticket only to its current holder, since its
+// credential gets the holder in the door
+func findHeldTicket(ticketRepo domain.TicketRepository, ticketID, email string) (*domain.Ticket, error) {
+	ticket, err := ticketRepo.GetTicketByID(ticketID)
+	if err != nil {
+		return nil, err
+	}
+	if !ticket.IsHeldBy(email) {
+		return nil, domain.ErrTicketNotHeldBy
+	}
+	return ticket, nil
+}
+
+// newIssuedTicketDto returns the ticket with a credential for its holder.
+// The ticket is already saved when it is issued, so a signing failure only
+// leaves the credential to be fetched later.
+func newIssuedTicketDto(keyRing *domain.CredentialKeyRing, ticket domain.Ticket, now time.Time) TicketDto {
+	dto := newTicketDto(ticket)
+	credential, err := keyRing.Issue(ticket, now)
+	if err != nil {
+		log.Printf("Erro ao emitir a credencial do ingresso %s: %v\n", ticket.ID, err)
+		return dto
+	}
+	dto.Credential = credential
+	return dto
+}
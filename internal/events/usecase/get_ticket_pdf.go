@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+
+	"go-backend-api/internal/events/domain"
+	"go-backend-api/internal/events/infra/service"
+)
+
+type GetTicketPDFInputDto struct {
+	TicketID string `json:"ticket_id"`
+	Email    string `json:"email"`
+}
+
+type TicketPDFOutputDto struct {
+	FileName string
+	PDF      []byte
+}
+
+type GetTicketPDFUseCase struct {
+	printer ticketPrinter
+}
+
+func NewGetTicketPDFUseCase(repo domain.EventRepository, ticketRepo domain.TicketRepository, templateRepo domain.TicketTemplateRepository, keyRing *domain.CredentialKeyRing, encoder service.QRCodeEncoder, renderer service.TicketPDFRenderer) *GetTicketPDFUseCase {
+	return &GetTicketPDFUseCase{
+		printer: ticketPrinter{repo: repo, ticketRepo: ticketRepo, templateRepo: templateRepo, keyRing: keyRing, encoder: encoder, renderer: renderer},
+	}
+}
+
+func (uc *GetTicketPDFUseCase) Execute(input GetTicketPDFInputDto) (*TicketPDFOutputDto, error) {
+	ticket, err := findHeldTicket(uc.printer.ticketRepo, input.TicketID, input.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	pdf, err := uc.printer.print([]domain.Ticket{*ticket})
+	if err != nil {
+		return nil, err
+	}
+	return &TicketPDFOutputDto{FileName: "ingresso-" + ticket.ID + ".pdf", PDF: pdf}, nil
+}
+
+// ticketPrinter prints tickets with the template of the organization of
+// their event and a fresh credential for each one
+type ticketPrinter struct {
+	repo         domain.EventRepository
+	ticketRepo   domain.TicketRepository
+	templateRepo domain.TicketTemplateRepository
+	keyRing      *domain.CredentialKeyRing
+	encoder      service.QRCodeEncoder
+	renderer     service.TicketPDFRenderer
+}
+
+// print renders the tickets, which must be of the same event
+func (p ticketPrinter) print(tickets []domain.Ticket) ([]byte, error) {
+	if len(tickets) == 0 {
+		return nil, service.ErrNoTicketsToPrint
+	}
+	event, err := p.repo.GetEventByID(tickets[0].EventID)
+	if err != nil {
+		return nil, err
+	}
+	template, err := findTicketTemplate(p.templateRepo, event.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	printed := make([]service.PrintedTicket, len(tickets))
+	for i, ticket := range tickets {
+		credential, err := p.keyRing.Issue(ticket, now)
+		if err != nil {
+			return nil, err
+		}
+		qrCode, err := p.encoder.EncodePNG(credential, service.DefaultQRCodeSize)
+		if err != nil {
+			return nil, err
+		}
+		printed[i] = service.PrintedTicket{Event: *event, Ticket: ticket, QRCodePNG: qrCode}
+	}
+
+	return p.renderer.RenderTickets(*template, printed)
+}
+
+// findTicketTemplate returns the template of the organization, or the
+// default one when it has none
+func findTicketTemplate(templateRepo domain.TicketTemplateRepository, organization string) (*domain.TicketTemplate, error) {
+	template, err := templateRepo.GetTicketTemplate(organization)
+	if errors.Is(err, domain.ErrTicketTemplateNotFound) {
+		defaultTemplate := domain.DefaultTicketTemplate(organization)
+		return &defaultTemplate, nil
+	}
+	return template, err
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"time"
+
+	"go-backend-api/internal/events/domain"
+	"go-backend-api/internal/events/infra/service"
+)
+
+type GetTicketQRCodeInputDto struct {
+	TicketID string `json:"ticket_id"`
+	Email    string `json:"email"`
+	Size     int    `json:"size"`
+}
+
+type GetTicketQRCodeOutputDto struct {
+	TicketID          string
+	CredentialVersion int
+	PNG               []byte
+}
+
+// GetTicketQRCodeUseCase renders the credential of a ticket as the QR code
+// presented at the door
+type GetTicketQRCodeUseCase struct {
+	ticketRepo domain.TicketRepository
+	keyRing    *domain.CredentialKeyRing
+	encoder    service.QRCodeEncoder
+}
+
+func NewGetTicketQRCodeUseCase(ticketRepo domain.TicketRepository, keyRing *domain.CredentialKeyRing, encoder service.QRCodeEncoder) *GetTicketQRCodeUseCase {
+	return &GetTicketQRCodeUseCase{
+		ticketRepo: ticketRepo,
+		keyRing:    keyRing,
+		encoder:    encoder,
+	}
+}
+
+func (uc *GetTicketQRCodeUseCase) Execute(input GetTicketQRCodeInputDto) (*GetTicketQRCodeOutputDto, error) {
+	if input.Size == 0 {
+		input.Size = service.DefaultQRCodeSize
+	}
+
+	ticket, err := findHeldTicket(uc.ticketRepo, input.TicketID, input.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := uc.keyRing.Issue(*ticket, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	png, err := uc.encoder.EncodePNG(credential, input.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetTicketQRCodeOutputDto{
+		TicketID:          ticket.ID,
+		CredentialVersion: ticket.CredentialVersion,
+		PNG:               png,
+	}, nil
+}
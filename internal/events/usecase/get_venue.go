@@ -0,0 +1,25 @@
+package usecase
+
+import "go-backend-api/internal/events/domain"
+
+type GetVenueInputDto struct {
+	ID string `json:"id"`
+}
+
+type GetVenueUseCase struct {
+	repo domain.VenueRepository
+}
+
+func NewGetVenueUseCase(repo domain.VenueRepository) *GetVenueUseCase {
+	return &GetVenueUseCase{repo: repo}
+}
+
+func (uc *GetVenueUseCase) Execute(input GetVenueInputDto) (*VenueDto, error) {
+	venue, err := uc.repo.GetVenueByID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := newVenueDto(venue)
+	return &output, nil
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import "go-backend-api/internal/events/domain"
+
+type GetWaitlistEntryInputDto struct {
+	EventID string `json:"event_id"`
+	EntryID string `json:"entry_id"`
+}
+
+type GetWaitlistEntryUseCase struct {
+	waitlistRepo domain.WaitlistRepository
+}
+
+func NewGetWaitlistEntryUseCase(waitlistRepo domain.WaitlistRepository) *GetWaitlistEntryUseCase {
+	return &GetWaitlistEntryUseCase{waitlistRepo: waitlistRepo}
+}
+
+func (uc *GetWaitlistEntryUseCase) Execute(input GetWaitlistEntryInputDto) (*WaitlistEntryDto, error) {
+	entry, err := uc.waitlistRepo.GetWaitlistEntryByID(input.EntryID)
+	if err != nil {
+		return nil, err
+	}
+	if entry.EventID != input.EventID {
+		return nil, domain.ErrWaitlistEntryNotFound
+	}
+
+	//a posição é calculada entre as entradas que ainda aguardam uma oferta
+	entries, err := uc.waitlistRepo.FindWaitlistEntriesByEventID(entry.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := newWaitlistEntryDto(*entry, domain.WaitlistPosition(entries, entry.ID))
+	return &output, nil
+}
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+// maxSeatSelectionAttempts bounds how many times the selection is retried
+// when a concurrent request holds or buys the chosen seats first.
+const maxSeatSelectionAttempts = 3
+
+type HoldBestAvailableSeatsInputDto struct {
+	EventID  string `json:"event_id"`
+	Email    string `json:"email"`
+	Quantity int    `json:"quantity"`
+	Section  string `json:"section"`
+}
+
+type HoldBestAvailableSeatsOutputDto struct {
+	Spots         []SpotDto `json:"spots"`
+	HeldBy        string    `json:"held_by"`
+	HoldExpiresAt time.Time `json:"hold_expires_at"`
+}
+
+type HoldBestAvailableSeatsUseCase struct {
+	repo    domain.EventRepository
+	holdTTL time.Duration
+}
+
+func NewHoldBestAvailableSeatsUseCase(repo domain.EventRepository, holdTTL time.Duration) *HoldBestAvailableSeatsUseCase {
+	return &HoldBestAvailableSeatsUseCase{
+		repo:    repo,
+		holdTTL: holdTTL,
+	}
+}
+
+func (uc *HoldBestAvailableSeatsUseCase) Execute(input HoldBestAvailableSeatsInputDto) (*HoldBestAvailableSeatsOutputDto, error) {
+	event, err := uc.repo.GetEventByID(input.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if err := event.CheckOnSale(time.Now()); err != nil {
+		return nil, err
+	}
+
+	email := domain.NormalizeEmail(input.Email)
+	if !domain.IsValidEmail(email) {
+		return nil, domain.ErrOrderEmailInvalid
+	}
+	sales, err := uc.repo.CountTicketSales(event.ID, email)
+	if err != nil {
+		return nil, err
+	}
+
+	//a reserva é tudo ou nada; se outra requisição levar um dos lugares, escolhemos de novo
+	for attempt := 0; attempt < maxSeatSelectionAttempts; attempt++ {
+		//todos os lugares medem as fileiras; o seletor só escolhe os disponíveis
+		all, err := uc.repo.FindSpotsEventID(event.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		//os lugares que o comprador já reservou contam no limite junto com os comprados
+		held := 0
+		for _, spot := range all {
+			if spot.IsHeldFor(email, time.Now()) {
+				held++
+			}
+		}
+		if err := event.CheckBuyerLimit(sales.ByBuyer+held, input.Quantity); err != nil {
+			return nil, err
+		}
+
+		spots, err := domain.SelectBestAvailableSeats(all, input.Quantity, input.Section)
+		if err != nil {
+			return nil, err
+		}
+
+		spotIDs := make([]string, len(spots))
+		for i, spot := range spots {
+			spotIDs[i] = spot.ID
+		}
+		until := time.Now().Add(uc.holdTTL)
+		err = uc.repo.HoldSpots(spotIDs, email, until)
+		if errors.Is(err, domain.ErrSpotNotAvailable) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		spotDto := make([]SpotDto, len(spots))
+		for i := range spots {
+			spots[i].Hold(email, until)
+			spotDto[i] = newSpotDto(spots[i])
+		}
+		return &HoldBestAvailableSeatsOutputDto{Spots: spotDto, HeldBy: email, HoldExpiresAt: until}, nil
+	}
+
+	return nil, domain.ErrNoContiguousSeats
+}
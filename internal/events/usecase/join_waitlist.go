@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+type JoinWaitlistInputDto struct {
+	EventID  string `json:"event_id"`
+	Email    string `json:"email"`
+	Quantity int    `json:"quantity"`
+}
+
+type WaitlistEntryDto struct {
+	ID             string    `json:"id"`
+	EventID        string    `json:"event_id"`
+	Email          string    `json:"email"`
+	Quantity       int       `json:"quantity"`
+	Status         string    `json:"status"`
+	Position       int       `json:"position"`
+	OfferedSpotIDs []string  `json:"offered_spot_ids"`
+	OfferExpiresAt time.Time `json:"offer_expires_at"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+func newWaitlistEntryDto(entry domain.WaitlistEntry, position int) WaitlistEntryDto {
+	return WaitlistEntryDto{
+		ID:             entry.ID,
+		EventID:        entry.EventID,
+		Email:          entry.Email,
+		Quantity:       entry.Quantity,
+		Status:         string(entry.Status),
+		Position:       position,
+		OfferedSpotIDs: entry.OfferedSpotIDs,
+		OfferExpiresAt: entry.OfferExpiresAt,
+		CreatedAt:      entry.CreatedAt,
+	}
+}
+
+type JoinWaitlistUseCase struct {
+	repo         domain.EventRepository
+	waitlistRepo domain.WaitlistRepository
+}
+
+func NewJoinWaitlistUseCase(repo domain.EventRepository, waitlistRepo domain.WaitlistRepository) *JoinWaitlistUseCase {
+	return &JoinWaitlistUseCase{
+		repo:         repo,
+		waitlistRepo: waitlistRepo,
+	}
+}
+
+func (uc *JoinWaitlistUseCase) Execute(input JoinWaitlistInputDto) (*WaitlistEntryDto, error) {
+	event, err := uc.repo.GetEventByID(input.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if !event.IsSelling() {
+		return nil, fmt.Errorf("%w: event is %s", domain.ErrEventNotOnSale, event.Status)
+	}
+
+	entry, err := domain.CreatedNewWaitlistEntry(*event, input.Email, input.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	//a fila só faz sentido quando não há lugares suficientes para comprar agora
+	available, err := uc.repo.FindAvailableSpotsByEventID(event.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(available) >= entry.Quantity {
+		return nil, domain.ErrWaitlistSpotsAvailable
+	}
+
+	if err := uc.waitlistRepo.CreateWaitlistEntry(entry); err != nil {
+		return nil, err
+	}
+
+	entries, err := uc.waitlistRepo.FindWaitlistEntriesByEventID(event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := newWaitlistEntryDto(*entry, domain.WaitlistPosition(entries, entry.ID))
+	return &output, nil
+}
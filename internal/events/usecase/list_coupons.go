@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+type ListCouponsOutputDto struct {
+	Coupons []CouponDto `json:"coupons"`
+}
+
+type CouponDto struct {
+	ID              string    `json:"id"`
+	Code            string    `json:"code"`
+	DiscountType    string    `json:"discount_type"`
+	Value           float64   `json:"value"`
+	ValidFrom       time.Time `json:"valid_from"`
+	ValidUntil      time.Time `json:"valid_until"`
+	MaxUses         int       `json:"max_uses"`
+	MaxUsesPerEmail int       `json:"max_uses_per_email"`
+	UsedCount       int       `json:"used_count"`
+	EventIDs        []string  `json:"event_ids"`
+	TicketKinds     []string  `json:"ticket_kinds"`
+}
+
+func newCouponDto(coupon *domain.Coupon) CouponDto {
+	ticketKinds := make([]string, len(coupon.TicketKinds))
+	for i, kind := range coupon.TicketKinds {
+		ticketKinds[i] = string(kind)
+	}
+	eventIDs := coupon.EventIDs
+	if eventIDs == nil {
+		eventIDs = []string{}
+	}
+	return CouponDto{
+		ID:              coupon.ID,
+		Code:            coupon.Code,
+		DiscountType:    string(coupon.DiscountType),
+		Value:           coupon.Value,
+		ValidFrom:       coupon.ValidFrom,
+		ValidUntil:      coupon.ValidUntil,
+		MaxUses:         coupon.MaxUses,
+		MaxUsesPerEmail: coupon.MaxUsesPerEmail,
+		UsedCount:       coupon.UsedCount,
+		EventIDs:        eventIDs,
+		TicketKinds:     ticketKinds,
+	}
+}
+
+type ListCouponsUseCase struct {
+	repo domain.CouponRepository
+}
+
+func NewListCouponsUseCase(repo domain.CouponRepository) *ListCouponsUseCase {
+	return &ListCouponsUseCase{repo: repo}
+}
+
+func (uc *ListCouponsUseCase) Execute() (*ListCouponsOutputDto, error) {
+	coupons, err := uc.repo.ListCoupons()
+	if err != nil {
+		return nil, err
+	}
+
+	couponDto := make([]CouponDto, len(coupons))
+	for i := range coupons {
+		couponDto[i] = newCouponDto(&coupons[i])
+	}
+
+	return &ListCouponsOutputDto{Coupons: couponDto}, nil
+}
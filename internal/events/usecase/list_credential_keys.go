@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"encoding/base64"
+
+	"go-backend-api/internal/events/domain"
+)
+
+type CredentialKeyDto struct {
+	ID        string `json:"id"`
+	Algorithm string `json:"algorithm"`
+	PublicKey string `json:"public_key"`
+	Active    bool   `json:"active"`
+}
+
+type ListCredentialKeysOutputDto struct {
+	Keys []CredentialKeyDto `json:"keys"`
+}
+
+// ListCredentialKeysUseCase publishes the public keys that verify ticket
+// credentials, so scanners pick up rotated keys
+type ListCredentialKeysUseCase struct {
+	keyRing *domain.CredentialKeyRing
+}
+
+func NewListCredentialKeysUseCase(keyRing *domain.CredentialKeyRing) *ListCredentialKeysUseCase {
+	return &ListCredentialKeysUseCase{keyRing: keyRing}
+}
+
+func (uc *ListCredentialKeysUseCase) Execute() (*ListCredentialKeysOutputDto, error) {
+	publicKeys := uc.keyRing.PublicKeys()
+	keyDto := make([]CredentialKeyDto, 0, len(publicKeys))
+	for _, keyID := range uc.keyRing.KeyIDs() {
+		keyDto = append(keyDto, CredentialKeyDto{
+			ID:        keyID,
+			Algorithm: "Ed25519",
+			PublicKey: base64.StdEncoding.EncodeToString(publicKeys[keyID]),
+			Active:    keyID == uc.keyRing.ActiveKeyID(),
+		})
+	}
+	return &ListCredentialKeysOutputDto{Keys: keyDto}, nil
+}
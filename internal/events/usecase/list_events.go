@@ -1,6 +1,10 @@
 package usecase
 
-import "go-backend-api/internal/events/domain"
+import (
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
 
 type ListEventsOutputDto struct {
 	Events []EventDto `json:"events"`
@@ -13,10 +17,62 @@ type EventDto struct {
 	Organization string  `json:"organization"`
 	Rating       string  `json:"rating"`
 	Date         string  `json:"date"`
+	Timezone     string  `json:"timezone"`
 	ImageURL     string  `json:"image_url"`
 	Capacity     int     `json:"capacity"`
 	Price        float64 `json:"price"`
 	PartnerID    int     `json:"partner_id"`
+	MaxTicketsPerBuyer int `json:"max_tickets_per_buyer"`
+	VenueID      string  `json:"venue_id"`
+	SeriesID     string  `json:"series_id"`
+	SeriesOverrides []string `json:"series_overrides"`
+	Coordinates  *domain.GeoPoint `json:"coordinates"`
+	Status       string  `json:"status"`
+	PublishAt    string  `json:"publish_at,omitempty"`
+	OnSaleAt     string  `json:"on_sale_at,omitempty"`
+	TransferDeadlineHours int `json:"transfer_deadline_hours"`
+}
+
+func newEventDto(event domain.Event) EventDto {
+	return EventDto{
+		ID:           event.ID,
+		Name:         event.Name,
+		Location:     event.Location,
+		Organization: event.Organization,
+		Rating:       string(event.Rating),
+		Date:         event.LocalDate().Format(time.RFC3339),
+		Timezone:     event.Timezone,
+		ImageURL:     event.ImageURL,
+		Capacity:     event.Capacity,
+		Price:        event.Price,
+		PartnerID:    event.PartnerID,
+		MaxTicketsPerBuyer: event.MaxTicketsPerBuyer,
+		VenueID:      event.VenueID,
+		SeriesID:     event.SeriesID,
+		Coordinates:  event.Coordinates,
+		SeriesOverrides: seriesOverrides(event),
+		Status:       string(event.Status),
+		PublishAt:    eventLocalTime(event, event.PublishAt),
+		OnSaleAt:     eventLocalTime(event, event.OnSaleAt),
+		TransferDeadlineHours: event.TransferDeadlineHours,
+	}
+}
+
+// eventLocalTime formats a scheduled time of the event in its time zone,
+// empty when the step is not scheduled
+func eventLocalTime(event domain.Event, at *time.Time) string {
+	if at == nil {
+		return ""
+	}
+	return domain.Event{Date: *at, Timezone: event.Timezone}.LocalDate().Format(time.RFC3339)
+}
+
+// seriesOverrides lists the fields the occurrence does not take from its series
+func seriesOverrides(event domain.Event) []string {
+	if event.SeriesOverrides == nil {
+		return []string{}
+	}
+	return event.SeriesOverrides
 }
 
 type ListEventsUseCase struct {
@@ -35,18 +91,7 @@ func (u *ListEventsUseCase) Execute() (*ListEventsOutputDto, error) {
 
 	eventDto := make([]EventDto, len(events))
 	for i, event := range events {
-		eventDto[i] = EventDto{
-			ID:           event.ID,
-			Name:         event.Name,
-			Location:     event.Location,
-			Organization: event.Organization,
-			Rating:       string(event.Rating),
-			Date:         event.Date.Format("2006-01-02 15:04:05"),
-			ImageURL:     event.ImageURL,
-			Capacity:     event.Capacity,
-			Price:        event.Price,
-			PartnerID:    event.PartnerID,
-		}
+		eventDto[i] = newEventDto(event)
 	}
 
 	return &ListEventsOutputDto{Events: eventDto}, nil
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"go-backend-api/internal/events/domain"
+)
+
+// jobRunsLimit is how many of the latest runs of a job are listed
+const jobRunsLimit = 50
+
+type ListJobRunsInputDto struct {
+	JobName string `json:"job_name"`
+}
+
+type ListJobRunsOutputDto struct {
+	Job  JobDto      `json:"job"`
+	Runs []JobRunDto `json:"runs"`
+}
+
+type ListJobRunsUseCase struct {
+	jobRepo domain.JobRepository
+}
+
+func NewListJobRunsUseCase(jobRepo domain.JobRepository) *ListJobRunsUseCase {
+	return &ListJobRunsUseCase{jobRepo: jobRepo}
+}
+
+func (uc *ListJobRunsUseCase) Execute(input ListJobRunsInputDto) (*ListJobRunsOutputDto, error) {
+	job, err := uc.jobRepo.GetJobByName(input.JobName)
+	if err != nil {
+		return nil, err
+	}
+	runs, err := uc.jobRepo.FindJobRunsByJobName(job.Name, jobRunsLimit)
+	if err != nil {
+		return nil, err
+	}
+	output := &ListJobRunsOutputDto{Job: newJobDto(*job), Runs: make([]JobRunDto, len(runs))}
+	for i, run := range runs {
+		output.Runs[i] = newJobRunDto(run)
+	}
+	return output, nil
+}
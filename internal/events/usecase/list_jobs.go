@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+type JobDto struct {
+	Name      string `json:"name"`
+	Handler   string `json:"handler"`
+	Schedule  string `json:"schedule"`
+	Status    string `json:"status"`
+	NextRunAt string `json:"next_run_at"`
+	LastRunAt string `json:"last_run_at,omitempty"`
+}
+
+func newJobDto(job domain.Job) JobDto {
+	return JobDto{
+		Name:      job.Name,
+		Handler:   job.Handler,
+		Schedule:  job.Schedule,
+		Status:    string(job.Status),
+		NextRunAt: job.NextRunAt.Format(time.RFC3339),
+		LastRunAt: formatOptionalTime(job.LastRunAt),
+	}
+}
+
+type JobRunDto struct {
+	ID         string `json:"id"`
+	JobName    string `json:"job_name"`
+	Runner     string `json:"runner"`
+	Status     string `json:"status"`
+	Output     string `json:"output,omitempty"`
+	Error      string `json:"error,omitempty"`
+	StartedAt  string `json:"started_at"`
+	FinishedAt string `json:"finished_at,omitempty"`
+}
+
+func newJobRunDto(run domain.JobRun) JobRunDto {
+	return JobRunDto{
+		ID:         run.ID,
+		JobName:    run.JobName,
+		Runner:     run.Runner,
+		Status:     string(run.Status),
+		Output:     run.Output,
+		Error:      run.Error,
+		StartedAt:  run.StartedAt.Format(time.RFC3339),
+		FinishedAt: formatOptionalTime(run.FinishedAt),
+	}
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+type ListJobsOutputDto struct {
+	Jobs []JobDto `json:"jobs"`
+}
+
+type ListJobsUseCase struct {
+	jobRepo domain.JobRepository
+}
+
+func NewListJobsUseCase(jobRepo domain.JobRepository) *ListJobsUseCase {
+	return &ListJobsUseCase{jobRepo: jobRepo}
+}
+
+func (uc *ListJobsUseCase) Execute() (*ListJobsOutputDto, error) {
+	jobs, err := uc.jobRepo.ListJobs()
+	if err != nil {
+		return nil, err
+	}
+	output := &ListJobsOutputDto{Jobs: make([]JobDto, len(jobs))}
+	for i, job := range jobs {
+		output.Jobs[i] = newJobDto(job)
+	}
+	return output, nil
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"go-backend-api/internal/events/domain"
+)
+
+type ListSpotChangesInputDto struct {
+	EventID string `json:"event_id"`
+}
+
+type ListSpotChangesOutputDto struct {
+	Changes []domain.SpotChange `json:"changes"`
+}
+
+type ListSpotChangesUseCase struct {
+	repo domain.EventRepository
+}
+
+func NewListSpotChangesUseCase(repo domain.EventRepository) *ListSpotChangesUseCase {
+	return &ListSpotChangesUseCase{repo: repo}
+}
+
+func (uc *ListSpotChangesUseCase) Execute(input ListSpotChangesInputDto) (*ListSpotChangesOutputDto, error) {
+	if _, err := uc.repo.GetEventByID(input.EventID); err != nil {
+		return nil, err
+	}
+
+	changes, err := uc.repo.FindSpotChangesByEventID(input.EventID)
+	if err != nil {
+		return nil, err
+	}
+	return &ListSpotChangesOutputDto{Changes: changes}, nil
+}
@@ -11,6 +11,32 @@ type SpotDto struct {
 	SpotStatus string `json:"spot_status"`
 	TicketID   string     `json:"ticket_id"`
 	Reserved bool   `json:"reserved"`
+	BlockedReason string `json:"blocked_reason"`
+	Section    string   `json:"section"`
+	Row        string   `json:"row"`
+	Number     int      `json:"number"`
+	X          float64  `json:"x"`
+	Y          float64  `json:"y"`
+	Accessible bool     `json:"accessible"`
+	Attributes []string `json:"attributes"`
+}
+
+func newSpotDto(spot domain.Spot) SpotDto {
+	return SpotDto{
+		ID:         spot.ID,
+		EventID:    spot.EventID,
+		Name:       spot.Name,
+		SpotStatus: string(spot.SpotStatus),
+		TicketID:   spot.TicketID,
+		BlockedReason: spot.BlockedReason,
+		Section:    spot.Section,
+		Row:        spot.Row,
+		Number:     spot.Number,
+		X:          spot.X,
+		Y:          spot.Y,
+		Accessible: spot.Accessible,
+		Attributes: spot.Attributes,
+	}
 }
 
 type ListSpotsInputDto struct {
@@ -43,28 +69,9 @@ func (uc *ListSpotsUseCase) Execute(input ListSpotsInputDto) (*ListSpotsOutputDt
 
 	spotDto := make([]SpotDto, len(spots))
 	for i, spot := range spots {
-		spotDto[i] = SpotDto{
-			ID:         spot.ID,
-			EventID:    spot.EventID,
-			Name:       spot.Name,
-			SpotStatus: string(spot.SpotStatus),
-			TicketID:   spot.TicketID,
-		}
-	}
-
-	eventDto := EventDto{
-		ID:           event.ID,
-		Name:         event.Name,
-		Location:     event.Location,
-		Organization: event.Organization,
-		Rating:       string(event.Rating),
-		Date:         event.Date.Format("2006-01-02 15:04:05"),
-		ImageURL:     event.ImageURL,
-		Capacity:     event.Capacity,
-		Price:        event.Price,
-		PartnerID:    event.PartnerID,
+		spotDto[i] = newSpotDto(spot)
 	}
 
-	return &ListSpotsOutputDto{Spots: spotDto, Event: eventDto}, nil
+	return &ListSpotsOutputDto{Spots: spotDto, Event: newEventDto(*event)}, nil
 
 }
\ No newline at end of file
@@ -0,0 +1,67 @@
+package usecase
+
+import "go-backend-api/internal/events/domain"
+
+type TicketCategoryDto struct {
+	ID                string   `json:"id"`
+	EventID           string   `json:"event_id"`
+	Kind              string   `json:"kind"`
+	Name              string   `json:"name"`
+	PriceMultiplier   float64  `json:"price_multiplier"`
+	Requirements      []string `json:"requirements"`
+	Quota             int      `json:"quota"`
+	QuotaPercent      float64  `json:"quota_percent"`
+	PartnerTicketKind string   `json:"partner_ticket_kind"`
+}
+
+func newTicketCategoryDto(category domain.TicketCategory) TicketCategoryDto {
+	requirements := category.Requirements
+	if requirements == nil {
+		requirements = []string{}
+	}
+	return TicketCategoryDto{
+		ID:                category.ID,
+		EventID:           category.EventID,
+		Kind:              string(category.Kind),
+		Name:              category.Name,
+		PriceMultiplier:   category.PriceMultiplier,
+		Requirements:      requirements,
+		Quota:             category.Quota,
+		QuotaPercent:      category.QuotaPercent,
+		PartnerTicketKind: category.PartnerKind(),
+	}
+}
+
+type ListTicketCategoriesInputDto struct {
+	EventID string `json:"event_id"`
+}
+
+type ListTicketCategoriesOutputDto struct {
+	TicketCategories []TicketCategoryDto `json:"ticket_categories"`
+}
+
+type ListTicketCategoriesUseCase struct {
+	repo domain.EventRepository
+}
+
+func NewListTicketCategoriesUseCase(repo domain.EventRepository) *ListTicketCategoriesUseCase {
+	return &ListTicketCategoriesUseCase{repo: repo}
+}
+
+// Execute lists the categories of the event, including the default full and
+// half categories it does not override.
+func (uc *ListTicketCategoriesUseCase) Execute(input ListTicketCategoriesInputDto) (*ListTicketCategoriesOutputDto, error) {
+	event, err := uc.repo.GetEventByID(input.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := event.AvailableTicketCategories()
+
+	categoryDto := make([]TicketCategoryDto, len(categories))
+	for i, category := range categories {
+		categoryDto[i] = newTicketCategoryDto(category)
+	}
+
+	return &ListTicketCategoriesOutputDto{TicketCategories: categoryDto}, nil
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+type ListTicketTransfersInputDto struct {
+	TicketID string `json:"ticket_id"`
+}
+
+type ListTicketTransfersOutputDto struct {
+	Transfers []TicketTransferDto `json:"transfers"`
+}
+
+// ListTicketTransfersUseCase returns the ownership history of a ticket
+type ListTicketTransfersUseCase struct {
+	ticketRepo domain.TicketRepository
+}
+
+func NewListTicketTransfersUseCase(ticketRepo domain.TicketRepository) *ListTicketTransfersUseCase {
+	return &ListTicketTransfersUseCase{ticketRepo: ticketRepo}
+}
+
+func (uc *ListTicketTransfersUseCase) Execute(input ListTicketTransfersInputDto) (*ListTicketTransfersOutputDto, error) {
+	if _, err := uc.ticketRepo.GetTicketByID(input.TicketID); err != nil {
+		return nil, err
+	}
+	transfers, err := uc.ticketRepo.FindTicketTransfersByTicketID(input.TicketID)
+	if err != nil {
+		return nil, err
+	}
+
+	//transferências vencidas aparecem como expiradas mesmo antes de serem gravadas assim
+	now := time.Now()
+	transferDto := make([]TicketTransferDto, len(transfers))
+	for i := range transfers {
+		transfers[i].Expire(now)
+		transferDto[i] = newTicketTransferDto(transfers[i])
+	}
+
+	return &ListTicketTransfersOutputDto{Transfers: transferDto}, nil
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import "go-backend-api/internal/events/domain"
+
+type ListVenueEventsInputDto struct {
+	VenueID string `json:"venue_id"`
+}
+
+type ListVenueEventsUseCase struct {
+	repo      domain.EventRepository
+	venueRepo domain.VenueRepository
+}
+
+func NewListVenueEventsUseCase(repo domain.EventRepository, venueRepo domain.VenueRepository) *ListVenueEventsUseCase {
+	return &ListVenueEventsUseCase{
+		repo:      repo,
+		venueRepo: venueRepo,
+	}
+}
+
+func (uc *ListVenueEventsUseCase) Execute(input ListVenueEventsInputDto) (*ListEventsOutputDto, error) {
+	//garantindo que o local existe, para diferenciar um local sem eventos de um inexistente
+	venue, err := uc.venueRepo.GetVenueByID(input.VenueID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := uc.repo.ListEventsByVenueID(venue.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	eventDto := make([]EventDto, len(events))
+	for i, event := range events {
+		eventDto[i] = newEventDto(event)
+	}
+
+	return &ListEventsOutputDto{Events: eventDto}, nil
+}
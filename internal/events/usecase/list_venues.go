@@ -0,0 +1,61 @@
+package usecase
+
+import "go-backend-api/internal/events/domain"
+
+type ListVenuesOutputDto struct {
+	Venues []VenueDto `json:"venues"`
+}
+
+type VenueDto struct {
+	ID        string            `json:"id"`
+	Name      string            `json:"name"`
+	Address   string            `json:"address"`
+	City      string            `json:"city"`
+	Latitude  float64           `json:"latitude"`
+	Longitude float64           `json:"longitude"`
+	Timezone  string            `json:"timezone"`
+	Capacity  int               `json:"capacity"`
+	SeatCount int               `json:"seat_count"`
+	Layout    domain.SeatLayout `json:"layout"`
+}
+
+func newVenueDto(venue *domain.Venue) VenueDto {
+	layout := venue.Layout
+	if layout.Sections == nil {
+		layout.Sections = []domain.SeatSection{}
+	}
+	return VenueDto{
+		ID:        venue.ID,
+		Name:      venue.Name,
+		Address:   venue.Address,
+		City:      venue.City,
+		Latitude:  venue.Latitude,
+		Longitude: venue.Longitude,
+		Timezone:  venue.Timezone,
+		Capacity:  venue.Capacity,
+		SeatCount: venue.Layout.SeatCount(),
+		Layout:    layout,
+	}
+}
+
+type ListVenuesUseCase struct {
+	repo domain.VenueRepository
+}
+
+func NewListVenuesUseCase(repo domain.VenueRepository) *ListVenuesUseCase {
+	return &ListVenuesUseCase{repo: repo}
+}
+
+func (uc *ListVenuesUseCase) Execute() (*ListVenuesOutputDto, error) {
+	venues, err := uc.repo.ListVenues()
+	if err != nil {
+		return nil, err
+	}
+
+	venueDto := make([]VenueDto, len(venues))
+	for i := range venues {
+		venueDto[i] = newVenueDto(&venues[i])
+	}
+
+	return &ListVenuesOutputDto{Venues: venueDto}, nil
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import "go-backend-api/internal/events/domain"
+
+type NearbyEventsInputDto struct {
+	Latitude  float64 `json:"lat"`
+	Longitude float64 `json:"lng"`
+	RadiusKm  float64 `json:"radius_km"`
+	Limit     int     `json:"limit"`
+}
+
+type NearbyEventDto struct {
+	EventDto
+	DistanceKm float64 `json:"distance_km"`
+}
+
+type NearbyEventsOutputDto struct {
+	Events []NearbyEventDto `json:"events"`
+}
+
+type NearbyEventsUseCase struct {
+	repo domain.EventRepository
+}
+
+func NewNearbyEventsUseCase(repo domain.EventRepository) *NearbyEventsUseCase {
+	return &NearbyEventsUseCase{repo: repo}
+}
+
+func (uc *NearbyEventsUseCase) Execute(input NearbyEventsInputDto) (*NearbyEventsOutputDto, error) {
+	center := domain.GeoPoint{Latitude: input.Latitude, Longitude: input.Longitude}
+	if err := center.Validate(); err != nil {
+		return nil, err
+	}
+	radiusKm, err := domain.CheckNearbyRadius(input.RadiusKm)
+	if err != nil {
+		return nil, err
+	}
+	limit, err := domain.CheckSearchLimit(input.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	nearby, err := uc.repo.FindEventsNearby(center, radiusKm, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	eventDto := make([]NearbyEventDto, len(nearby))
+	for i, result := range nearby {
+		eventDto[i] = NearbyEventDto{EventDto: newEventDto(result.Event), DistanceKm: result.DistanceKm}
+	}
+
+	return &NearbyEventsOutputDto{Events: eventDto}, nil
+}
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"go-backend-api/internal/events/domain"
+	"go-backend-api/internal/events/infra/service"
+)
+
+type OfferReleasedSpotsInputDto struct {
+	EventID string `json:"event_id"`
+}
+
+type OfferReleasedSpotsOutputDto struct {
+	Offers []WaitlistEntryDto `json:"offers"`
+}
+
+// OfferReleasedSpotsUseCase offers the available spots of an event to the
+// waitlist and puts a sold out event back on sale. It runs whenever spots go
+// back to available: when a hold expires and when the organizer unblocks
+// them.
+type OfferReleasedSpotsUseCase struct {
+	repo         domain.EventRepository
+	waitlistRepo domain.WaitlistRepository
+	notifier     service.WaitlistNotifier
+	offerTTL     time.Duration
+}
+
+func NewOfferReleasedSpotsUseCase(repo domain.EventRepository, waitlistRepo domain.WaitlistRepository, notifier service.WaitlistNotifier, offerTTL time.Duration) *OfferReleasedSpotsUseCase {
+	return &OfferReleasedSpotsUseCase{
+		repo:         repo,
+		waitlistRepo: waitlistRepo,
+		notifier:     notifier,
+		offerTTL:     offerTTL,
+	}
+}
+
+func (uc *OfferReleasedSpotsUseCase) Execute(input OfferReleasedSpotsInputDto) (*OfferReleasedSpotsOutputDto, error) {
+	//os lugares liberados reabrem as vendas; os oferecidos ficam reservados e não esgotam o evento
+	if err := updateSoldOut(uc.repo, input.EventID); err != nil {
+		log.Printf("Erro ao atualizar o status de esgotado do evento %s: %v\n", input.EventID, err)
+	}
+
+	available, err := uc.repo.FindAvailableSpotsByEventID(input.EventID)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := uc.waitlistRepo.FindWaitlistEntriesByEventID(input.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	offers := []WaitlistEntryDto{}
+	for {
+		entry := domain.NextWaitlistEntry(entries, len(available))
+		if entry == nil {
+			break
+		}
+
+		spots := available[:entry.Quantity]
+		spotIDs := make([]string, len(spots))
+		spotNames := make([]string, len(spots))
+		for i, spot := range spots {
+			spotIDs[i] = spot.ID
+			spotNames[i] = spot.Name
+		}
+
+		//reservando os lugares com exclusividade para o próximo da fila
+		until := time.Now().Add(uc.offerTTL)
+		if err := uc.repo.HoldSpots(spotIDs, entry.Email, until); err != nil {
+			if errors.Is(err, domain.ErrSpotNotAvailable) {
+				//outra compra levou os lugares, a próxima liberação tenta de novo
+				break
+			}
+			return nil, err
+		}
+		if err := entry.Offer(spotIDs, until); err != nil {
+			return nil, err
+		}
+		if err := uc.waitlistRepo.UpdateWaitlistEntry(entry); err != nil {
+			return nil, err
+		}
+		available = available[entry.Quantity:]
+
+		//a falha na notificação não desfaz a oferta, o comprador ainda pode consultá-la
+		err := uc.notifier.NotifyWaitlistOffer(service.WaitlistOffer{
+			EntryID:   entry.ID,
+			EventID:   entry.EventID,
+			Email:     entry.Email,
+			Spots:     spotNames,
+			ExpiresAt: until,
+		})
+		if err != nil {
+			log.Printf("Erro ao notificar a oferta da fila de espera %s: %v\n", entry.ID, err)
+		}
+
+		offers = append(offers, newWaitlistEntryDto(*entry, 0))
+	}
+
+	return &OfferReleasedSpotsOutputDto{Offers: offers}, nil
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+// OverrideSeriesOccurrenceInputDto changes one occurrence of a series. The
+// fields given are kept by the occurrence when the series changes; the
+// fields in Reset follow the series again.
+type OverrideSeriesOccurrenceInputDto struct {
+	SeriesID           string   `json:"series_id"`
+	EventID            string   `json:"event_id"`
+	Name               *string  `json:"name"`
+	Location           *string  `json:"location"`
+	Organization       *string  `json:"organization"`
+	Rating             *string  `json:"rating"`
+	ImageURL           *string  `json:"image_url"`
+	Capacity           *int     `json:"capacity"`
+	Price              *float64 `json:"price"`
+	MaxTicketsPerBuyer *int     `json:"max_tickets_per_buyer"`
+	Reset              []string `json:"reset"`
+}
+
+type OverrideSeriesOccurrenceUseCase struct {
+	repo       domain.EventRepository
+	seriesRepo domain.EventSeriesRepository
+}
+
+func NewOverrideSeriesOccurrenceUseCase(repo domain.EventRepository, seriesRepo domain.EventSeriesRepository) *OverrideSeriesOccurrenceUseCase {
+	return &OverrideSeriesOccurrenceUseCase{
+		repo:       repo,
+		seriesRepo: seriesRepo,
+	}
+}
+
+func (uc *OverrideSeriesOccurrenceUseCase) Execute(input OverrideSeriesOccurrenceInputDto) (*EventDto, error) {
+	series, err := uc.seriesRepo.GetEventSeriesByID(input.SeriesID)
+	if err != nil {
+		return nil, err
+	}
+	event, err := uc.repo.GetEventByID(input.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.SeriesID != series.ID {
+		return nil, domain.ErrEventNotInSeries
+	}
+	if event.Date.Before(time.Now()) {
+		return nil, domain.ErrSeriesOccurrenceAlreadyPast
+	}
+
+	for _, field := range input.Reset {
+		if err := event.ResetSeriesField(*series, field); err != nil {
+			return nil, err
+		}
+	}
+
+	//cada campo informado passa a ser próprio da ocorrência
+	overrides := []struct {
+		field string
+		set   bool
+		apply func()
+	}{
+		{domain.SeriesFieldName, input.Name != nil, func() { event.Name = *input.Name }},
+		{domain.SeriesFieldLocation, input.Location != nil, func() { event.Location = *input.Location }},
+		{domain.SeriesFieldOrganization, input.Organization != nil, func() { event.Organization = *input.Organization }},
+		{domain.SeriesFieldRating, input.Rating != nil, func() { event.Rating = domain.Rating(*input.Rating) }},
+		{domain.SeriesFieldImageURL, input.ImageURL != nil, func() { event.ImageURL = *input.ImageURL }},
+		{domain.SeriesFieldCapacity, input.Capacity != nil, func() { event.Capacity = *input.Capacity }},
+		{domain.SeriesFieldPrice, input.Price != nil, func() { event.Price = *input.Price }},
+		{domain.SeriesFieldMaxTicketsPerBuyer, input.MaxTicketsPerBuyer != nil, func() { event.MaxTicketsPerBuyer = *input.MaxTicketsPerBuyer }},
+	}
+	for _, override := range overrides {
+		if !override.set {
+			continue
+		}
+		override.apply()
+		if err := event.OverrideSeriesField(override.field); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := event.CheckSpotCapacity(0); err != nil {
+		return nil, err
+	}
+	if err := event.Validade(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.seriesRepo.UpdateSeriesOccurrence(event); err != nil {
+		return nil, err
+	}
+
+	output := newEventDto(*event)
+	return &output, nil
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+// RecurringJobDto declares a recurring job, named after its handler
+type RecurringJobDto struct {
+	Name     string `json:"name"`
+	Schedule string `json:"schedule"`
+}
+
+type RegisterScheduledJobsInputDto struct {
+	Jobs []RecurringJobDto `json:"jobs"`
+}
+
+// RegisterScheduledJobsUseCase stores the recurring jobs declared by the
+// server when it starts. Jobs already stored keep their next run unless
+// their schedule changed.
+type RegisterScheduledJobsUseCase struct {
+	jobRepo  domain.JobRepository
+	handlers JobHandlers
+}
+
+func NewRegisterScheduledJobsUseCase(jobRepo domain.JobRepository, handlers JobHandlers) *RegisterScheduledJobsUseCase {
+	return &RegisterScheduledJobsUseCase{jobRepo: jobRepo, handlers: handlers}
+}
+
+func (uc *RegisterScheduledJobsUseCase) Execute(input RegisterScheduledJobsInputDto) (*ListJobsOutputDto, error) {
+	now := time.Now()
+	output := &ListJobsOutputDto{Jobs: make([]JobDto, len(input.Jobs))}
+	for i, dto := range input.Jobs {
+		if _, ok := uc.handlers[dto.Name]; !ok {
+			return nil, fmt.Errorf("%w: %s", domain.ErrJobHandlerNotFound, dto.Name)
+		}
+
+		job, err := uc.jobRepo.GetJobByName(dto.Name)
+		if errors.Is(err, domain.ErrJobNotFound) {
+			job, err = domain.CreatedNewJob(dto.Name, dto.Schedule, now)
+		} else if err == nil {
+			err = job.Reschedule(dto.Schedule, now)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("job %s: %w", dto.Name, err)
+		}
+
+		if err := uc.jobRepo.SaveJob(job); err != nil {
+			return nil, err
+		}
+		output.Jobs[i] = newJobDto(*job)
+	}
+	return output, nil
+}
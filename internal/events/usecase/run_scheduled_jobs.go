@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+// JobHandler does the work of a job and returns a summary of what it did,
+// stored in the run history
+type JobHandler func() (any, error)
+
+// JobHandlers maps the handler names to the functions of the jobs
+type JobHandlers map[string]JobHandler
+
+type RunScheduledJobsOutputDto struct {
+	Runs []JobRunDto `json:"runs"`
+}
+
+// RunScheduledJobsUseCase runs the jobs that are due. Every replica of the
+// server executes it, but each job runs in only one of them: the one that
+// takes the job lock. The job is read again under the lock, so a run that
+// another replica just finished is not repeated.
+type RunScheduledJobsUseCase struct {
+	jobRepo  domain.JobRepository
+	locker   domain.JobLocker
+	handlers JobHandlers
+	runner   string
+}
+
+func NewRunScheduledJobsUseCase(jobRepo domain.JobRepository, locker domain.JobLocker, handlers JobHandlers, runner string) *RunScheduledJobsUseCase {
+	return &RunScheduledJobsUseCase{
+		jobRepo:  jobRepo,
+		locker:   locker,
+		handlers: handlers,
+		runner:   runner,
+	}
+}
+
+func (uc *RunScheduledJobsUseCase) Execute() (*RunScheduledJobsOutputDto, error) {
+	jobs, err := uc.jobRepo.FindDueJobs(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	output := &RunScheduledJobsOutputDto{Runs: []JobRunDto{}}
+	for _, job := range jobs {
+		run, err := uc.runLocked(job.Name)
+		if err != nil {
+			return nil, err
+		}
+		if run != nil {
+			output.Runs = append(output.Runs, newJobRunDto(*run))
+		}
+	}
+	return output, nil
+}
+
+// runLocked runs the job if this instance takes its lock and it is still
+// due. It returns nil when the job was left to another instance.
+func (uc *RunScheduledJobsUseCase) runLocked(name string) (*domain.JobRun, error) {
+	unlock, acquired, err := uc.locker.TryLockJob(name)
+	if err != nil {
+		return nil, err
+	}
+	if !acquired {
+		return nil, nil
+	}
+	defer unlock()
+
+	job, err := uc.jobRepo.GetJobByName(name)
+	if err != nil {
+		return nil, err
+	}
+	startedAt := time.Now()
+	if !job.IsDue(startedAt) {
+		return nil, nil
+	}
+
+	run := domain.CreatedNewJobRun(*job, uc.runner, startedAt)
+	if err := uc.jobRepo.CreateJobRun(run); err != nil {
+		return nil, err
+	}
+	result, runErr := uc.run(*job)
+	if runErr != nil {
+		log.Printf("Erro ao executar o job %s: %v\n", job.Name, runErr)
+	}
+	run.Finish(result, runErr, time.Now())
+	if err := uc.jobRepo.UpdateJobRun(run); err != nil {
+		return nil, err
+	}
+
+	//o job falho também segue o agendamento; a próxima execução tenta de novo
+	if err := job.Finish(startedAt, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := uc.jobRepo.SaveJob(job); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// run calls the handler of the job, turning a panic into a failed run so
+// one broken job does not stop the scheduler
+func (uc *RunScheduledJobsUseCase) run(job domain.Job) (result string, err error) {
+	handler, ok := uc.handlers[job.Handler]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", domain.ErrJobHandlerNotFound, job.Handler)
+	}
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("job panicked: %v", recovered)
+		}
+	}()
+
+	output, err := handler()
+	if err != nil {
+		return "", err
+	}
+	encoded, err := json.Marshal(output)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+// ScheduleJobInputDto schedules a one-off run of the handler at RunAt, an
+// RFC 3339 time, or as soon as possible when RunAt is empty
+type ScheduleJobInputDto struct {
+	Handler string `json:"-"`
+	RunAt   string `json:"run_at"`
+}
+
+type ScheduleJobUseCase struct {
+	jobRepo  domain.JobRepository
+	handlers JobHandlers
+}
+
+func NewScheduleJobUseCase(jobRepo domain.JobRepository, handlers JobHandlers) *ScheduleJobUseCase {
+	return &ScheduleJobUseCase{jobRepo: jobRepo, handlers: handlers}
+}
+
+func (uc *ScheduleJobUseCase) Execute(input ScheduleJobInputDto) (*JobDto, error) {
+	if _, ok := uc.handlers[input.Handler]; !ok {
+		return nil, fmt.Errorf("%w: %s", domain.ErrJobHandlerNotFound, input.Handler)
+	}
+
+	now := time.Now()
+	runAt := now
+	if input.RunAt != "" {
+		var err error
+		runAt, err = time.Parse(time.RFC3339, input.RunAt)
+		if err != nil {
+			return nil, domain.ErrJobRunAtInvalid
+		}
+	}
+
+	job, err := domain.CreatedNewOneOffJob(input.Handler, runAt, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.jobRepo.SaveJob(job); err != nil {
+		return nil, err
+	}
+
+	output := newJobDto(*job)
+	return &output, nil
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import "go-backend-api/internal/events/domain"
+
+type SearchEventsInputDto struct {
+	Query string `json:"q"`
+	Limit int    `json:"limit"`
+}
+
+type SearchEventsOutputDto struct {
+	Events []EventDto `json:"events"`
+}
+
+type SearchEventsUseCase struct {
+	index domain.EventSearchIndex
+}
+
+func NewSearchEventsUseCase(index domain.EventSearchIndex) *SearchEventsUseCase {
+	return &SearchEventsUseCase{index: index}
+}
+
+func (uc *SearchEventsUseCase) Execute(input SearchEventsInputDto) (*SearchEventsOutputDto, error) {
+	terms, err := domain.SearchTerms(input.Query)
+	if err != nil {
+		return nil, err
+	}
+	limit, err := domain.CheckSearchLimit(input.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := uc.index.SearchEvents(terms, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	eventDto := make([]EventDto, len(events))
+	for i, event := range events {
+		eventDto[i] = newEventDto(event)
+	}
+
+	return &SearchEventsOutputDto{Events: eventDto}, nil
+}
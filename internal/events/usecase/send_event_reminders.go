@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+type SendEventRemindersOutputDto struct {
+	Events  int `json:"events"`
+	Holders int `json:"holders"`
+}
+
+// SendEventRemindersUseCase queues the reminder email for the ticket
+// holders of the events starting within domain.EventReminderLead. It runs
+// often; the outbox keeps a single reminder per holder of each event, so
+// later runs do not send it again.
+type SendEventRemindersUseCase struct {
+	repo             domain.EventRepository
+	ticketRepo       domain.TicketRepository
+	notificationRepo domain.NotificationRepository
+}
+
+func NewSendEventRemindersUseCase(repo domain.EventRepository, ticketRepo domain.TicketRepository, notificationRepo domain.NotificationRepository) *SendEventRemindersUseCase {
+	return &SendEventRemindersUseCase{
+		repo:             repo,
+		ticketRepo:       ticketRepo,
+		notificationRepo: notificationRepo,
+	}
+}
+
+func (uc *SendEventRemindersUseCase) Execute() (*SendEventRemindersOutputDto, error) {
+	now := time.Now()
+
+	events, err := uc.repo.FindEventsStartingBetween(now, now.Add(domain.EventReminderLead))
+	if err != nil {
+		return nil, err
+	}
+
+	output := &SendEventRemindersOutputDto{Events: len(events)}
+	for _, event := range events {
+		tickets, err := uc.ticketRepo.FindTicketsByEventID(event.ID)
+		if err != nil {
+			return nil, err
+		}
+		holders, byHolder := ticketsByHolder(tickets)
+		for _, holder := range holders {
+			data := newEventEmailData(event, byHolder[holder])
+			err := queueNotification(uc.notificationRepo, domain.NotificationReminder, domain.DefaultNotificationLocale, holder, event.ID+":"+holder, data)
+			if err != nil {
+				return nil, err
+			}
+		}
+		output.Holders += len(holders)
+	}
+
+	return output, nil
+}
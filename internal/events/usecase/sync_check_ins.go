@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+type OfflineScanDto struct {
+	Credential string    `json:"credential"`
+	Gate       string    `json:"gate"`
+	ScannedAt  time.Time `json:"scanned_at"`
+}
+
+type SyncCheckInsInputDto struct {
+	EventID  string           `json:"-"`
+	DeviceID string           `json:"device_id"`
+	Scans    []OfflineScanDto `json:"scans"`
+}
+
+type SyncedScanDto struct {
+	Result    string          `json:"result"`
+	TicketID  string          `json:"ticket_id,omitempty"`
+	ScannedAt time.Time       `json:"scanned_at"`
+	Gate      string          `json:"gate"`
+	Reason    string          `json:"reason,omitempty"`
+	FirstScan *CheckInScanDto `json:"first_scan,omitempty"`
+	Replaced  *CheckInScanDto `json:"replaced,omitempty"`
+}
+
+type SyncCheckInsOutputDto struct {
+	Accepted  int             `json:"accepted"`
+	Duplicate int             `json:"duplicate"`
+	Rejected  int             `json:"rejected"`
+	Scans     []SyncedScanDto `json:"scans"`
+}
+
+// SyncCheckInsUseCase applies the scans an offline scanner made with the
+// check-in manifest. The earliest scan of a ticket wins, wherever it was
+// made, and syncing the same scans again does not change the result.
+type SyncCheckInsUseCase struct {
+	scanner ticketScanner
+}
+
+func NewSyncCheckInsUseCase(repo domain.EventRepository, ticketRepo domain.TicketRepository, keyRing *domain.CredentialKeyRing) *SyncCheckInsUseCase {
+	return &SyncCheckInsUseCase{
+		scanner: ticketScanner{repo: repo, ticketRepo: ticketRepo, keyRing: keyRing},
+	}
+}
+
+func (uc *SyncCheckInsUseCase) Execute(input SyncCheckInsInputDto) (*SyncCheckInsOutputDto, error) {
+	if len(input.Scans) > domain.MaxCheckInSyncBatch {
+		return nil, domain.ErrCheckInSyncBatchTooLarge
+	}
+	if _, err := uc.scanner.repo.GetEventByID(input.EventID); err != nil {
+		return nil, err
+	}
+
+	//aplicando as leituras em ordem cronológica, respondendo na ordem recebida
+	order := make([]int, len(input.Scans))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return input.Scans[order[a]].ScannedAt.Before(input.Scans[order[b]].ScannedAt)
+	})
+
+	now := time.Now()
+	output := &SyncCheckInsOutputDto{Scans: make([]SyncedScanDto, len(input.Scans))}
+	for _, i := range order {
+		synced := uc.syncScan(input.Scans[i], input.EventID, input.DeviceID, now)
+		switch domain.ScanResult(synced.Result) {
+		case domain.ScanResultAccepted:
+			output.Accepted++
+		case domain.ScanResultDuplicate:
+			output.Duplicate++
+		default:
+			output.Rejected++
+		}
+		output.Scans[i] = synced
+	}
+	return output, nil
+}
+
+func (uc *SyncCheckInsUseCase) syncScan(offline OfflineScanDto, eventID, deviceID string, now time.Time) SyncedScanDto {
+	synced := SyncedScanDto{ScannedAt: offline.ScannedAt, Gate: offline.Gate}
+	scan, err := domain.CreatedNewTicketScan(offline.Gate, deviceID, domain.ScanSourceOffline, offline.ScannedAt, now)
+	if err != nil {
+		synced.Result = string(domain.ScanResultRejected)
+		synced.Reason = err.Error()
+		return synced
+	}
+
+	checkIn, err := uc.scanner.checkIn(scan, offline.Credential, eventID)
+	synced.TicketID = scan.TicketID
+	if isSameCheckIn(err, scan) {
+		synced.Result = string(domain.ScanResultAccepted)
+		return synced
+	}
+	if err != nil {
+		synced.Result = string(scan.Result)
+		synced.Reason = err.Error()
+		var duplicate *domain.DuplicateCheckInError
+		if errors.As(err, &duplicate) {
+			synced.FirstScan = &CheckInScanDto{CheckedInAt: duplicate.CheckedInAt, Gate: duplicate.Gate}
+		}
+		return synced
+	}
+	synced.Result = checkIn.Result
+	synced.Replaced = checkIn.Replaced
+	return synced
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"go-backend-api/internal/events/domain"
+)
+
+type TicketTemplateDto struct {
+	Organization string `json:"organization"`
+	Layout       string `json:"layout"`
+	AccentColor  string `json:"accent_color"`
+	HeaderText   string `json:"header_text"`
+	FooterText   string `json:"footer_text"`
+	ShowPrice    bool   `json:"show_price"`
+}
+
+func newTicketTemplateDto(template domain.TicketTemplate) TicketTemplateDto {
+	return TicketTemplateDto{
+		Organization: template.Organization,
+		Layout:       string(template.Layout),
+		AccentColor:  template.AccentColor,
+		HeaderText:   template.HeaderText,
+		FooterText:   template.FooterText,
+		ShowPrice:    template.ShowPrice,
+	}
+}
+
+type GetTicketTemplateInputDto struct {
+	Organization string `json:"organization"`
+}
+
+// GetTicketTemplateUseCase returns the template the tickets of the
+// organization are printed with, the default one when it has none
+type GetTicketTemplateUseCase struct {
+	templateRepo domain.TicketTemplateRepository
+}
+
+func NewGetTicketTemplateUseCase(templateRepo domain.TicketTemplateRepository) *GetTicketTemplateUseCase {
+	return &GetTicketTemplateUseCase{templateRepo: templateRepo}
+}
+
+func (uc *GetTicketTemplateUseCase) Execute(input GetTicketTemplateInputDto) (*TicketTemplateDto, error) {
+	template, err := findTicketTemplate(uc.templateRepo, input.Organization)
+	if err != nil {
+		return nil, err
+	}
+	output := newTicketTemplateDto(*template)
+	return &output, nil
+}
+
+type SaveTicketTemplateInputDto struct {
+	Organization string `json:"-"`
+	Layout       string `json:"layout"`
+	AccentColor  string `json:"accent_color"`
+	HeaderText   string `json:"header_text"`
+	FooterText   string `json:"footer_text"`
+	ShowPrice    bool   `json:"show_price"`
+}
+
+type SaveTicketTemplateUseCase struct {
+	templateRepo domain.TicketTemplateRepository
+}
+
+func NewSaveTicketTemplateUseCase(templateRepo domain.TicketTemplateRepository) *SaveTicketTemplateUseCase {
+	return &SaveTicketTemplateUseCase{templateRepo: templateRepo}
+}
+
+func (uc *SaveTicketTemplateUseCase) Execute(input SaveTicketTemplateInputDto) (*TicketTemplateDto, error) {
+	template, err := domain.CreatedNewTicketTemplate(
+		input.Organization,
+		domain.TicketTemplateLayout(input.Layout),
+		input.AccentColor,
+		input.HeaderText,
+		input.FooterText,
+		input.ShowPrice,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.templateRepo.SaveTicketTemplate(template); err != nil {
+		return nil, err
+	}
+	output := newTicketTemplateDto(*template)
+	return &output, nil
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"go-backend-api/internal/events/domain"
+	"go-backend-api/internal/events/infra/service"
+)
+
+type TransferTicketInputDto struct {
+	TicketID string `json:"-"`
+	Email    string `json:"email"`
+	ToEmail  string `json:"to_email"`
+}
+
+type TicketTransferDto struct {
+	ID                string     `json:"id"`
+	TicketID          string     `json:"ticket_id"`
+	EventID           string     `json:"event_id"`
+	FromEmail         string     `json:"from_email"`
+	ToEmail           string     `json:"to_email"`
+	Status            string     `json:"status"`
+	CredentialVersion int        `json:"credential_version,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	ExpiresAt         time.Time  `json:"expires_at"`
+	ResolvedAt        *time.Time `json:"resolved_at,omitempty"`
+}
+
+func newTicketTransferDto(transfer domain.TicketTransfer) TicketTransferDto {
+	dto := TicketTransferDto{
+		ID:                transfer.ID,
+		TicketID:          transfer.TicketID,
+		EventID:           transfer.EventID,
+		FromEmail:         transfer.FromEmail,
+		ToEmail:           transfer.ToEmail,
+		Status:            string(transfer.Status),
+		CredentialVersion: transfer.CredentialVersion,
+		CreatedAt:         transfer.CreatedAt,
+		ExpiresAt:         transfer.ExpiresAt,
+	}
+	if !transfer.ResolvedAt.IsZero() {
+		dto.ResolvedAt = &transfer.ResolvedAt
+	}
+	return dto
+}
+
+type TransferTicketUseCase struct {
+	repo       domain.EventRepository
+	ticketRepo domain.TicketRepository
+	notifier   service.TicketTransferNotifier
+}
+
+func NewTransferTicketUseCase(repo domain.EventRepository, ticketRepo domain.TicketRepository, notifier service.TicketTransferNotifier) *TransferTicketUseCase {
+	return &TransferTicketUseCase{
+		repo:       repo,
+		ticketRepo: ticketRepo,
+		notifier:   notifier,
+	}
+}
+
+func (uc *TransferTicketUseCase) Execute(input TransferTicketInputDto) (*TicketTransferDto, error) {
+	now := time.Now()
+
+	ticket, err := uc.ticketRepo.GetTicketByID(input.TicketID)
+	if err != nil {
+		return nil, err
+	}
+	event, err := uc.repo.GetEventByID(ticket.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	//uma transferência vencida não impede uma nova
+	if _, err := findOpenTicketTransfer(uc.ticketRepo, ticket.ID, now); err == nil {
+		return nil, domain.ErrTicketTransferPending
+	} else if !errors.Is(err, domain.ErrTicketTransferNotFound) {
+		return nil, err
+	}
+
+	transfer, err := domain.CreatedNewTicketTransfer(*event, *ticket, input.Email, input.ToEmail, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.ticketRepo.CreateTicketTransfer(transfer); err != nil {
+		return nil, err
+	}
+
+	//a falha na notificação não desfaz a transferência, o remetente pode cancelá-la e enviar de novo
+	err = uc.notifier.NotifyTicketTransfer(service.TicketTransferOffer{
+		TransferID:     transfer.ID,
+		TicketID:       transfer.TicketID,
+		EventID:        transfer.EventID,
+		FromEmail:      transfer.FromEmail,
+		Email:          transfer.ToEmail,
+		AcceptanceCode: transfer.AcceptanceCode,
+		ExpiresAt:      transfer.ExpiresAt,
+	})
+	if err != nil {
+		log.Printf("Erro ao notificar a transferência %s para %s: %v\n", transfer.ID, transfer.ToEmail, err)
+	}
+
+	output := newTicketTransferDto(*transfer)
+	return &output, nil
+}
+
+// findOpenTicketTransfer returns the transfer of the ticket waiting for the
+// recipient, expiring the ones that were not accepted in time
+func findOpenTicketTransfer(ticketRepo domain.TicketRepository, ticketID string, now time.Time) (*domain.TicketTransfer, error) {
+	transfers, err := ticketRepo.FindTicketTransfersByTicketID(ticketID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range transfers {
+		if transfers[i].IsOpen(now) {
+			return &transfers[i], nil
+		}
+		if transfers[i].Expire(now) {
+			err := ticketRepo.UpdateTicketTransfer(&transfers[i])
+			if err != nil && !errors.Is(err, domain.ErrTicketTransferNotPending) {
+				return nil, err
+			}
+		}
+	}
+	return nil, domain.ErrTicketTransferNotFound
+}
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"errors"
+
+	"go-backend-api/internal/events/domain"
+)
+
+type UpdateCouponInputDto struct {
+	ID string `json:"id"`
+	CreateCouponInputDto
+}
+
+type UpdateCouponUseCase struct {
+	repo domain.CouponRepository
+}
+
+func NewUpdateCouponUseCase(repo domain.CouponRepository) *UpdateCouponUseCase {
+	return &UpdateCouponUseCase{repo: repo}
+}
+
+// Execute replaces the coupon configuration. The usage counter is kept, so
+// lowering MaxUses below UsedCount only prevents further redemptions.
+func (uc *UpdateCouponUseCase) Execute(input UpdateCouponInputDto) (*CouponDto, error) {
+	coupon, err := uc.repo.GetCouponByID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	code := domain.NormalizeCouponCode(input.Code)
+	if code != coupon.Code {
+		existing, err := uc.repo.GetCouponByCode(code)
+		if err == nil && existing.ID != coupon.ID {
+			return nil, domain.ErrCouponAlreadyExists
+		}
+		if err != nil && !errors.Is(err, domain.ErrCouponNotFound) {
+			return nil, err
+		}
+	}
+
+	coupon.Code = code
+	coupon.DiscountType = domain.DiscountType(input.DiscountType)
+	coupon.Value = input.Value
+	coupon.ValidFrom = input.ValidFrom
+	coupon.ValidUntil = input.ValidUntil
+	coupon.MaxUses = input.MaxUses
+	coupon.MaxUsesPerEmail = input.MaxUsesPerEmail
+	coupon.EventIDs = input.EventIDs
+	coupon.TicketKinds = toTicketKinds(input.TicketKinds)
+	if err := coupon.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.UpdateCoupon(coupon); err != nil {
+		return nil, err
+	}
+	//relendo o cupom para devolver o contador de usos atual
+	coupon, err = uc.repo.GetCouponByID(coupon.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := newCouponDto(coupon)
+	return &output, nil
+}
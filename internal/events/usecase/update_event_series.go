@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+type UpdateEventSeriesInputDto struct {
+	ID                 string  `json:"id"`
+	Name               string  `json:"name"`
+	Location           string  `json:"location"`
+	Organization       string  `json:"organization"`
+	Rating             string  `json:"rating"`
+	ImageURL           string  `json:"image_url"`
+	Capacity           int     `json:"capacity"`
+	Price              float64 `json:"price"`
+	MaxTicketsPerBuyer int     `json:"max_tickets_per_buyer"`
+}
+
+type UpdateEventSeriesUseCase struct {
+	repo       domain.EventRepository
+	seriesRepo domain.EventSeriesRepository
+}
+
+func NewUpdateEventSeriesUseCase(repo domain.EventRepository, seriesRepo domain.EventSeriesRepository) *UpdateEventSeriesUseCase {
+	return &UpdateEventSeriesUseCase{
+		repo:       repo,
+		seriesRepo: seriesRepo,
+	}
+}
+
+// Execute replaces the series configuration and applies it to the future
+// occurrences, except for the fields they override. Past occurrences, the
+// dates, the spots and the ticket categories are not changed.
+func (uc *UpdateEventSeriesUseCase) Execute(input UpdateEventSeriesInputDto) (*EventSeriesDto, error) {
+	series, err := uc.seriesRepo.GetEventSeriesByID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	series.Name = input.Name
+	series.Location = input.Location
+	series.Organization = input.Organization
+	series.Rating = domain.Rating(input.Rating)
+	series.ImageURL = input.ImageURL
+	series.Capacity = input.Capacity
+	series.Price = input.Price
+	series.MaxTicketsPerBuyer = input.MaxTicketsPerBuyer
+	if err := series.Validate(); err != nil {
+		return nil, err
+	}
+
+	occurrences, err := uc.repo.ListEventsBySeriesID(series.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	//só as ocorrências futuras acompanham a série
+	now := time.Now()
+	future := []domain.Event{}
+	for i := range occurrences {
+		if occurrences[i].Date.Before(now) {
+			continue
+		}
+		if err := series.ApplyTo(&occurrences[i]); err != nil {
+			return nil, err
+		}
+		future = append(future, occurrences[i])
+	}
+
+	if err := uc.seriesRepo.UpdateEventSeries(series, future); err != nil {
+		return nil, err
+	}
+
+	output := newEventSeriesDto(series, occurrences)
+	return &output, nil
+}
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"log"
+	"slices"
+	"time"
+
+	"go-backend-api/internal/events/domain"
+)
+
+// UpdateSpotsInputDto blocks, unblocks or renames the spots of the event in
+// one change, recorded in the audit trail with Actor, the organizer who made it
+type UpdateSpotsInputDto struct {
+	EventID string              `json:"-"`
+	Actor   string              `json:"actor"`
+	Updates []domain.SpotUpdate `json:"updates"`
+}
+
+// UpdateSpotsOutputDto has the changed spots and, when spots were unblocked,
+// the waitlist offers made with them
+type UpdateSpotsOutputDto struct {
+	Spots   []SpotDto           `json:"spots"`
+	Changes []domain.SpotChange `json:"changes"`
+	Offers  []WaitlistEntryDto  `json:"offers"`
+}
+
+type UpdateSpotsUseCase struct {
+	repo                      domain.EventRepository
+	offerReleasedSpotsUseCase *OfferReleasedSpotsUseCase
+}
+
+func NewUpdateSpotsUseCase(repo domain.EventRepository, offerReleasedSpotsUseCase *OfferReleasedSpotsUseCase) *UpdateSpotsUseCase {
+	return &UpdateSpotsUseCase{repo: repo, offerReleasedSpotsUseCase: offerReleasedSpotsUseCase}
+}
+
+func (uc *UpdateSpotsUseCase) Execute(input UpdateSpotsInputDto) (*UpdateSpotsOutputDto, error) {
+	if len(input.Updates) == 0 {
+		return nil, domain.ErrSpotUpdateEmpty
+	}
+
+	output := &UpdateSpotsOutputDto{Spots: []SpotDto{}, Offers: []WaitlistEntryDto{}}
+	err := uc.repo.ChangeSpots(input.EventID, func(spots []domain.Spot) ([]domain.SpotChange, error) {
+		changes, err := domain.UpdateSpots(spots, input.Updates, input.Actor, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		output.Changes = changes
+		for _, spot := range spots {
+			if slices.ContainsFunc(input.Updates, func(update domain.SpotUpdate) bool { return update.SpotID == spot.ID }) {
+				output.Spots = append(output.Spots, newSpotDto(spot))
+			}
+		}
+		return changes, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	//os lugares desbloqueados voltam à venda e vão primeiro para a fila de espera
+	unblocked := slices.ContainsFunc(output.Changes, func(change domain.SpotChange) bool {
+		return change.Action == domain.SpotChangeUnblocked
+	})
+	//a alteração já foi gravada, uma falha aqui não deve ser devolvida ao organizador
+	if unblocked {
+		offered, err := uc.offerReleasedSpotsUseCase.Execute(OfferReleasedSpotsInputDto{EventID: input.EventID})
+		if err != nil {
+			log.Printf("Erro ao oferecer os lugares desbloqueados do evento %s à fila de espera: %v\n", input.EventID, err)
+		} else {
+			output.Offers = offered.Offers
+		}
+	} else if err := updateSoldOut(uc.repo, input.EventID); err != nil {
+		//bloquear os últimos lugares à venda esgota o evento
+		log.Printf("Erro ao atualizar o status de esgotado do evento %s: %v\n", input.EventID, err)
+	}
+	return output, nil
+}
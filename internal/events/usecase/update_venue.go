@@ -0,0 +1,47 @@
+package usecase
+
+import "go-backend-api/internal/events/domain"
+
+type UpdateVenueInputDto struct {
+	ID string `json:"id"`
+	CreateVenueInputDto
+}
+
+type UpdateVenueUseCase struct {
+	repo domain.VenueRepository
+}
+
+func NewUpdateVenueUseCase(repo domain.VenueRepository) *UpdateVenueUseCase {
+	return &UpdateVenueUseCase{repo: repo}
+}
+
+// Execute replaces the venue data. Spots already generated for events keep
+// the layout they were created from; a new layout applies to new spots only.
+func (uc *UpdateVenueUseCase) Execute(input UpdateVenueInputDto) (*VenueDto, error) {
+	venue, err := uc.repo.GetVenueByID(input.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := domain.CreatedNewVenue(
+		input.Name,
+		input.Address,
+		input.City,
+		input.Latitude,
+		input.Longitude,
+		input.Timezone,
+		input.Capacity,
+		input.Layout,
+	)
+	if err != nil {
+		return nil, err
+	}
+	updated.ID = venue.ID
+
+	if err := uc.repo.UpdateVenue(updated); err != nil {
+		return nil, err
+	}
+
+	output := newVenueDto(updated)
+	return &output, nil
+}
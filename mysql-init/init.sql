@@ -4,6 +4,41 @@ CREATE DATABASE test_db;
 
 USE test_db;
 
+CREATE TABLE venues (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  name VARCHAR(255) NOT NULL,
+  address VARCHAR(255) NOT NULL,
+  city VARCHAR(255) NOT NULL,
+  latitude DOUBLE NOT NULL,
+  longitude DOUBLE NOT NULL,
+  timezone VARCHAR(64) NOT NULL,
+  capacity INT NOT NULL,
+  layout TEXT NOT NULL
+);
+
+CREATE TABLE event_series (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  name VARCHAR(255) NOT NULL,
+  location VARCHAR(255) NOT NULL,
+  organization VARCHAR(255) NOT NULL,
+  rating VARCHAR(10) NOT NULL,
+  image_url VARCHAR(255) NOT NULL,
+  capacity INT NOT NULL,
+  price FLOAT NOT NULL,
+  partner_id INT NOT NULL,
+  max_tickets_per_buyer INT NOT NULL DEFAULT 0,
+  venue_id VARCHAR(36),
+  timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+  start DATETIME NOT NULL,
+  recurrence VARCHAR(255) NOT NULL,
+  number_of_spots INT NOT NULL DEFAULT 0,
+  layout TEXT NOT NULL,
+  ticket_categories TEXT NOT NULL,
+  latitude DOUBLE,
+  longitude DOUBLE,
+  FOREIGN KEY (venue_id) REFERENCES venues(id)
+);
+
 CREATE TABLE events (
   id VARCHAR(36) NOT NULL PRIMARY KEY,
   name VARCHAR(255) NOT NULL,
@@ -11,10 +46,33 @@ CREATE TABLE events (
   organization VARCHAR(255) NOT NULL,
   rating VARCHAR(10) NOT NULL,
   date DATETIME NOT NULL,
+  timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
   image_url VARCHAR(255) NOT NULL,
   capacity INT NOT NULL,
   price FLOAT NOT NULL,
-  partner_id INT NOT NULL
+  partner_id INT NOT NULL,
+  max_tickets_per_buyer INT NOT NULL DEFAULT 0,
+  venue_id VARCHAR(36),
+  series_id VARCHAR(36),
+  series_overrides VARCHAR(255) NOT NULL DEFAULT '[]',
+  search_name VARCHAR(255),
+  search_location VARCHAR(255),
+  search_organization VARCHAR(255),
+  latitude DOUBLE,
+  longitude DOUBLE,
+  status VARCHAR(20) NOT NULL DEFAULT 'draft',
+  publish_at DATETIME,
+  on_sale_at DATETIME,
+  transfer_deadline_hours INT NOT NULL DEFAULT 0,
+  archived_at DATETIME,
+  FOREIGN KEY (venue_id) REFERENCES venues(id),
+  FOREIGN KEY (series_id) REFERENCES event_series(id),
+  FULLTEXT INDEX ft_events_search (search_name, search_location, search_organization),
+  FULLTEXT INDEX ft_events_search_name (search_name),
+  FULLTEXT INDEX ft_events_search_location (search_location),
+  FULLTEXT INDEX ft_events_search_organization (search_organization),
+  INDEX idx_events_coordinates (latitude, longitude),
+  INDEX idx_events_status (status, date)
 );
 
 CREATE TABLE spots (
@@ -23,65 +81,247 @@ CREATE TABLE spots (
   name VARCHAR(10) NOT NULL,
   status VARCHAR(10) NOT NULL,
   ticket_id VARCHAR(36),
+  held_by VARCHAR(255),
+  hold_expires_at DATETIME,
+  blocked_reason VARCHAR(255),
+  section VARCHAR(50) NOT NULL DEFAULT '',
+  seat_row VARCHAR(3) NOT NULL DEFAULT '',
+  seat_number INT NOT NULL DEFAULT 0,
+  x DOUBLE NOT NULL DEFAULT 0,
+  y DOUBLE NOT NULL DEFAULT 0,
+  accessible BOOLEAN NOT NULL DEFAULT FALSE,
+  attributes TEXT,
+  UNIQUE KEY uq_spots_event_name (event_id, name),
+  INDEX idx_spots_hold_expires_at (status, hold_expires_at),
+  FOREIGN KEY (event_id) REFERENCES events(id)
+);
+
+CREATE TABLE spot_changes (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  event_id VARCHAR(36) NOT NULL,
+  spot_id VARCHAR(36) NOT NULL,
+  spot_name VARCHAR(10) NOT NULL,
+  action VARCHAR(10) NOT NULL,
+  previous_name VARCHAR(10) NOT NULL DEFAULT '',
+  reason VARCHAR(255) NOT NULL DEFAULT '',
+  actor VARCHAR(255) NOT NULL,
+  created_at DATETIME(6) NOT NULL,
+  INDEX idx_spot_changes_event (event_id, created_at),
+  FOREIGN KEY (event_id) REFERENCES events(id)
+);
+
+CREATE TABLE ticket_categories (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  event_id VARCHAR(36) NOT NULL,
+  kind VARCHAR(20) NOT NULL,
+  name VARCHAR(100) NOT NULL,
+  price_multiplier FLOAT NOT NULL,
+  requirements TEXT NOT NULL,
+  quota INT NOT NULL DEFAULT 0,
+  quota_percent FLOAT NOT NULL DEFAULT 0,
+  partner_ticket_kind VARCHAR(20) NOT NULL DEFAULT '',
+  UNIQUE KEY uq_ticket_categories_kind (event_id, kind),
+  FOREIGN KEY (event_id) REFERENCES events(id)
+);
+
+CREATE TABLE orders (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  event_id VARCHAR(36) NOT NULL,
+  email VARCHAR(255) NOT NULL,
+  card_hash VARCHAR(255) NOT NULL,
+  ip_address VARCHAR(45) NOT NULL,
+  created_at DATETIME NOT NULL,
+  INDEX idx_orders_event_email (event_id, email),
+  INDEX idx_orders_card_hash (card_hash, created_at),
+  INDEX idx_orders_ip_address (ip_address, created_at),
   FOREIGN KEY (event_id) REFERENCES events(id)
 );
 
 CREATE TABLE tickets (
   id VARCHAR(36) NOT NULL PRIMARY KEY,
   event_id VARCHAR(36) NOT NULL,
+  order_id VARCHAR(36),
   spot_id VARCHAR(36) NOT NULL,
-  ticket_kind VARCHAR(10) NOT NULL,
+  ticket_kind VARCHAR(20) NOT NULL,
   price FLOAT NOT NULL,
+  coupon_id VARCHAR(36),
+  discount FLOAT NOT NULL DEFAULT 0,
+  attendee_name VARCHAR(255) NOT NULL DEFAULT '',
+  attendee_document VARCHAR(50) NOT NULL DEFAULT '',
+  holder_email VARCHAR(255) NOT NULL DEFAULT '',
+  credential_version INT NOT NULL DEFAULT 1,
+  checked_in_at DATETIME(6),
+  checked_in_gate VARCHAR(50) NOT NULL DEFAULT '',
   FOREIGN KEY (event_id) REFERENCES events(id),
+  FOREIGN KEY (order_id) REFERENCES orders(id),
   FOREIGN KEY (spot_id) REFERENCES spots(id)
 );
 
-INSERT INTO events (id, name, location, organization, rating, date, image_url, capacity, price, partner_id) VALUES
-  ('10853e59-dc5b-4d7b-a028-01513ef50d76', 'Event 001 - Partner1', 'São Paulo, SP', 'Partner 1', 'L14', '2021-10-10 10:00:00', 'https://images.unsplash.com/photo-1470229722913-7c0e2dbbafd3', 10, 100, 1),
-  ('e0352b32-7698-4805-b029-28302b3a911f', 'Event 002 - Partner1', 'Rio de Janeiro, RJ', 'Partner 1', 'L14', '2021-10-10 12:00:00', 'https://images.unsplash.com/photo-1459749411175-04bf5292ceea', 10, 200, 1),
-  ('5b79831a-a9d3-4538-8fb5-569494bd17a5', 'Event 003 - Partner2', 'Belo Horizonte, MG', 'Partner 2', 'L12', '2024-10-10 10:00:00', 'https://images.unsplash.com/photo-1540039155733-5bb30b53aa14', 10, 400, 2),
-  ('8beff8fd-39e4-49ea-ae5e-a0ec9af888c5', 'Event 004 - Partner2', 'Uberlândia, MG', 'Partner 2', 'L16', '2024-10-10 12:00:00', 'https://images.unsplash.com/photo-1493225457124-a3eb161ffa5f', 10, 500, 2)
+CREATE TABLE ticket_transfers (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  ticket_id VARCHAR(36) NOT NULL,
+  event_id VARCHAR(36) NOT NULL,
+  from_email VARCHAR(255) NOT NULL,
+  to_email VARCHAR(255) NOT NULL,
+  status VARCHAR(10) NOT NULL,
+  acceptance_code VARCHAR(64) NOT NULL,
+  credential_version INT NOT NULL DEFAULT 0,
+  created_at DATETIME(6) NOT NULL,
+  expires_at DATETIME NOT NULL,
+  resolved_at DATETIME,
+  INDEX idx_ticket_transfers_ticket (ticket_id, created_at),
+  FOREIGN KEY (ticket_id) REFERENCES tickets(id),
+  FOREIGN KEY (event_id) REFERENCES events(id)
+);
+
+CREATE TABLE ticket_templates (
+  organization VARCHAR(255) NOT NULL PRIMARY KEY,
+  layout VARCHAR(10) NOT NULL DEFAULT 'page',
+  accent_color CHAR(7) NOT NULL,
+  header_text VARCHAR(200) NOT NULL DEFAULT '',
+  footer_text VARCHAR(200) NOT NULL DEFAULT '',
+  show_price BOOLEAN NOT NULL DEFAULT TRUE
+);
+
+CREATE TABLE ticket_scans (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  ticket_id VARCHAR(36),
+  event_id VARCHAR(36),
+  gate VARCHAR(50) NOT NULL,
+  device_id VARCHAR(100) NOT NULL DEFAULT '',
+  source VARCHAR(10) NOT NULL,
+  result VARCHAR(10) NOT NULL,
+  reason VARCHAR(255) NOT NULL DEFAULT '',
+  scanned_at DATETIME(6) NOT NULL,
+  synced_at DATETIME NOT NULL,
+  INDEX idx_ticket_scans_ticket (ticket_id, scanned_at),
+  INDEX idx_ticket_scans_event (event_id, scanned_at)
+);
+
+CREATE TABLE notifications (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  kind VARCHAR(30) NOT NULL,
+  locale VARCHAR(10) NOT NULL,
+  recipient VARCHAR(255) NOT NULL,
+  dedup_key VARCHAR(255) NOT NULL,
+  data JSON NOT NULL,
+  status VARCHAR(10) NOT NULL,
+  attempts INT NOT NULL DEFAULT 0,
+  next_attempt_at DATETIME(6) NOT NULL,
+  last_error TEXT NOT NULL,
+  created_at DATETIME NOT NULL,
+  sent_at DATETIME,
+  UNIQUE KEY uq_notifications_dedup_key (dedup_key),
+  INDEX idx_notifications_due (status, next_attempt_at)
+);
+
+CREATE TABLE scheduled_jobs (
+  name VARCHAR(60) NOT NULL PRIMARY KEY,
+  handler VARCHAR(60) NOT NULL,
+  schedule VARCHAR(100) NOT NULL,
+  status VARCHAR(10) NOT NULL,
+  next_run_at DATETIME(6) NOT NULL,
+  last_run_at DATETIME(6),
+  created_at DATETIME NOT NULL,
+  INDEX idx_scheduled_jobs_due (status, next_run_at)
+);
+
+CREATE TABLE job_runs (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  job_name VARCHAR(60) NOT NULL,
+  runner VARCHAR(255) NOT NULL,
+  status VARCHAR(10) NOT NULL,
+  output TEXT NOT NULL,
+  error TEXT NOT NULL,
+  started_at DATETIME(6) NOT NULL,
+  finished_at DATETIME(6),
+  INDEX idx_job_runs_job (job_name, started_at)
+);
+
+CREATE TABLE waitlist_entries (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  event_id VARCHAR(36) NOT NULL,
+  email VARCHAR(255) NOT NULL,
+  quantity INT NOT NULL,
+  status VARCHAR(10) NOT NULL,
+  offered_spot_ids TEXT NOT NULL,
+  offer_expires_at DATETIME,
+  created_at DATETIME(6) NOT NULL,
+  INDEX idx_waitlist_event (event_id, created_at),
+  INDEX idx_waitlist_offer_expires_at (status, offer_expires_at),
+  FOREIGN KEY (event_id) REFERENCES events(id)
+);
+
+CREATE TABLE coupons (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  code VARCHAR(64) NOT NULL UNIQUE,
+  discount_type VARCHAR(16) NOT NULL,
+  value FLOAT NOT NULL,
+  valid_from DATETIME NOT NULL,
+  valid_until DATETIME,
+  max_uses INT NOT NULL DEFAULT 0,
+  max_uses_per_email INT NOT NULL DEFAULT 0,
+  used_count INT NOT NULL DEFAULT 0,
+  event_ids TEXT NOT NULL,
+  ticket_kinds TEXT NOT NULL
+);
+
+CREATE TABLE coupon_redemptions (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  coupon_id VARCHAR(36) NOT NULL,
+  email VARCHAR(255) NOT NULL,
+  quantity INT NOT NULL,
+  created_at DATETIME NOT NULL,
+  INDEX idx_coupon_redemptions_email (coupon_id, email),
+  FOREIGN KEY (coupon_id) REFERENCES coupons(id) ON DELETE CASCADE
+);
+
+INSERT INTO events (id, name, location, organization, rating, date, image_url, capacity, price, partner_id, status) VALUES
+  ('10853e59-dc5b-4d7b-a028-01513ef50d76', 'Event 001 - Partner1', 'São Paulo, SP', 'Partner 1', 'L14', '2021-10-10 10:00:00', 'https://images.unsplash.com/photo-1470229722913-7c0e2dbbafd3', 10, 100, 1, 'finished'),
+  ('e0352b32-7698-4805-b029-28302b3a911f', 'Event 002 - Partner1', 'Rio de Janeiro, RJ', 'Partner 1', 'L14', '2021-10-10 12:00:00', 'https://images.unsplash.com/photo-1459749411175-04bf5292ceea', 10, 200, 1, 'finished'),
+  ('5b79831a-a9d3-4538-8fb5-569494bd17a5', 'Event 003 - Partner2', 'Belo Horizonte, MG', 'Partner 2', 'L12', '2024-10-10 10:00:00', 'https://images.unsplash.com/photo-1540039155733-5bb30b53aa14', 10, 400, 2, 'finished'),
+  ('8beff8fd-39e4-49ea-ae5e-a0ec9af888c5', 'Event 004 - Partner2', 'Uberlândia, MG', 'Partner 2', 'L16', '2024-10-10 12:00:00', 'https://images.unsplash.com/photo-1493225457124-a3eb161ffa5f', 10, 500, 2, 'finished')
 ;
 
 INSERT INTO spots (id, event_id, name, status, ticket_id) VALUES
-  ('f1b1b1b1-1b1b-1b1b-1b1b-1b1b1b1b1b1b', '10853e59-dc5b-4d7b-a028-01513ef50d76', 'A1', 'available', ""),
-  ('f2b2b2b2-2b2b-2b2b-2b2b-2b2b2b2b2b2b', '10853e59-dc5b-4d7b-a028-01513ef50d76', 'A2', 'sold', ""),
-  ('f3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b', '10853e59-dc5b-4d7b-a028-01513ef50d76', 'A3', 'available', ""),
-  ('f4b4b4b4-4b4b-4b4b-4b4b-4b4b4b4b4b4b', '10853e59-dc5b-4d7b-a028-01513ef50d76', 'A4', 'available', ""),
-  ('f5b5b5b5-5b5b-5b5b-5b5b-5b5b5b5b5b5b', '10853e59-dc5b-4d7b-a028-01513ef50d76', 'A5', 'available', ""),
-  ('7c022408-c6ec-4575-b362-923822ee83b4', '10853e59-dc5b-4d7b-a028-01513ef50d76', 'B1', 'available', ""),
-  ('f6b6b6b6-6b6b-6b6b-6b6b-6b6b6b6b6b6b', '10853e59-dc5b-4d7b-a028-01513ef50d76', 'B2', 'sold', ""),
-  ('f7b7b7b7-7b7b-7b7b-7b7b-7b7b7b7b7b7b', '10853e59-dc5b-4d7b-a028-01513ef50d76', 'B3', 'available', ""),
-  ('f8b8b8b8-8b8b-8b8b-8b8b-8b8b8b8b8b8b', '10853e59-dc5b-4d7b-a028-01513ef50d76', 'B4', 'available', ""),
-  ('f9b9b9b9-9b9b-9b9b-9b9b-9b9b9b9b9b9b', '10853e59-dc5b-4d7b-a028-01513ef50d76', 'B5', 'reserved', ""),
-  ('deb28dbe-cbe1-4bf3-a7e3-bce4aa52b54f', 'e0352b32-7698-4805-b029-28302b3a911f', 'A1', 'sold', ""),
-  ('e0e0e0e0-0e0e-0e0e-0e0e-0e0e0e0e0e0e', 'e0352b32-7698-4805-b029-28302b3a911f', 'A2', 'available', ""),
-  ('e1e1e1e1-1e1e-1e1e-1e1e-1e1e1e1e1e1e', 'e0352b32-7698-4805-b029-28302b3a911f', 'A3', 'available', ""),
-  ('e2e2e2e2-2e2e-2e2e-2e2e-2e2e2e2e2e2e', 'e0352b32-7698-4805-b029-28302b3a911f', 'A4', 'sold', ""),
-  ('e3e3e3e3-3e3e-3e3e-3e3e-3e3e3e3e3e3e', 'e0352b32-7698-4805-b029-28302b3a911f', 'A5', 'reserved', ""),
-  ('6c7bdf8d-9146-43df-8b0b-3ae3d4c18cba', 'e0352b32-7698-4805-b029-28302b3a911f', 'B1', 'available', ""),
-  ('e4e4e4e4-4e4e-4e4e-4e4e-4e4e4e4e4e4e', 'e0352b32-7698-4805-b029-28302b3a911f', 'B2', 'available', ""),
-  ('e5e5e5e5-5e5e-5e5e-5e5e-5e5e5e5e5e5e', 'e0352b32-7698-4805-b029-28302b3a911f', 'B3', 'available', ""),
-  ('e6e6e6e6-6e6e-6e6e-6e6e-6e6e6e6e6e6e', 'e0352b32-7698-4805-b029-28302b3a911f', 'B4', 'sold', ""), 
-  ('e7e7e7e7-7e7e-7e7e-7e7e-7e7e7e7e7e7e', 'e0352b32-7698-4805-b029-28302b3a911f', 'B5', 'sold', ""),
-  ('e8e8e8e8-8e8e-8e8e-8e8e-8e8e8e8e8e8e', '5b79831a-a9d3-4538-8fb5-569494bd17a5', 'A1', 'available', ""),
-  ('e9e9e9e9-9e9e-9e9e-9e9e-9e9e9e9e9e9e', '5b79831a-a9d3-4538-8fb5-569494bd17a5', 'A2', 'available', ""),
-  ('fafafafa-afaf-afaf-afaf-afafafafafaf', '5b79831a-a9d3-4538-8fb5-569494bd17a5', 'A3', 'available', ""),
-  ('fbfbfbfb-bfbf-bfbf-bfbf-bfbfbfbfbfbf', '5b79831a-a9d3-4538-8fb5-569494bd17a5', 'A4', 'sold', ""),
-  ('fcfcfcfc-cfcf-cfcf-cfcf-cfcfcfcfcfcf', '5b79831a-a9d3-4538-8fb5-569494bd17a5', 'A5', 'available', ""),
-  ('f8f8f8f8-8f8f-8f8f-8f8f-8f8f8f8f8f8f', '5b79831a-a9d3-4538-8fb5-569494bd17a5', 'B1', 'reserved', ""),
-  ('fdfdfdfd-dfdf-dfdf-dfdf-dfdfdfdfdfdf', '5b79831a-a9d3-4538-8fb5-569494bd17a5', 'B2', 'available', ""),
-  ('fefefefe-efef-efef-efef-efefefefefef', '5b79831a-a9d3-4538-8fb5-569494bd17a5', 'B3', 'sold', ""),
-  ('f0f0f0f0-0f0f-0f0f-0f0f-0f0f0f0f0f0f', '5b79831a-a9d3-4538-8fb5-569494bd17a5', 'B4', 'sold', ""),
-  ('f1f1f1f1-1f1f-1f1f-1f1f-1f1f1f1f1f1f', '5b79831a-a9d3-4538-8fb5-569494bd17a5', 'B5', 'available', ""),
-  ('f2f2f2f2-2f2f-2f2f-2f2f-2f2f2f2f2f2f', '8beff8fd-39e4-49ea-ae5e-a0ec9af888c5', 'A1', 'available', ""),
-  ('f3f3f3f3-3f3f-3f3f-3f3f-3f3f3f3f3f3f', '8beff8fd-39e4-49ea-ae5e-a0ec9af888c5', 'A2', 'available', ""),
-  ('f4f4f4f4-4f4f-4f4f-4f4f-4f4f4f4f4f4f', '8beff8fd-39e4-49ea-ae5e-a0ec9af888c5', 'A3', 'available', ""),
-  ('f5f5f5f5-5f5f-5f5f-5f5f-5f5f5f5f5f5f', '8beff8fd-39e4-49ea-ae5e-a0ec9af888c5', 'A4', 'reserved', ""),
-  ('f6f6f6f6-6f6f-6f6f-6f6f-6f6f6f6f6f6f', '8beff8fd-39e4-49ea-ae5e-a0ec9af888c5', 'A5', 'sold', ""),
-  ('af20c380-b6c8-4c99-b1d9-780871b80ab1', '8beff8fd-39e4-49ea-ae5e-a0ec9af888c5', 'B1', 'sold', ""),
-  ('f7f7f7f7-7f7f-7f7f-7f7f-7f7f7f7f7f7f', '8beff8fd-39e4-49ea-ae5e-a0ec9af888c5', 'B2', 'available', ""),
-  ('cb3e9985-dec6-4c7b-9675-409dad659196', '8beff8fd-39e4-49ea-ae5e-a0ec9af888c5', 'B3', 'reserved', ""),
-  ('f9f9f9f9-9f9f-9f9f-9f9f-9f9f9f9f9f9f', '8beff8fd-39e4-49ea-ae5e-a0ec9af888c5', 'B4', 'available', ""),
-  ('g0g0g0g0-0g0g-0g0g-0g0g-0g0g0g0g0g0g', '8beff8fd-39e4-49ea-ae5e-a0ec9af888c5', 'B5', 'sold', "")
+  ('f1b1b1b1-1b1b-1b1b-1b1b-1b1b1b1b1b1b', '10853e59-dc5b-4d7b-a028-01513ef50d76', 'A1', 'available', NULL),
+  ('f2b2b2b2-2b2b-2b2b-2b2b-2b2b2b2b2b2b', '10853e59-dc5b-4d7b-a028-01513ef50d76', 'A2', 'sold', NULL),
+  ('f3b3b3b3-3b3b-3b3b-3b3b-3b3b3b3b3b3b', '10853e59-dc5b-4d7b-a028-01513ef50d76', 'A3', 'available', NULL),
+  ('f4b4b4b4-4b4b-4b4b-4b4b-4b4b4b4b4b4b', '10853e59-dc5b-4d7b-a028-01513ef50d76', 'A4', 'available', NULL),
+  ('f5b5b5b5-5b5b-5b5b-5b5b-5b5b5b5b5b5b', '10853e59-dc5b-4d7b-a028-01513ef50d76', 'A5', 'available', NULL),
+  ('7c022408-c6ec-4575-b362-923822ee83b4', '10853e59-dc5b-4d7b-a028-01513ef50d76', 'B1', 'available', NULL),
+  ('f6b6b6b6-6b6b-6b6b-6b6b-6b6b6b6b6b6b', '10853e59-dc5b-4d7b-a028-01513ef50d76', 'B2', 'sold', NULL),
+  ('f7b7b7b7-7b7b-7b7b-7b7b-7b7b7b7b7b7b', '10853e59-dc5b-4d7b-a028-01513ef50d76', 'B3', 'available', NULL),
+  ('f8b8b8b8-8b8b-8b8b-8b8b-8b8b8b8b8b8b', '10853e59-dc5b-4d7b-a028-01513ef50d76', 'B4', 'available', NULL),
+  ('f9b9b9b9-9b9b-9b9b-9b9b-9b9b9b9b9b9b', '10853e59-dc5b-4d7b-a028-01513ef50d76', 'B5', 'reserved', NULL),
+  ('deb28dbe-cbe1-4bf3-a7e3-bce4aa52b54f', 'e0352b32-7698-4805-b029-28302b3a911f', 'A1', 'sold', NULL),
+  ('e0e0e0e0-0e0e-0e0e-0e0e-0e0e0e0e0e0e', 'e0352b32-7698-4805-b029-28302b3a911f', 'A2', 'available', NULL),
+  ('e1e1e1e1-1e1e-1e1e-1e1e-1e1e1e1e1e1e', 'e0352b32-7698-4805-b029-28302b3a911f', 'A3', 'available', NULL),
+  ('e2e2e2e2-2e2e-2e2e-2e2e-2e2e2e2e2e2e', 'e0352b32-7698-4805-b029-28302b3a911f', 'A4', 'sold', NULL),
+  ('e3e3e3e3-3e3e-3e3e-3e3e-3e3e3e3e3e3e', 'e0352b32-7698-4805-b029-28302b3a911f', 'A5', 'reserved', NULL),
+  ('6c7bdf8d-9146-43df-8b0b-3ae3d4c18cba', 'e0352b32-7698-4805-b029-28302b3a911f', 'B1', 'available', NULL),
+  ('e4e4e4e4-4e4e-4e4e-4e4e-4e4e4e4e4e4e', 'e0352b32-7698-4805-b029-28302b3a911f', 'B2', 'available', NULL),
+  ('e5e5e5e5-5e5e-5e5e-5e5e-5e5e5e5e5e5e', 'e0352b32-7698-4805-b029-28302b3a911f', 'B3', 'available', NULL),
+  ('e6e6e6e6-6e6e-6e6e-6e6e-6e6e6e6e6e6e', 'e0352b32-7698-4805-b029-28302b3a911f', 'B4', 'sold', NULL), 
+  ('e7e7e7e7-7e7e-7e7e-7e7e-7e7e7e7e7e7e', 'e0352b32-7698-4805-b029-28302b3a911f', 'B5', 'sold', NULL),
+  ('e8e8e8e8-8e8e-8e8e-8e8e-8e8e8e8e8e8e', '5b79831a-a9d3-4538-8fb5-569494bd17a5', 'A1', 'available', NULL),
+  ('e9e9e9e9-9e9e-9e9e-9e9e-9e9e9e9e9e9e', '5b79831a-a9d3-4538-8fb5-569494bd17a5', 'A2', 'available', NULL),
+  ('fafafafa-afaf-afaf-afaf-afafafafafaf', '5b79831a-a9d3-4538-8fb5-569494bd17a5', 'A3', 'available', NULL),
+  ('fbfbfbfb-bfbf-bfbf-bfbf-bfbfbfbfbfbf', '5b79831a-a9d3-4538-8fb5-569494bd17a5', 'A4', 'sold', NULL),
+  ('fcfcfcfc-cfcf-cfcf-cfcf-cfcfcfcfcfcf', '5b79831a-a9d3-4538-8fb5-569494bd17a5', 'A5', 'available', NULL),
+  ('f8f8f8f8-8f8f-8f8f-8f8f-8f8f8f8f8f8f', '5b79831a-a9d3-4538-8fb5-569494bd17a5', 'B1', 'reserved', NULL),
+  ('fdfdfdfd-dfdf-dfdf-dfdf-dfdfdfdfdfdf', '5b79831a-a9d3-4538-8fb5-569494bd17a5', 'B2', 'available', NULL),
+  ('fefefefe-efef-efef-efef-efefefefefef', '5b79831a-a9d3-4538-8fb5-569494bd17a5', 'B3', 'sold', NULL),
+  ('f0f0f0f0-0f0f-0f0f-0f0f-0f0f0f0f0f0f', '5b79831a-a9d3-4538-8fb5-569494bd17a5', 'B4', 'sold', NULL),
+  ('f1f1f1f1-1f1f-1f1f-1f1f-1f1f1f1f1f1f', '5b79831a-a9d3-4538-8fb5-569494bd17a5', 'B5', 'available', NULL),
+  ('f2f2f2f2-2f2f-2f2f-2f2f-2f2f2f2f2f2f', '8beff8fd-39e4-49ea-ae5e-a0ec9af888c5', 'A1', 'available', NULL),
+  ('f3f3f3f3-3f3f-3f3f-3f3f-3f3f3f3f3f3f', '8beff8fd-39e4-49ea-ae5e-a0ec9af888c5', 'A2', 'available', NULL),
+  ('f4f4f4f4-4f4f-4f4f-4f4f-4f4f4f4f4f4f', '8beff8fd-39e4-49ea-ae5e-a0ec9af888c5', 'A3', 'available', NULL),
+  ('f5f5f5f5-5f5f-5f5f-5f5f-5f5f5f5f5f5f', '8beff8fd-39e4-49ea-ae5e-a0ec9af888c5', 'A4', 'reserved', NULL),
+  ('f6f6f6f6-6f6f-6f6f-6f6f-6f6f6f6f6f6f', '8beff8fd-39e4-49ea-ae5e-a0ec9af888c5', 'A5', 'sold', NULL),
+  ('af20c380-b6c8-4c99-b1d9-780871b80ab1', '8beff8fd-39e4-49ea-ae5e-a0ec9af888c5', 'B1', 'sold', NULL),
+  ('f7f7f7f7-7f7f-7f7f-7f7f-7f7f7f7f7f7f', '8beff8fd-39e4-49ea-ae5e-a0ec9af888c5', 'B2', 'available', NULL),
+  ('cb3e9985-dec6-4c7b-9675-409dad659196', '8beff8fd-39e4-49ea-ae5e-a0ec9af888c5', 'B3', 'reserved', NULL),
+  ('f9f9f9f9-9f9f-9f9f-9f9f-9f9f9f9f9f9f', '8beff8fd-39e4-49ea-ae5e-a0ec9af888c5', 'B4', 'available', NULL),
+  ('g0g0g0g0-0g0g-0g0g-0g0g-0g0g0g0g0g0g', '8beff8fd-39e4-49ea-ae5e-a0ec9af888c5', 'B5', 'sold', NULL)
 ;
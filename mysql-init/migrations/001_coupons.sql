@@ -0,0 +1,34 @@
+-- Adds the coupons and the discount of each ticket. Tickets sold before the
+-- coupons keep no coupon and no discount.
+--
+-- Files in this directory are not run by the MySQL entrypoint; apply them
+-- by hand, in order, to databases created before each change:
+--   mysql -u root -p test_db < mysql-init/migrations/001_coupons.sql
+
+ALTER TABLE tickets
+  ADD COLUMN coupon_id VARCHAR(36),
+  ADD COLUMN discount FLOAT NOT NULL DEFAULT 0;
+
+CREATE TABLE coupons (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  code VARCHAR(64) NOT NULL UNIQUE,
+  discount_type VARCHAR(16) NOT NULL,
+  value FLOAT NOT NULL,
+  valid_from DATETIME NOT NULL,
+  valid_until DATETIME,
+  max_uses INT NOT NULL DEFAULT 0,
+  max_uses_per_email INT NOT NULL DEFAULT 0,
+  used_count INT NOT NULL DEFAULT 0,
+  event_ids TEXT NOT NULL,
+  ticket_kinds TEXT NOT NULL
+);
+
+CREATE TABLE coupon_redemptions (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  coupon_id VARCHAR(36) NOT NULL,
+  email VARCHAR(255) NOT NULL,
+  quantity INT NOT NULL,
+  created_at DATETIME NOT NULL,
+  INDEX idx_coupon_redemptions_email (coupon_id, email),
+  FOREIGN KEY (coupon_id) REFERENCES coupons(id) ON DELETE CASCADE
+);
@@ -0,0 +1,20 @@
+-- Adds the ticket categories of the events. Events without categories keep
+-- selling the default full and half tickets; the ticket kind grows to fit
+-- the names of the new categories.
+--   mysql -u root -p test_db < mysql-init/migrations/002_ticket_categories.sql
+
+CREATE TABLE ticket_categories (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  event_id VARCHAR(36) NOT NULL,
+  kind VARCHAR(20) NOT NULL,
+  name VARCHAR(100) NOT NULL,
+  price_multiplier FLOAT NOT NULL,
+  requirements TEXT NOT NULL,
+  quota INT NOT NULL DEFAULT 0,
+  partner_ticket_kind VARCHAR(20) NOT NULL DEFAULT '',
+  UNIQUE KEY uq_ticket_categories_kind (event_id, kind),
+  FOREIGN KEY (event_id) REFERENCES events(id)
+);
+
+ALTER TABLE tickets
+  MODIFY COLUMN ticket_kind VARCHAR(20) NOT NULL;
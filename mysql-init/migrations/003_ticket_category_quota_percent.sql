@@ -0,0 +1,6 @@
+-- Adds the quota of the ticket categories as a share of the capacity.
+-- Existing categories keep only their absolute quota.
+--   mysql -u root -p test_db < mysql-init/migrations/003_ticket_category_quota_percent.sql
+
+ALTER TABLE ticket_categories
+  ADD COLUMN quota_percent FLOAT NOT NULL DEFAULT 0 AFTER quota;
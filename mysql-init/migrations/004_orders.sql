@@ -0,0 +1,24 @@
+-- Adds the per-buyer ticket limit of the events and the orders used by the
+-- velocity rules. Tickets sold before the orders belong to no order and
+-- count for no buyer.
+--   mysql -u root -p test_db < mysql-init/migrations/004_orders.sql
+
+ALTER TABLE events
+  ADD COLUMN max_tickets_per_buyer INT NOT NULL DEFAULT 0 AFTER partner_id;
+
+CREATE TABLE orders (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  event_id VARCHAR(36) NOT NULL,
+  email VARCHAR(255) NOT NULL,
+  card_hash VARCHAR(255) NOT NULL,
+  ip_address VARCHAR(45) NOT NULL,
+  created_at DATETIME NOT NULL,
+  INDEX idx_orders_event_email (event_id, email),
+  INDEX idx_orders_card_hash (card_hash, created_at),
+  INDEX idx_orders_ip_address (ip_address, created_at),
+  FOREIGN KEY (event_id) REFERENCES events(id)
+);
+
+ALTER TABLE tickets
+  ADD COLUMN order_id VARCHAR(36) AFTER event_id,
+  ADD FOREIGN KEY (order_id) REFERENCES orders(id);
@@ -0,0 +1,21 @@
+-- Adds the holds on spots offered to the waitlist and the waitlist entries.
+--   mysql -u root -p test_db < mysql-init/migrations/005_waitlist.sql
+
+ALTER TABLE spots
+  ADD COLUMN held_by VARCHAR(255),
+  ADD COLUMN hold_expires_at DATETIME,
+  ADD INDEX idx_spots_hold_expires_at (status, hold_expires_at);
+
+CREATE TABLE waitlist_entries (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  event_id VARCHAR(36) NOT NULL,
+  email VARCHAR(255) NOT NULL,
+  quantity INT NOT NULL,
+  status VARCHAR(10) NOT NULL,
+  offered_spot_ids TEXT NOT NULL,
+  offer_expires_at DATETIME,
+  created_at DATETIME(6) NOT NULL,
+  INDEX idx_waitlist_event (event_id, created_at),
+  INDEX idx_waitlist_offer_expires_at (status, offer_expires_at),
+  FOREIGN KEY (event_id) REFERENCES events(id)
+);
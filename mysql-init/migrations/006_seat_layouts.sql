@@ -0,0 +1,12 @@
+-- Adds the seat layout of the spots. Spots created before the layouts have
+-- no section, row or coordinates.
+--   mysql -u root -p test_db < mysql-init/migrations/006_seat_layouts.sql
+
+ALTER TABLE spots
+  ADD COLUMN section VARCHAR(50) NOT NULL DEFAULT '',
+  ADD COLUMN seat_row VARCHAR(3) NOT NULL DEFAULT '',
+  ADD COLUMN seat_number INT NOT NULL DEFAULT 0,
+  ADD COLUMN x DOUBLE NOT NULL DEFAULT 0,
+  ADD COLUMN y DOUBLE NOT NULL DEFAULT 0,
+  ADD COLUMN accessible BOOLEAN NOT NULL DEFAULT FALSE,
+  ADD COLUMN attributes TEXT;
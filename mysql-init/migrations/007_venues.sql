@@ -0,0 +1,19 @@
+-- Adds the venues and the venue of each event. Events created before the
+-- venues keep only their free-text location.
+--   mysql -u root -p test_db < mysql-init/migrations/007_venues.sql
+
+CREATE TABLE venues (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  name VARCHAR(255) NOT NULL,
+  address VARCHAR(255) NOT NULL,
+  city VARCHAR(255) NOT NULL,
+  latitude DOUBLE NOT NULL,
+  longitude DOUBLE NOT NULL,
+  timezone VARCHAR(64) NOT NULL,
+  capacity INT NOT NULL,
+  layout TEXT NOT NULL
+);
+
+ALTER TABLE events
+  ADD COLUMN venue_id VARCHAR(36) AFTER max_tickets_per_buyer,
+  ADD FOREIGN KEY (venue_id) REFERENCES venues(id);
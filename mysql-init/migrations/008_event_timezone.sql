@@ -0,0 +1,17 @@
+-- Adds the time zone of the events to databases created before it existed.
+-- Safe to run again: the column is only added when missing.
+--   mysql -u root -p test_db < mysql-init/migrations/008_event_timezone.sql
+
+SET @add_timezone = (
+  SELECT IF(COUNT(*) = 0,
+    'ALTER TABLE events ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT ''UTC'' AFTER date',
+    'DO 0')
+  FROM information_schema.columns
+  WHERE table_schema = DATABASE() AND table_name = 'events' AND column_name = 'timezone'
+);
+PREPARE add_timezone FROM @add_timezone;
+EXECUTE add_timezone;
+DEALLOCATE PREPARE add_timezone;
+
+-- events at a venue take its time zone
+UPDATE events e JOIN venues v ON v.id = e.venue_id SET e.timezone = v.timezone;
@@ -0,0 +1,27 @@
+-- Event dates used to be written with the layout "2024-07-15 15:04:05",
+-- which has no year or month, so MySQL stored either a zero date or a
+-- garbled one. The original instant cannot be derived from the stored
+-- value, so the repair takes three steps:
+--
+--   1. run this script: it lists the affected events in event_date_repairs;
+--   2. fill corrected_date (in UTC) of each listed event, e.g.
+--        UPDATE event_date_repairs SET corrected_date = '2024-07-15 18:00:00'
+--        WHERE event_id = '...';
+--   3. run 010_fix_event_dates.sql, which writes corrected_date to events.
+--
+-- Safe to run again: events already listed are kept as they are.
+--   mysql -u root -p test_db < mysql-init/migrations/009_event_date_repairs.sql
+
+CREATE TABLE IF NOT EXISTS event_date_repairs (
+  event_id VARCHAR(36) NOT NULL PRIMARY KEY,
+  stored_date VARCHAR(32) NOT NULL,
+  corrected_date DATETIME NULL,
+  FOREIGN KEY (event_id) REFERENCES events(id)
+);
+
+INSERT IGNORE INTO event_date_repairs (event_id, stored_date)
+SELECT id, CAST(date AS CHAR)
+FROM events
+WHERE CAST(date AS CHAR) LIKE '0000-00-00%'
+   OR date < '2000-01-01'
+   OR date > '2100-01-01';
@@ -0,0 +1,9 @@
+-- Writes the dates filled in event_date_repairs (see
+-- 009_event_date_repairs.sql) to the events. Listed events still without
+-- corrected_date are left alone, so it can be run again after filling more.
+--   mysql -u root -p test_db < mysql-init/migrations/010_fix_event_dates.sql
+
+UPDATE events e
+JOIN event_date_repairs r ON r.event_id = e.id
+SET e.date = r.corrected_date
+WHERE r.corrected_date IS NOT NULL;
@@ -0,0 +1,28 @@
+-- Adds event series to databases created before recurring events.
+--   mysql -u root -p test_db < mysql-init/migrations/011_event_series.sql
+
+CREATE TABLE event_series (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  name VARCHAR(255) NOT NULL,
+  location VARCHAR(255) NOT NULL,
+  organization VARCHAR(255) NOT NULL,
+  rating VARCHAR(10) NOT NULL,
+  image_url VARCHAR(255) NOT NULL,
+  capacity INT NOT NULL,
+  price FLOAT NOT NULL,
+  partner_id INT NOT NULL,
+  max_tickets_per_buyer INT NOT NULL DEFAULT 0,
+  venue_id VARCHAR(36),
+  timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+  start DATETIME NOT NULL,
+  recurrence VARCHAR(255) NOT NULL,
+  number_of_spots INT NOT NULL DEFAULT 0,
+  layout TEXT NOT NULL,
+  ticket_categories TEXT NOT NULL,
+  FOREIGN KEY (venue_id) REFERENCES venues(id)
+);
+
+ALTER TABLE events
+  ADD COLUMN series_id VARCHAR(36),
+  ADD COLUMN series_overrides VARCHAR(255) NOT NULL DEFAULT '[]',
+  ADD FOREIGN KEY (series_id) REFERENCES event_series(id);
@@ -0,0 +1,15 @@
+-- Adds the event search columns and their FULLTEXT indexes to databases
+-- created before the search. The API fills the columns of the existing
+-- events when it starts; until this runs it falls back to searching in
+-- memory.
+--   mysql -u root -p test_db < mysql-init/migrations/012_event_search.sql
+
+ALTER TABLE events
+  ADD COLUMN search_name VARCHAR(255),
+  ADD COLUMN search_location VARCHAR(255),
+  ADD COLUMN search_organization VARCHAR(255);
+
+ALTER TABLE events ADD FULLTEXT INDEX ft_events_search (search_name, search_location, search_organization);
+ALTER TABLE events ADD FULLTEXT INDEX ft_events_search_name (search_name);
+ALTER TABLE events ADD FULLTEXT INDEX ft_events_search_location (search_location);
+ALTER TABLE events ADD FULLTEXT INDEX ft_events_search_organization (search_organization);
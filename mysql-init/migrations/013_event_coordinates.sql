@@ -0,0 +1,19 @@
+-- Adds coordinates to events and series created before the nearby search.
+-- Events at a venue take its coordinates; the others stay without
+-- coordinates until they are set again.
+--   mysql -u root -p test_db < mysql-init/migrations/013_event_coordinates.sql
+
+ALTER TABLE events
+  ADD COLUMN latitude DOUBLE,
+  ADD COLUMN longitude DOUBLE,
+  ADD INDEX idx_events_coordinates (latitude, longitude);
+
+ALTER TABLE event_series
+  ADD COLUMN latitude DOUBLE,
+  ADD COLUMN longitude DOUBLE;
+
+UPDATE events e JOIN venues v ON v.id = e.venue_id
+SET e.latitude = v.latitude, e.longitude = v.longitude;
+
+UPDATE event_series s JOIN venues v ON v.id = s.venue_id
+SET s.latitude = v.latitude, s.longitude = v.longitude;
@@ -0,0 +1,12 @@
+-- Adds the lifecycle status to events created before it existed. They were
+-- on sale from the moment they were created, so future events stay on sale
+-- and the ones that already happened, like the 2021 seed events, finish.
+--   mysql -u root -p test_db < mysql-init/migrations/014_event_lifecycle.sql
+
+ALTER TABLE events
+  ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'draft',
+  ADD COLUMN publish_at DATETIME,
+  ADD COLUMN on_sale_at DATETIME,
+  ADD INDEX idx_events_status (status, date);
+
+UPDATE events SET status = IF(date <= UTC_TIMESTAMP(), 'finished', 'on_sale');
@@ -0,0 +1,7 @@
+-- Adds the attendee of each ticket. Tickets sold before the age rating check
+-- keep an empty attendee.
+--   mysql -u root -p test_db < mysql-init/migrations/015_ticket_attendees.sql
+
+ALTER TABLE tickets
+  ADD COLUMN attendee_name VARCHAR(255) NOT NULL DEFAULT '',
+  ADD COLUMN attendee_document VARCHAR(50) NOT NULL DEFAULT '';
@@ -0,0 +1,35 @@
+-- Adds ticket holders and transfers. Tickets sold before the transfers are
+-- held by the email of their order.
+--   mysql -u root -p test_db < mysql-init/migrations/016_ticket_transfers.sql
+
+ALTER TABLE events
+  ADD COLUMN transfer_deadline_hours INT NOT NULL DEFAULT 0;
+
+ALTER TABLE tickets
+  ADD COLUMN holder_email VARCHAR(255) NOT NULL DEFAULT '',
+  ADD COLUMN credential_version INT NOT NULL DEFAULT 1;
+
+UPDATE tickets t JOIN orders o ON o.id = t.order_id
+SET t.holder_email = o.email;
+
+-- Tickets sold before the orders have no email to copy and keep an empty
+-- holder, which no email matches: they cannot be transferred nor have their
+-- credential fetched until a holder is set by hand. List them:
+SELECT id, event_id FROM tickets WHERE holder_email = '';
+
+CREATE TABLE ticket_transfers (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  ticket_id VARCHAR(36) NOT NULL,
+  event_id VARCHAR(36) NOT NULL,
+  from_email VARCHAR(255) NOT NULL,
+  to_email VARCHAR(255) NOT NULL,
+  status VARCHAR(10) NOT NULL,
+  acceptance_code VARCHAR(64) NOT NULL,
+  credential_version INT NOT NULL DEFAULT 0,
+  created_at DATETIME(6) NOT NULL,
+  expires_at DATETIME NOT NULL,
+  resolved_at DATETIME,
+  INDEX idx_ticket_transfers_ticket (ticket_id, created_at),
+  FOREIGN KEY (ticket_id) REFERENCES tickets(id),
+  FOREIGN KEY (event_id) REFERENCES events(id)
+);
@@ -0,0 +1,21 @@
+-- Adds the check-in of tickets at the door and the log of every scan.
+--   mysql -u root -p test_db < mysql-init/migrations/017_ticket_checkins.sql
+
+ALTER TABLE tickets
+  ADD COLUMN checked_in_at DATETIME(6),
+  ADD COLUMN checked_in_gate VARCHAR(50) NOT NULL DEFAULT '';
+
+CREATE TABLE ticket_scans (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  ticket_id VARCHAR(36),
+  event_id VARCHAR(36),
+  gate VARCHAR(50) NOT NULL,
+  device_id VARCHAR(100) NOT NULL DEFAULT '',
+  source VARCHAR(10) NOT NULL,
+  result VARCHAR(10) NOT NULL,
+  reason VARCHAR(255) NOT NULL DEFAULT '',
+  scanned_at DATETIME(6) NOT NULL,
+  synced_at DATETIME NOT NULL,
+  INDEX idx_ticket_scans_ticket (ticket_id, scanned_at),
+  INDEX idx_ticket_scans_event (event_id, scanned_at)
+);
@@ -0,0 +1,11 @@
+-- Adds the printed ticket templates of the organizations.
+--   mysql -u root -p test_db < mysql-init/migrations/018_ticket_templates.sql
+
+CREATE TABLE ticket_templates (
+  organization VARCHAR(255) NOT NULL PRIMARY KEY,
+  layout VARCHAR(10) NOT NULL DEFAULT 'page',
+  accent_color CHAR(7) NOT NULL,
+  header_text VARCHAR(200) NOT NULL DEFAULT '',
+  footer_text VARCHAR(200) NOT NULL DEFAULT '',
+  show_price BOOLEAN NOT NULL DEFAULT TRUE
+);
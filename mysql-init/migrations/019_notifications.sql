@@ -0,0 +1,19 @@
+-- Adds the outbox of the emails sent to the buyers.
+--   mysql -u root -p test_db < mysql-init/migrations/019_notifications.sql
+
+CREATE TABLE notifications (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  kind VARCHAR(30) NOT NULL,
+  locale VARCHAR(10) NOT NULL,
+  recipient VARCHAR(255) NOT NULL,
+  dedup_key VARCHAR(255) NOT NULL,
+  data JSON NOT NULL,
+  status VARCHAR(10) NOT NULL,
+  attempts INT NOT NULL DEFAULT 0,
+  next_attempt_at DATETIME(6) NOT NULL,
+  last_error TEXT NOT NULL,
+  created_at DATETIME NOT NULL,
+  sent_at DATETIME,
+  UNIQUE KEY uq_notifications_dedup_key (dedup_key),
+  INDEX idx_notifications_due (status, next_attempt_at)
+);
@@ -0,0 +1,28 @@
+-- Adds the scheduled jobs with their run history and the archival of past
+-- events, which leave the event listing once archived.
+--   mysql -u root -p test_db < mysql-init/migrations/020_scheduled_jobs.sql
+
+ALTER TABLE events ADD COLUMN archived_at DATETIME;
+
+CREATE TABLE scheduled_jobs (
+  name VARCHAR(60) NOT NULL PRIMARY KEY,
+  handler VARCHAR(60) NOT NULL,
+  schedule VARCHAR(100) NOT NULL,
+  status VARCHAR(10) NOT NULL,
+  next_run_at DATETIME(6) NOT NULL,
+  last_run_at DATETIME(6),
+  created_at DATETIME NOT NULL,
+  INDEX idx_scheduled_jobs_due (status, next_run_at)
+);
+
+CREATE TABLE job_runs (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  job_name VARCHAR(60) NOT NULL,
+  runner VARCHAR(255) NOT NULL,
+  status VARCHAR(10) NOT NULL,
+  output TEXT NOT NULL,
+  error TEXT NOT NULL,
+  started_at DATETIME(6) NOT NULL,
+  finished_at DATETIME(6),
+  INDEX idx_job_runs_job (job_name, started_at)
+);
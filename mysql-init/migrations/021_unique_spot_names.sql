@@ -0,0 +1,6 @@
+-- Makes spot names unique within each event, so concurrent bulk creations
+-- cannot repeat a name. Events that already repeat a spot name must have the
+-- duplicates renamed before the migration runs.
+--   mysql -u root -p test_db < mysql-init/migrations/021_unique_spot_names.sql
+
+ALTER TABLE spots ADD UNIQUE KEY uq_spots_event_name (event_id, name);
@@ -0,0 +1,19 @@
+-- Adds the blocked spots, kept out of sale with a reason, and the audit
+-- trail of the changes made to the spots of the events.
+--   mysql -u root -p test_db < mysql-init/migrations/022_spot_changes.sql
+
+ALTER TABLE spots ADD COLUMN blocked_reason VARCHAR(255) AFTER hold_expires_at;
+
+CREATE TABLE spot_changes (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  event_id VARCHAR(36) NOT NULL,
+  spot_id VARCHAR(36) NOT NULL,
+  spot_name VARCHAR(10) NOT NULL,
+  action VARCHAR(10) NOT NULL,
+  previous_name VARCHAR(10) NOT NULL DEFAULT '',
+  reason VARCHAR(255) NOT NULL DEFAULT '',
+  actor VARCHAR(255) NOT NULL,
+  created_at DATETIME(6) NOT NULL,
+  INDEX idx_spot_changes_event (event_id, created_at),
+  FOREIGN KEY (event_id) REFERENCES events(id)
+);
@@ -0,0 +1,5 @@
+-- Spots without a ticket keep ticket_id NULL. The seed data used to store
+-- an empty string instead.
+--   mysql -u root -p test_db < mysql-init/migrations/023_spot_ticket_null.sql
+
+UPDATE spots SET ticket_id = NULL WHERE ticket_id = '';
@@ -0,0 +1,120 @@
+-- Creates the tables of the event repository in PostgreSQL, with the same
+-- columns as mysql-init/init.sql. The foreign keys of venue_id and series_id
+-- come with their tables in 002_venues_and_series.sql. DATETIME columns
+-- become TIMESTAMP without time zone holding UTC instants.
+--   psql -U test_user -d test_db -f postgres-init/migrations/001_event_repository.sql
+
+CREATE TABLE events (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  name VARCHAR(255) NOT NULL,
+  location VARCHAR(255) NOT NULL,
+  organization VARCHAR(255) NOT NULL,
+  rating VARCHAR(10) NOT NULL,
+  date TIMESTAMP NOT NULL,
+  timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+  image_url VARCHAR(255) NOT NULL,
+  capacity INT NOT NULL,
+  price REAL NOT NULL,
+  partner_id INT NOT NULL,
+  max_tickets_per_buyer INT NOT NULL DEFAULT 0,
+  venue_id VARCHAR(36),
+  series_id VARCHAR(36),
+  series_overrides VARCHAR(255) NOT NULL DEFAULT '[]',
+  search_name VARCHAR(255),
+  search_location VARCHAR(255),
+  search_organization VARCHAR(255),
+  latitude DOUBLE PRECISION,
+  longitude DOUBLE PRECISION,
+  status VARCHAR(20) NOT NULL DEFAULT 'draft',
+  publish_at TIMESTAMP,
+  on_sale_at TIMESTAMP,
+  transfer_deadline_hours INT NOT NULL DEFAULT 0,
+  archived_at TIMESTAMP
+);
+
+CREATE INDEX idx_events_coordinates ON events (latitude, longitude);
+CREATE INDEX idx_events_status ON events (status, date);
+CREATE INDEX idx_events_venue ON events (venue_id);
+CREATE INDEX idx_events_series ON events (series_id);
+
+CREATE TABLE spots (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  event_id VARCHAR(36) NOT NULL REFERENCES events(id),
+  name VARCHAR(10) NOT NULL,
+  status VARCHAR(10) NOT NULL,
+  ticket_id VARCHAR(36),
+  held_by VARCHAR(255),
+  hold_expires_at TIMESTAMP,
+  blocked_reason VARCHAR(255),
+  section VARCHAR(50) NOT NULL DEFAULT '',
+  seat_row VARCHAR(3) NOT NULL DEFAULT '',
+  seat_number INT NOT NULL DEFAULT 0,
+  x DOUBLE PRECISION NOT NULL DEFAULT 0,
+  y DOUBLE PRECISION NOT NULL DEFAULT 0,
+  accessible BOOLEAN NOT NULL DEFAULT FALSE,
+  attributes TEXT,
+  CONSTRAINT uq_spots_event_name UNIQUE (event_id, name)
+);
+
+CREATE INDEX idx_spots_hold_expires_at ON spots (status, hold_expires_at);
+
+CREATE TABLE spot_changes (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  event_id VARCHAR(36) NOT NULL REFERENCES events(id),
+  spot_id VARCHAR(36) NOT NULL,
+  spot_name VARCHAR(10) NOT NULL,
+  action VARCHAR(10) NOT NULL,
+  previous_name VARCHAR(10) NOT NULL DEFAULT '',
+  reason VARCHAR(255) NOT NULL DEFAULT '',
+  actor VARCHAR(255) NOT NULL,
+  created_at TIMESTAMP(6) NOT NULL
+);
+
+CREATE INDEX idx_spot_changes_event ON spot_changes (event_id, created_at);
+
+CREATE TABLE ticket_categories (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  event_id VARCHAR(36) NOT NULL REFERENCES events(id),
+  kind VARCHAR(20) NOT NULL,
+  name VARCHAR(100) NOT NULL,
+  price_multiplier REAL NOT NULL,
+  requirements TEXT NOT NULL,
+  quota INT NOT NULL DEFAULT 0,
+  quota_percent REAL NOT NULL DEFAULT 0,
+  partner_ticket_kind VARCHAR(20) NOT NULL DEFAULT '',
+  CONSTRAINT uq_ticket_categories_kind UNIQUE (event_id, kind)
+);
+
+CREATE TABLE orders (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  event_id VARCHAR(36) NOT NULL REFERENCES events(id),
+  email VARCHAR(255) NOT NULL,
+  card_hash VARCHAR(255) NOT NULL,
+  ip_address VARCHAR(45) NOT NULL,
+  created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_orders_event_email ON orders (event_id, email);
+CREATE INDEX idx_orders_card_hash ON orders (card_hash, created_at);
+CREATE INDEX idx_orders_ip_address ON orders (ip_address, created_at);
+
+CREATE TABLE tickets (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  event_id VARCHAR(36) NOT NULL REFERENCES events(id),
+  order_id VARCHAR(36) REFERENCES orders(id),
+  spot_id VARCHAR(36) NOT NULL REFERENCES spots(id),
+  ticket_kind VARCHAR(20) NOT NULL,
+  price REAL NOT NULL,
+  coupon_id VARCHAR(36),
+  discount REAL NOT NULL DEFAULT 0,
+  attendee_name VARCHAR(255) NOT NULL DEFAULT '',
+  attendee_document VARCHAR(50) NOT NULL DEFAULT '',
+  holder_email VARCHAR(255) NOT NULL DEFAULT '',
+  credential_version INT NOT NULL DEFAULT 1,
+  checked_in_at TIMESTAMP(6),
+  checked_in_gate VARCHAR(50) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_tickets_spot ON tickets (spot_id);
+CREATE INDEX idx_tickets_event ON tickets (event_id);
+CREATE INDEX idx_tickets_order ON tickets (order_id);
@@ -0,0 +1,41 @@
+-- Creates the venues and event series of PostgreSQL and links the events
+-- to them, as in mysql-init/init.sql.
+--   psql -U test_user -d test_db -f postgres-init/migrations/002_venues_and_series.sql
+
+CREATE TABLE venues (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  name VARCHAR(255) NOT NULL,
+  address VARCHAR(255) NOT NULL,
+  city VARCHAR(255) NOT NULL,
+  latitude DOUBLE PRECISION NOT NULL,
+  longitude DOUBLE PRECISION NOT NULL,
+  timezone VARCHAR(64) NOT NULL,
+  capacity INT NOT NULL,
+  layout TEXT NOT NULL
+);
+
+CREATE TABLE event_series (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  name VARCHAR(255) NOT NULL,
+  location VARCHAR(255) NOT NULL,
+  organization VARCHAR(255) NOT NULL,
+  rating VARCHAR(10) NOT NULL,
+  image_url VARCHAR(255) NOT NULL,
+  capacity INT NOT NULL,
+  price REAL NOT NULL,
+  partner_id INT NOT NULL,
+  max_tickets_per_buyer INT NOT NULL DEFAULT 0,
+  venue_id VARCHAR(36) REFERENCES venues(id),
+  timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+  start TIMESTAMP NOT NULL,
+  recurrence VARCHAR(255) NOT NULL,
+  number_of_spots INT NOT NULL DEFAULT 0,
+  layout TEXT NOT NULL,
+  ticket_categories TEXT NOT NULL,
+  latitude DOUBLE PRECISION,
+  longitude DOUBLE PRECISION
+);
+
+ALTER TABLE events
+  ADD CONSTRAINT fk_events_venue FOREIGN KEY (venue_id) REFERENCES venues(id),
+  ADD CONSTRAINT fk_events_series FOREIGN KEY (series_id) REFERENCES event_series(id);
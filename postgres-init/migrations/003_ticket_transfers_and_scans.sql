@@ -0,0 +1,34 @@
+-- Creates the ticket transfers and the check-in scans of PostgreSQL.
+--   psql -U test_user -d test_db -f postgres-init/migrations/003_ticket_transfers_and_scans.sql
+
+CREATE TABLE ticket_transfers (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  ticket_id VARCHAR(36) NOT NULL REFERENCES tickets(id),
+  event_id VARCHAR(36) NOT NULL REFERENCES events(id),
+  from_email VARCHAR(255) NOT NULL,
+  to_email VARCHAR(255) NOT NULL,
+  status VARCHAR(10) NOT NULL,
+  acceptance_code VARCHAR(64) NOT NULL,
+  credential_version INT NOT NULL DEFAULT 0,
+  created_at TIMESTAMP(6) NOT NULL,
+  expires_at TIMESTAMP NOT NULL,
+  resolved_at TIMESTAMP
+);
+
+CREATE INDEX idx_ticket_transfers_ticket ON ticket_transfers (ticket_id, created_at);
+
+CREATE TABLE ticket_scans (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  ticket_id VARCHAR(36),
+  event_id VARCHAR(36),
+  gate VARCHAR(50) NOT NULL,
+  device_id VARCHAR(100) NOT NULL DEFAULT '',
+  source VARCHAR(10) NOT NULL,
+  result VARCHAR(10) NOT NULL,
+  reason VARCHAR(255) NOT NULL DEFAULT '',
+  scanned_at TIMESTAMP(6) NOT NULL,
+  synced_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_ticket_scans_ticket ON ticket_scans (ticket_id, scanned_at);
+CREATE INDEX idx_ticket_scans_event ON ticket_scans (event_id, scanned_at);
@@ -0,0 +1,11 @@
+-- Creates the ticket templates of the organizations in PostgreSQL.
+--   psql -U test_user -d test_db -f postgres-init/migrations/004_ticket_templates.sql
+
+CREATE TABLE ticket_templates (
+  organization VARCHAR(255) NOT NULL PRIMARY KEY,
+  layout VARCHAR(10) NOT NULL DEFAULT 'page',
+  accent_color CHAR(7) NOT NULL,
+  header_text VARCHAR(200) NOT NULL DEFAULT '',
+  footer_text VARCHAR(200) NOT NULL DEFAULT '',
+  show_price BOOLEAN NOT NULL DEFAULT TRUE
+);
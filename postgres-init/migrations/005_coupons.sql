@@ -0,0 +1,26 @@
+-- Creates the coupons and their redemptions in PostgreSQL.
+--   psql -U test_user -d test_db -f postgres-init/migrations/005_coupons.sql
+
+CREATE TABLE coupons (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  code VARCHAR(64) NOT NULL UNIQUE,
+  discount_type VARCHAR(16) NOT NULL,
+  value REAL NOT NULL,
+  valid_from TIMESTAMP NOT NULL,
+  valid_until TIMESTAMP,
+  max_uses INT NOT NULL DEFAULT 0,
+  max_uses_per_email INT NOT NULL DEFAULT 0,
+  used_count INT NOT NULL DEFAULT 0,
+  event_ids TEXT NOT NULL,
+  ticket_kinds TEXT NOT NULL
+);
+
+CREATE TABLE coupon_redemptions (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  coupon_id VARCHAR(36) NOT NULL REFERENCES coupons(id) ON DELETE CASCADE,
+  email VARCHAR(255) NOT NULL,
+  quantity INT NOT NULL,
+  created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_coupon_redemptions_email ON coupon_redemptions (coupon_id, email);
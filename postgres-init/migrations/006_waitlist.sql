@@ -0,0 +1,16 @@
+-- Creates the waitlist of the sold out events in PostgreSQL.
+--   psql -U test_user -d test_db -f postgres-init/migrations/006_waitlist.sql
+
+CREATE TABLE waitlist_entries (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  event_id VARCHAR(36) NOT NULL REFERENCES events(id),
+  email VARCHAR(255) NOT NULL,
+  quantity INT NOT NULL,
+  status VARCHAR(10) NOT NULL,
+  offered_spot_ids TEXT NOT NULL,
+  offer_expires_at TIMESTAMP,
+  created_at TIMESTAMP(6) NOT NULL
+);
+
+CREATE INDEX idx_waitlist_event ON waitlist_entries (event_id, created_at);
+CREATE INDEX idx_waitlist_offer_expires_at ON waitlist_entries (status, offer_expires_at);
@@ -0,0 +1,45 @@
+-- Creates the outbox of notifications and the scheduled jobs in PostgreSQL.
+--   psql -U test_user -d test_db -f postgres-init/migrations/007_notifications_and_jobs.sql
+
+CREATE TABLE notifications (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  kind VARCHAR(30) NOT NULL,
+  locale VARCHAR(10) NOT NULL,
+  recipient VARCHAR(255) NOT NULL,
+  dedup_key VARCHAR(255) NOT NULL,
+  data JSON NOT NULL,
+  status VARCHAR(10) NOT NULL,
+  attempts INT NOT NULL DEFAULT 0,
+  next_attempt_at TIMESTAMP(6) NOT NULL,
+  last_error TEXT NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  sent_at TIMESTAMP,
+  CONSTRAINT uq_notifications_dedup_key UNIQUE (dedup_key)
+);
+
+CREATE INDEX idx_notifications_due ON notifications (status, next_attempt_at);
+
+CREATE TABLE scheduled_jobs (
+  name VARCHAR(60) NOT NULL PRIMARY KEY,
+  handler VARCHAR(60) NOT NULL,
+  schedule VARCHAR(100) NOT NULL,
+  status VARCHAR(10) NOT NULL,
+  next_run_at TIMESTAMP(6) NOT NULL,
+  last_run_at TIMESTAMP(6),
+  created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_scheduled_jobs_due ON scheduled_jobs (status, next_run_at);
+
+CREATE TABLE job_runs (
+  id VARCHAR(36) NOT NULL PRIMARY KEY,
+  job_name VARCHAR(60) NOT NULL,
+  runner VARCHAR(255) NOT NULL,
+  status VARCHAR(10) NOT NULL,
+  output TEXT NOT NULL,
+  error TEXT NOT NULL,
+  started_at TIMESTAMP(6) NOT NULL,
+  finished_at TIMESTAMP(6)
+);
+
+CREATE INDEX idx_job_runs_job ON job_runs (job_name, started_at);